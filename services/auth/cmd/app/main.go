@@ -8,8 +8,12 @@ import (
 	"syscall"
 
 	appcfg "github.com/mibrgmv/whoami-server/auth/internal/config"
+	guestv1 "github.com/mibrgmv/whoami-server/auth/internal/protogen/guest/v1"
+	historyv1 "github.com/mibrgmv/whoami-server/auth/internal/protogen/history/v1"
 	"github.com/mibrgmv/whoami-server/auth/internal/server"
 	"github.com/mibrgmv/whoami-server/shared/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 func main() {
@@ -23,7 +27,19 @@ func main() {
 		log.Fatalf("failed to read user service config: %v", err)
 	}
 
-	s := server.NewGrpcServer(cfg)
+	historyConn, err := grpc.NewClient(cfg.HistoryService.GetAddr(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("failed to connect to history service: %v", err)
+	}
+	defer historyConn.Close()
+
+	quizConn, err := grpc.NewClient(cfg.QuizService.GetAddr(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("failed to connect to quiz service: %v", err)
+	}
+	defer quizConn.Close()
+
+	s := server.NewGrpcServer(cfg, historyv1.NewHistoryServiceClient(historyConn), guestv1.NewGuestServiceClient(quizConn))
 	lis, err := net.Listen("tcp", cfg.Grpc.GetAddr())
 	if err != nil {
 		log.Fatal("Failed to listen:", err)
@@ -6,6 +6,8 @@ import (
 )
 
 type Config struct {
-	Grpc     grpc.Config     `mapstructure:"grpc"`
-	Keycloak keycloak.Config `mapstructure:"keycloak"`
+	Grpc           grpc.Config     `mapstructure:"grpc"`
+	Keycloak       keycloak.Config `mapstructure:"keycloak"`
+	HistoryService grpc.Config     `mapstructure:"history_service"`
+	QuizService    grpc.Config     `mapstructure:"quiz_service"`
 }
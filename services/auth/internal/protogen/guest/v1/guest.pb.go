@@ -0,0 +1,183 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v5.29.3
+// source: guest.proto
+
+package guestv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MergeGuestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuestId       string                 `protobuf:"bytes,1,opt,name=guest_id,json=guestId,proto3" json:"guest_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeGuestRequest) Reset() {
+	*x = MergeGuestRequest{}
+	mi := &file_guest_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeGuestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeGuestRequest) ProtoMessage() {}
+
+func (x *MergeGuestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guest_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeGuestRequest.ProtoReflect.Descriptor instead.
+func (*MergeGuestRequest) Descriptor() ([]byte, []int) {
+	return file_guest_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MergeGuestRequest) GetGuestId() string {
+	if x != nil {
+		return x.GuestId
+	}
+	return ""
+}
+
+func (x *MergeGuestRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type MergeGuestResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	MergedAttemptCount int64                  `protobuf:"varint,1,opt,name=merged_attempt_count,json=mergedAttemptCount,proto3" json:"merged_attempt_count,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MergeGuestResponse) Reset() {
+	*x = MergeGuestResponse{}
+	mi := &file_guest_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeGuestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeGuestResponse) ProtoMessage() {}
+
+func (x *MergeGuestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guest_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeGuestResponse.ProtoReflect.Descriptor instead.
+func (*MergeGuestResponse) Descriptor() ([]byte, []int) {
+	return file_guest_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MergeGuestResponse) GetMergedAttemptCount() int64 {
+	if x != nil {
+		return x.MergedAttemptCount
+	}
+	return 0
+}
+
+var File_guest_proto protoreflect.FileDescriptor
+
+const file_guest_proto_rawDesc = "" +
+	"\n" +
+	"\vguest.proto\x12\bguest.v1\"G\n" +
+	"\x11MergeGuestRequest\x12\x19\n" +
+	"\bguest_id\x18\x01 \x01(\tR\aguestId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"F\n" +
+	"\x12MergeGuestResponse\x120\n" +
+	"\x14merged_attempt_count\x18\x01 \x01(\x03R\x12mergedAttemptCount2Y\n" +
+	"\fGuestService\x12I\n" +
+	"\n" +
+	"MergeGuest\x12\x1b.guest.v1.MergeGuestRequest\x1a\x1c.guest.v1.MergeGuestResponse\"\x00BJZHgithub.com/mibrgmv/whoami-server/auth/internal/protogen/guest/v1;guestv1b\x06proto3"
+
+var (
+	file_guest_proto_rawDescOnce sync.Once
+	file_guest_proto_rawDescData []byte
+)
+
+func file_guest_proto_rawDescGZIP() []byte {
+	file_guest_proto_rawDescOnce.Do(func() {
+		file_guest_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_guest_proto_rawDesc), len(file_guest_proto_rawDesc)))
+	})
+	return file_guest_proto_rawDescData
+}
+
+var file_guest_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_guest_proto_goTypes = []any{
+	(*MergeGuestRequest)(nil),  // 0: guest.v1.MergeGuestRequest
+	(*MergeGuestResponse)(nil), // 1: guest.v1.MergeGuestResponse
+}
+var file_guest_proto_depIdxs = []int32{
+	0, // 0: guest.v1.GuestService.MergeGuest:input_type -> guest.v1.MergeGuestRequest
+	1, // 1: guest.v1.GuestService.MergeGuest:output_type -> guest.v1.MergeGuestResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_guest_proto_init() }
+func file_guest_proto_init() {
+	if File_guest_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_guest_proto_rawDesc), len(file_guest_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_guest_proto_goTypes,
+		DependencyIndexes: file_guest_proto_depIdxs,
+		MessageInfos:      file_guest_proto_msgTypes,
+	}.Build()
+	File_guest_proto = out.File
+	file_guest_proto_goTypes = nil
+	file_guest_proto_depIdxs = nil
+}
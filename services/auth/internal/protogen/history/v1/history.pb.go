@@ -0,0 +1,1543 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v5.29.3
+// source: history.proto
+
+package historyv1
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StatsInterval int32
+
+const (
+	StatsInterval_STATS_INTERVAL_UNSPECIFIED StatsInterval = 0
+	StatsInterval_STATS_INTERVAL_DAY         StatsInterval = 1
+	StatsInterval_STATS_INTERVAL_WEEK        StatsInterval = 2
+	StatsInterval_STATS_INTERVAL_MONTH       StatsInterval = 3
+)
+
+// Enum value maps for StatsInterval.
+var (
+	StatsInterval_name = map[int32]string{
+		0: "STATS_INTERVAL_UNSPECIFIED",
+		1: "STATS_INTERVAL_DAY",
+		2: "STATS_INTERVAL_WEEK",
+		3: "STATS_INTERVAL_MONTH",
+	}
+	StatsInterval_value = map[string]int32{
+		"STATS_INTERVAL_UNSPECIFIED": 0,
+		"STATS_INTERVAL_DAY":         1,
+		"STATS_INTERVAL_WEEK":        2,
+		"STATS_INTERVAL_MONTH":       3,
+	}
+)
+
+func (x StatsInterval) Enum() *StatsInterval {
+	p := new(StatsInterval)
+	*p = x
+	return p
+}
+
+func (x StatsInterval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatsInterval) Descriptor() protoreflect.EnumDescriptor {
+	return file_history_proto_enumTypes[0].Descriptor()
+}
+
+func (StatsInterval) Type() protoreflect.EnumType {
+	return &file_history_proto_enumTypes[0]
+}
+
+func (x StatsInterval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatsInterval.Descriptor instead.
+func (StatsInterval) EnumDescriptor() ([]byte, []int) {
+	return file_history_proto_rawDescGZIP(), []int{0}
+}
+
+type RecommendationReason int32
+
+const (
+	RecommendationReason_RECOMMENDATION_REASON_UNSPECIFIED   RecommendationReason = 0
+	RecommendationReason_RECOMMENDATION_REASON_SIMILAR_USERS RecommendationReason = 1
+	RecommendationReason_RECOMMENDATION_REASON_POPULAR       RecommendationReason = 2
+)
+
+// Enum value maps for RecommendationReason.
+var (
+	RecommendationReason_name = map[int32]string{
+		0: "RECOMMENDATION_REASON_UNSPECIFIED",
+		1: "RECOMMENDATION_REASON_SIMILAR_USERS",
+		2: "RECOMMENDATION_REASON_POPULAR",
+	}
+	RecommendationReason_value = map[string]int32{
+		"RECOMMENDATION_REASON_UNSPECIFIED":   0,
+		"RECOMMENDATION_REASON_SIMILAR_USERS": 1,
+		"RECOMMENDATION_REASON_POPULAR":       2,
+	}
+)
+
+func (x RecommendationReason) Enum() *RecommendationReason {
+	p := new(RecommendationReason)
+	*p = x
+	return p
+}
+
+func (x RecommendationReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecommendationReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_history_proto_enumTypes[1].Descriptor()
+}
+
+func (RecommendationReason) Type() protoreflect.EnumType {
+	return &file_history_proto_enumTypes[1]
+}
+
+func (x RecommendationReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RecommendationReason.Descriptor instead.
+func (RecommendationReason) EnumDescriptor() ([]byte, []int) {
+	return file_history_proto_rawDescGZIP(), []int{1}
+}
+
+type QuizCompletionHistoryItem struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId           string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	QuizId           string                 `protobuf:"bytes,3,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	QuizResult       string                 `protobuf:"bytes,4,opt,name=quiz_result,json=quizResult,proto3" json:"quiz_result,omitempty"`
+	QuizVersionId    string                 `protobuf:"bytes,5,opt,name=quiz_version_id,json=quizVersionId,proto3" json:"quiz_version_id,omitempty"`
+	QuizResultScores []*QuizResultScore     `protobuf:"bytes,6,rep,name=quiz_result_scores,json=quizResultScores,proto3" json:"quiz_result_scores,omitempty"`
+	ElapsedTime      *durationpb.Duration   `protobuf:"bytes,7,opt,name=elapsed_time,json=elapsedTime,proto3" json:"elapsed_time,omitempty"`
+	CompletedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *QuizCompletionHistoryItem) Reset() {
+	*x = QuizCompletionHistoryItem{}
+	mi := &file_history_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizCompletionHistoryItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizCompletionHistoryItem) ProtoMessage() {}
+
+func (x *QuizCompletionHistoryItem) ProtoReflect() protoreflect.Message {
+	mi := &file_history_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizCompletionHistoryItem.ProtoReflect.Descriptor instead.
+func (*QuizCompletionHistoryItem) Descriptor() ([]byte, []int) {
+	return file_history_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QuizCompletionHistoryItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *QuizCompletionHistoryItem) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *QuizCompletionHistoryItem) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *QuizCompletionHistoryItem) GetQuizResult() string {
+	if x != nil {
+		return x.QuizResult
+	}
+	return ""
+}
+
+func (x *QuizCompletionHistoryItem) GetQuizVersionId() string {
+	if x != nil {
+		return x.QuizVersionId
+	}
+	return ""
+}
+
+func (x *QuizCompletionHistoryItem) GetQuizResultScores() []*QuizResultScore {
+	if x != nil {
+		return x.QuizResultScores
+	}
+	return nil
+}
+
+func (x *QuizCompletionHistoryItem) GetElapsedTime() *durationpb.Duration {
+	if x != nil {
+		return x.ElapsedTime
+	}
+	return nil
+}
+
+func (x *QuizCompletionHistoryItem) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+type QuizResultScore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Total         float32                `protobuf:"fixed32,2,opt,name=total,proto3" json:"total,omitempty"`
+	Percentage    float32                `protobuf:"fixed32,3,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	Rank          int32                  `protobuf:"varint,4,opt,name=rank,proto3" json:"rank,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizResultScore) Reset() {
+	*x = QuizResultScore{}
+	mi := &file_history_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizResultScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizResultScore) ProtoMessage() {}
+
+func (x *QuizResultScore) ProtoReflect() protoreflect.Message {
+	mi := &file_history_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizResultScore.ProtoReflect.Descriptor instead.
+func (*QuizResultScore) Descriptor() ([]byte, []int) {
+	return file_history_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QuizResultScore) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *QuizResultScore) GetTotal() float32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *QuizResultScore) GetPercentage() float32 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+func (x *QuizResultScore) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+type CreateItemRequest struct {
+	state          protoimpl.MessageState     `protogen:"open.v1"`
+	Item           *QuizCompletionHistoryItem `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	IdempotencyKey string                     `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateItemRequest) Reset() {
+	*x = CreateItemRequest{}
+	mi := &file_history_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateItemRequest) ProtoMessage() {}
+
+func (x *CreateItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_history_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateItemRequest.ProtoReflect.Descriptor instead.
+func (*CreateItemRequest) Descriptor() ([]byte, []int) {
+	return file_history_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateItemRequest) GetItem() *QuizCompletionHistoryItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *CreateItemRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type BatchGetMyItemsRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	QuizIds       []*wrapperspb.StringValue `protobuf:"bytes,1,rep,name=quiz_ids,json=quizIds,proto3" json:"quiz_ids,omitempty"`
+	PageSize      int32                     `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                    `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetMyItemsRequest) Reset() {
+	*x = BatchGetMyItemsRequest{}
+	mi := &file_history_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetMyItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetMyItemsRequest) ProtoMessage() {}
+
+func (x *BatchGetMyItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_history_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetMyItemsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetMyItemsRequest) Descriptor() ([]byte, []int) {
+	return file_history_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BatchGetMyItemsRequest) GetQuizIds() []*wrapperspb.StringValue {
+	if x != nil {
+		return x.QuizIds
+	}
+	return nil
+}
+
+func (x *BatchGetMyItemsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *BatchGetMyItemsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type BatchGetItemsRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	UserIds       []*wrapperspb.StringValue `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	QuizIds       []*wrapperspb.StringValue `protobuf:"bytes,2,rep,name=quiz_ids,json=quizIds,proto3" json:"quiz_ids,omitempty"`
+	PageSize      int32                     `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                    `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetItemsRequest) Reset() {
+	*x = BatchGetItemsRequest{}
+	mi := &file_history_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetItemsRequest) ProtoMessage() {}
+
+func (x *BatchGetItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_history_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetItemsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetItemsRequest) Descriptor() ([]byte, []int) {
+	return file_history_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BatchGetItemsRequest) GetUserIds() []*wrapperspb.StringValue {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *BatchGetItemsRequest) GetQuizIds() []*wrapperspb.StringValue {
+	if x != nil {
+		return x.QuizIds
+	}
+	return nil
+}
+
+func (x *BatchGetItemsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *BatchGetItemsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type BatchGetItemsResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Items         []*QuizCompletionHistoryItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string                       `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetItemsResponse) Reset() {
+	*x = BatchGetItemsResponse{}
+	mi := &file_history_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetItemsResponse) ProtoMessage() {}
+
+func (x *BatchGetItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_history_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetItemsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetItemsResponse) Descriptor() ([]byte, []int) {
+	return file_history_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BatchGetItemsResponse) GetItems() []*QuizCompletionHistoryItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BatchGetItemsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SharedItem struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Item          *QuizCompletionHistoryItem `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	ShareToken    string                     `protobuf:"bytes,2,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
+	DisplayName   string                     `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharedItem) Reset() {
+	*x = SharedItem{}
+	mi := &file_history_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharedItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedItem) ProtoMessage() {}
+
+func (x *SharedItem) ProtoReflect() protoreflect.Message {
+	mi := &file_history_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedItem.ProtoReflect.Descriptor instead.
+func (*SharedItem) Descriptor() ([]byte, []int) {
+	return file_history_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SharedItem) GetItem() *QuizCompletionHistoryItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *SharedItem) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+func (x *SharedItem) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+type ShareItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareItemRequest) Reset() {
+	*x = ShareItemRequest{}
+	mi := &file_history_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareItemRequest) ProtoMessage() {}
+
+func (x *ShareItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_history_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareItemRequest.ProtoReflect.Descriptor instead.
+func (*ShareItemRequest) Descriptor() ([]byte, []int) {
+	return file_history_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ShareItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ShareItemRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+type UnshareItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnshareItemRequest) Reset() {
+	*x = UnshareItemRequest{}
+	mi := &file_history_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnshareItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnshareItemRequest) ProtoMessage() {}
+
+func (x *UnshareItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_history_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnshareItemRequest.ProtoReflect.Descriptor instead.
+func (*UnshareItemRequest) Descriptor() ([]byte, []int) {
+	return file_history_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UnshareItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UnshareItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnshareItemResponse) Reset() {
+	*x = UnshareItemResponse{}
+	mi := &file_history_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnshareItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnshareItemResponse) ProtoMessage() {}
+
+func (x *UnshareItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_history_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnshareItemResponse.ProtoReflect.Descriptor instead.
+func (*UnshareItemResponse) Descriptor() ([]byte, []int) {
+	return file_history_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UnshareItemResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UnshareItemResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetSharedItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShareToken    string                 `protobuf:"bytes,1,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharedItemRequest) Reset() {
+	*x = GetSharedItemRequest{}
+	mi := &file_history_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedItemRequest) ProtoMessage() {}
+
+func (x *GetSharedItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_history_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedItemRequest.ProtoReflect.Descriptor instead.
+func (*GetSharedItemRequest) Descriptor() ([]byte, []int) {
+	return file_history_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetSharedItemRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+type MergeItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromUserId    string                 `protobuf:"bytes,1,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
+	ToUserId      string                 `protobuf:"bytes,2,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeItemsRequest) Reset() {
+	*x = MergeItemsRequest{}
+	mi := &file_history_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeItemsRequest) ProtoMessage() {}
+
+func (x *MergeItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_history_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeItemsRequest.ProtoReflect.Descriptor instead.
+func (*MergeItemsRequest) Descriptor() ([]byte, []int) {
+	return file_history_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MergeItemsRequest) GetFromUserId() string {
+	if x != nil {
+		return x.FromUserId
+	}
+	return ""
+}
+
+func (x *MergeItemsRequest) GetToUserId() string {
+	if x != nil {
+		return x.ToUserId
+	}
+	return ""
+}
+
+type MergeItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MergedCount   int64                  `protobuf:"varint,1,opt,name=merged_count,json=mergedCount,proto3" json:"merged_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeItemsResponse) Reset() {
+	*x = MergeItemsResponse{}
+	mi := &file_history_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeItemsResponse) ProtoMessage() {}
+
+func (x *MergeItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_history_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeItemsResponse.ProtoReflect.Descriptor instead.
+func (*MergeItemsResponse) Descriptor() ([]byte, []int) {
+	return file_history_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MergeItemsResponse) GetMergedCount() int64 {
+	if x != nil {
+		return x.MergedCount
+	}
+	return 0
+}
+
+type GetQuizStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Interval      StatsInterval          `protobuf:"varint,4,opt,name=interval,proto3,enum=history.v1.StatsInterval" json:"interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuizStatsRequest) Reset() {
+	*x = GetQuizStatsRequest{}
+	mi := &file_history_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuizStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuizStatsRequest) ProtoMessage() {}
+
+func (x *GetQuizStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_history_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuizStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetQuizStatsRequest) Descriptor() ([]byte, []int) {
+	return file_history_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetQuizStatsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetQuizStatsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetQuizStatsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetQuizStatsRequest) GetInterval() StatsInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return StatsInterval_STATS_INTERVAL_UNSPECIFIED
+}
+
+type QuizStats struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	QuizId           string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	TotalCompletions int64                  `protobuf:"varint,2,opt,name=total_completions,json=totalCompletions,proto3" json:"total_completions,omitempty"`
+	UniqueUsers      int64                  `protobuf:"varint,3,opt,name=unique_users,json=uniqueUsers,proto3" json:"unique_users,omitempty"`
+	Results          []*ResultShare         `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty"`
+	Buckets          []*CompletionBucket    `protobuf:"bytes,5,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	Interval         StatsInterval          `protobuf:"varint,6,opt,name=interval,proto3,enum=history.v1.StatsInterval" json:"interval,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *QuizStats) Reset() {
+	*x = QuizStats{}
+	mi := &file_history_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizStats) ProtoMessage() {}
+
+func (x *QuizStats) ProtoReflect() protoreflect.Message {
+	mi := &file_history_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizStats.ProtoReflect.Descriptor instead.
+func (*QuizStats) Descriptor() ([]byte, []int) {
+	return file_history_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *QuizStats) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *QuizStats) GetTotalCompletions() int64 {
+	if x != nil {
+		return x.TotalCompletions
+	}
+	return 0
+}
+
+func (x *QuizStats) GetUniqueUsers() int64 {
+	if x != nil {
+		return x.UniqueUsers
+	}
+	return 0
+}
+
+func (x *QuizStats) GetResults() []*ResultShare {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *QuizStats) GetBuckets() []*CompletionBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *QuizStats) GetInterval() StatsInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return StatsInterval_STATS_INTERVAL_UNSPECIFIED
+}
+
+type ResultShare struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Percentage    float32                `protobuf:"fixed32,3,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResultShare) Reset() {
+	*x = ResultShare{}
+	mi := &file_history_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResultShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultShare) ProtoMessage() {}
+
+func (x *ResultShare) ProtoReflect() protoreflect.Message {
+	mi := &file_history_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResultShare.ProtoReflect.Descriptor instead.
+func (*ResultShare) Descriptor() ([]byte, []int) {
+	return file_history_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ResultShare) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *ResultShare) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ResultShare) GetPercentage() float32 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+type CompletionBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	Completions   int64                  `protobuf:"varint,2,opt,name=completions,proto3" json:"completions,omitempty"`
+	UniqueUsers   int64                  `protobuf:"varint,3,opt,name=unique_users,json=uniqueUsers,proto3" json:"unique_users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompletionBucket) Reset() {
+	*x = CompletionBucket{}
+	mi := &file_history_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompletionBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompletionBucket) ProtoMessage() {}
+
+func (x *CompletionBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_history_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompletionBucket.ProtoReflect.Descriptor instead.
+func (*CompletionBucket) Descriptor() ([]byte, []int) {
+	return file_history_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CompletionBucket) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *CompletionBucket) GetCompletions() int64 {
+	if x != nil {
+		return x.Completions
+	}
+	return 0
+}
+
+func (x *CompletionBucket) GetUniqueUsers() int64 {
+	if x != nil {
+		return x.UniqueUsers
+	}
+	return 0
+}
+
+type Recommendation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuizId        string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	Score         float32                `protobuf:"fixed32,2,opt,name=score,proto3" json:"score,omitempty"`
+	Reason        RecommendationReason   `protobuf:"varint,3,opt,name=reason,proto3,enum=history.v1.RecommendationReason" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Recommendation) Reset() {
+	*x = Recommendation{}
+	mi := &file_history_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recommendation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recommendation) ProtoMessage() {}
+
+func (x *Recommendation) ProtoReflect() protoreflect.Message {
+	mi := &file_history_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recommendation.ProtoReflect.Descriptor instead.
+func (*Recommendation) Descriptor() ([]byte, []int) {
+	return file_history_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Recommendation) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *Recommendation) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Recommendation) GetReason() RecommendationReason {
+	if x != nil {
+		return x.Reason
+	}
+	return RecommendationReason_RECOMMENDATION_REASON_UNSPECIFIED
+}
+
+type GetRecommendationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecommendationsRequest) Reset() {
+	*x = GetRecommendationsRequest{}
+	mi := &file_history_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecommendationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecommendationsRequest) ProtoMessage() {}
+
+func (x *GetRecommendationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_history_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecommendationsRequest.ProtoReflect.Descriptor instead.
+func (*GetRecommendationsRequest) Descriptor() ([]byte, []int) {
+	return file_history_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetRecommendationsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetRecommendationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetRecommendationsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Recommendations []*Recommendation      `protobuf:"bytes,1,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetRecommendationsResponse) Reset() {
+	*x = GetRecommendationsResponse{}
+	mi := &file_history_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecommendationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecommendationsResponse) ProtoMessage() {}
+
+func (x *GetRecommendationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_history_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecommendationsResponse.ProtoReflect.Descriptor instead.
+func (*GetRecommendationsResponse) Descriptor() ([]byte, []int) {
+	return file_history_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetRecommendationsResponse) GetRecommendations() []*Recommendation {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+var File_history_proto protoreflect.FileDescriptor
+
+const file_history_proto_rawDesc = "" +
+	"\n" +
+	"\rhistory.proto\x12\n" +
+	"history.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xee\x02\n" +
+	"\x19QuizCompletionHistoryItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
+	"\aquiz_id\x18\x03 \x01(\tR\x06quizId\x12\x1f\n" +
+	"\vquiz_result\x18\x04 \x01(\tR\n" +
+	"quizResult\x12&\n" +
+	"\x0fquiz_version_id\x18\x05 \x01(\tR\rquizVersionId\x12I\n" +
+	"\x12quiz_result_scores\x18\x06 \x03(\v2\x1b.history.v1.QuizResultScoreR\x10quizResultScores\x12<\n" +
+	"\felapsed_time\x18\a \x01(\v2\x19.google.protobuf.DurationR\velapsedTime\x12=\n" +
+	"\fcompleted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"s\n" +
+	"\x0fQuizResultScore\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\tR\x06result\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x02R\x05total\x12\x1e\n" +
+	"\n" +
+	"percentage\x18\x03 \x01(\x02R\n" +
+	"percentage\x12\x12\n" +
+	"\x04rank\x18\x04 \x01(\x05R\x04rank\"w\n" +
+	"\x11CreateItemRequest\x129\n" +
+	"\x04item\x18\x01 \x01(\v2%.history.v1.QuizCompletionHistoryItemR\x04item\x12'\n" +
+	"\x0fidempotency_key\x18\x02 \x01(\tR\x0eidempotencyKey\"\x8d\x01\n" +
+	"\x16BatchGetMyItemsRequest\x127\n" +
+	"\bquiz_ids\x18\x01 \x03(\v2\x1c.google.protobuf.StringValueR\aquizIds\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\xc4\x01\n" +
+	"\x14BatchGetItemsRequest\x127\n" +
+	"\buser_ids\x18\x01 \x03(\v2\x1c.google.protobuf.StringValueR\auserIds\x127\n" +
+	"\bquiz_ids\x18\x02 \x03(\v2\x1c.google.protobuf.StringValueR\aquizIds\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"|\n" +
+	"\x15BatchGetItemsResponse\x12;\n" +
+	"\x05items\x18\x01 \x03(\v2%.history.v1.QuizCompletionHistoryItemR\x05items\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x8b\x01\n" +
+	"\n" +
+	"SharedItem\x129\n" +
+	"\x04item\x18\x01 \x01(\v2%.history.v1.QuizCompletionHistoryItemR\x04item\x12\x1f\n" +
+	"\vshare_token\x18\x02 \x01(\tR\n" +
+	"shareToken\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\"E\n" +
+	"\x10ShareItemRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\"$\n" +
+	"\x12UnshareItemRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"?\n" +
+	"\x13UnshareItemResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"7\n" +
+	"\x14GetSharedItemRequest\x12\x1f\n" +
+	"\vshare_token\x18\x01 \x01(\tR\n" +
+	"shareToken\"S\n" +
+	"\x11MergeItemsRequest\x12 \n" +
+	"\ffrom_user_id\x18\x01 \x01(\tR\n" +
+	"fromUserId\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\x02 \x01(\tR\btoUserId\"7\n" +
+	"\x12MergeItemsResponse\x12!\n" +
+	"\fmerged_count\x18\x01 \x01(\x03R\vmergedCount\"\xb8\x01\n" +
+	"\x13GetQuizStatsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x125\n" +
+	"\binterval\x18\x04 \x01(\x0e2\x19.history.v1.StatsIntervalR\binterval\"\x96\x02\n" +
+	"\tQuizStats\x12\x17\n" +
+	"\aquiz_id\x18\x01 \x01(\tR\x06quizId\x12+\n" +
+	"\x11total_completions\x18\x02 \x01(\x03R\x10totalCompletions\x12!\n" +
+	"\funique_users\x18\x03 \x01(\x03R\vuniqueUsers\x121\n" +
+	"\aresults\x18\x04 \x03(\v2\x17.history.v1.ResultShareR\aresults\x126\n" +
+	"\abuckets\x18\x05 \x03(\v2\x1c.history.v1.CompletionBucketR\abuckets\x125\n" +
+	"\binterval\x18\x06 \x01(\x0e2\x19.history.v1.StatsIntervalR\binterval\"[\n" +
+	"\vResultShare\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\tR\x06result\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x12\x1e\n" +
+	"\n" +
+	"percentage\x18\x03 \x01(\x02R\n" +
+	"percentage\"\x89\x01\n" +
+	"\x10CompletionBucket\x120\n" +
+	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12 \n" +
+	"\vcompletions\x18\x02 \x01(\x03R\vcompletions\x12!\n" +
+	"\funique_users\x18\x03 \x01(\x03R\vuniqueUsers\"y\n" +
+	"\x0eRecommendation\x12\x17\n" +
+	"\aquiz_id\x18\x01 \x01(\tR\x06quizId\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x02R\x05score\x128\n" +
+	"\x06reason\x18\x03 \x01(\x0e2 .history.v1.RecommendationReasonR\x06reason\"J\n" +
+	"\x19GetRecommendationsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"b\n" +
+	"\x1aGetRecommendationsResponse\x12D\n" +
+	"\x0frecommendations\x18\x01 \x03(\v2\x1a.history.v1.RecommendationR\x0frecommendations*z\n" +
+	"\rStatsInterval\x12\x1e\n" +
+	"\x1aSTATS_INTERVAL_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12STATS_INTERVAL_DAY\x10\x01\x12\x17\n" +
+	"\x13STATS_INTERVAL_WEEK\x10\x02\x12\x18\n" +
+	"\x14STATS_INTERVAL_MONTH\x10\x03*\x89\x01\n" +
+	"\x14RecommendationReason\x12%\n" +
+	"!RECOMMENDATION_REASON_UNSPECIFIED\x10\x00\x12'\n" +
+	"#RECOMMENDATION_REASON_SIMILAR_USERS\x10\x01\x12!\n" +
+	"\x1dRECOMMENDATION_REASON_POPULAR\x10\x022\xcd\a\n" +
+	"\x0eHistoryService\x12T\n" +
+	"\n" +
+	"CreateItem\x12\x1d.history.v1.CreateItemRequest\x1a%.history.v1.QuizCompletionHistoryItem\"\x00\x12e\n" +
+	"\x12GetRecommendations\x12%.history.v1.GetRecommendationsRequest\x1a&.history.v1.GetRecommendationsResponse\"\x00\x12K\n" +
+	"\rGetSharedItem\x12 .history.v1.GetSharedItemRequest\x1a\x16.history.v1.SharedItem\"\x00\x12M\n" +
+	"\n" +
+	"MergeItems\x12\x1d.history.v1.MergeItemsRequest\x1a\x1e.history.v1.MergeItemsResponse\"\x00\x12H\n" +
+	"\fGetQuizStats\x12\x1f.history.v1.GetQuizStatsRequest\x1a\x15.history.v1.QuizStats\"\x00\x12\x89\x01\n" +
+	"\x0fBatchGetMyItems\x12\".history.v1.BatchGetMyItemsRequest\x1a!.history.v1.BatchGetItemsResponse\"/\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/history/me\x12\x82\x01\n" +
+	"\rBatchGetItems\x12 .history.v1.BatchGetItemsRequest\x1a!.history.v1.BatchGetItemsResponse\",\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/v1/history\x12}\n" +
+	"\tShareItem\x12\x1c.history.v1.ShareItemRequest\x1a\x16.history.v1.SharedItem\":\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/history/{id}/share\x12\x87\x01\n" +
+	"\vUnshareItem\x12\x1e.history.v1.UnshareItemRequest\x1a\x1f.history.v1.UnshareItemResponse\"7\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x1c*\x1a/api/v1/history/{id}/shareBNZLgithub.com/mibrgmv/whoami-server/auth/internal/protogen/history/v1;historyv1b\x06proto3"
+
+var (
+	file_history_proto_rawDescOnce sync.Once
+	file_history_proto_rawDescData []byte
+)
+
+func file_history_proto_rawDescGZIP() []byte {
+	file_history_proto_rawDescOnce.Do(func() {
+		file_history_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_history_proto_rawDesc), len(file_history_proto_rawDesc)))
+	})
+	return file_history_proto_rawDescData
+}
+
+var file_history_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_history_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_history_proto_goTypes = []any{
+	(StatsInterval)(0),                 // 0: history.v1.StatsInterval
+	(RecommendationReason)(0),          // 1: history.v1.RecommendationReason
+	(*QuizCompletionHistoryItem)(nil),  // 2: history.v1.QuizCompletionHistoryItem
+	(*QuizResultScore)(nil),            // 3: history.v1.QuizResultScore
+	(*CreateItemRequest)(nil),          // 4: history.v1.CreateItemRequest
+	(*BatchGetMyItemsRequest)(nil),     // 5: history.v1.BatchGetMyItemsRequest
+	(*BatchGetItemsRequest)(nil),       // 6: history.v1.BatchGetItemsRequest
+	(*BatchGetItemsResponse)(nil),      // 7: history.v1.BatchGetItemsResponse
+	(*SharedItem)(nil),                 // 8: history.v1.SharedItem
+	(*ShareItemRequest)(nil),           // 9: history.v1.ShareItemRequest
+	(*UnshareItemRequest)(nil),         // 10: history.v1.UnshareItemRequest
+	(*UnshareItemResponse)(nil),        // 11: history.v1.UnshareItemResponse
+	(*GetSharedItemRequest)(nil),       // 12: history.v1.GetSharedItemRequest
+	(*MergeItemsRequest)(nil),          // 13: history.v1.MergeItemsRequest
+	(*MergeItemsResponse)(nil),         // 14: history.v1.MergeItemsResponse
+	(*GetQuizStatsRequest)(nil),        // 15: history.v1.GetQuizStatsRequest
+	(*QuizStats)(nil),                  // 16: history.v1.QuizStats
+	(*ResultShare)(nil),                // 17: history.v1.ResultShare
+	(*CompletionBucket)(nil),           // 18: history.v1.CompletionBucket
+	(*Recommendation)(nil),             // 19: history.v1.Recommendation
+	(*GetRecommendationsRequest)(nil),  // 20: history.v1.GetRecommendationsRequest
+	(*GetRecommendationsResponse)(nil), // 21: history.v1.GetRecommendationsResponse
+	(*durationpb.Duration)(nil),        // 22: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),      // 23: google.protobuf.Timestamp
+	(*wrapperspb.StringValue)(nil),     // 24: google.protobuf.StringValue
+}
+var file_history_proto_depIdxs = []int32{
+	3,  // 0: history.v1.QuizCompletionHistoryItem.quiz_result_scores:type_name -> history.v1.QuizResultScore
+	22, // 1: history.v1.QuizCompletionHistoryItem.elapsed_time:type_name -> google.protobuf.Duration
+	23, // 2: history.v1.QuizCompletionHistoryItem.completed_at:type_name -> google.protobuf.Timestamp
+	2,  // 3: history.v1.CreateItemRequest.item:type_name -> history.v1.QuizCompletionHistoryItem
+	24, // 4: history.v1.BatchGetMyItemsRequest.quiz_ids:type_name -> google.protobuf.StringValue
+	24, // 5: history.v1.BatchGetItemsRequest.user_ids:type_name -> google.protobuf.StringValue
+	24, // 6: history.v1.BatchGetItemsRequest.quiz_ids:type_name -> google.protobuf.StringValue
+	2,  // 7: history.v1.BatchGetItemsResponse.items:type_name -> history.v1.QuizCompletionHistoryItem
+	2,  // 8: history.v1.SharedItem.item:type_name -> history.v1.QuizCompletionHistoryItem
+	23, // 9: history.v1.GetQuizStatsRequest.from:type_name -> google.protobuf.Timestamp
+	23, // 10: history.v1.GetQuizStatsRequest.to:type_name -> google.protobuf.Timestamp
+	0,  // 11: history.v1.GetQuizStatsRequest.interval:type_name -> history.v1.StatsInterval
+	17, // 12: history.v1.QuizStats.results:type_name -> history.v1.ResultShare
+	18, // 13: history.v1.QuizStats.buckets:type_name -> history.v1.CompletionBucket
+	0,  // 14: history.v1.QuizStats.interval:type_name -> history.v1.StatsInterval
+	23, // 15: history.v1.CompletionBucket.start:type_name -> google.protobuf.Timestamp
+	1,  // 16: history.v1.Recommendation.reason:type_name -> history.v1.RecommendationReason
+	19, // 17: history.v1.GetRecommendationsResponse.recommendations:type_name -> history.v1.Recommendation
+	4,  // 18: history.v1.HistoryService.CreateItem:input_type -> history.v1.CreateItemRequest
+	20, // 19: history.v1.HistoryService.GetRecommendations:input_type -> history.v1.GetRecommendationsRequest
+	12, // 20: history.v1.HistoryService.GetSharedItem:input_type -> history.v1.GetSharedItemRequest
+	13, // 21: history.v1.HistoryService.MergeItems:input_type -> history.v1.MergeItemsRequest
+	15, // 22: history.v1.HistoryService.GetQuizStats:input_type -> history.v1.GetQuizStatsRequest
+	5,  // 23: history.v1.HistoryService.BatchGetMyItems:input_type -> history.v1.BatchGetMyItemsRequest
+	6,  // 24: history.v1.HistoryService.BatchGetItems:input_type -> history.v1.BatchGetItemsRequest
+	9,  // 25: history.v1.HistoryService.ShareItem:input_type -> history.v1.ShareItemRequest
+	10, // 26: history.v1.HistoryService.UnshareItem:input_type -> history.v1.UnshareItemRequest
+	2,  // 27: history.v1.HistoryService.CreateItem:output_type -> history.v1.QuizCompletionHistoryItem
+	21, // 28: history.v1.HistoryService.GetRecommendations:output_type -> history.v1.GetRecommendationsResponse
+	8,  // 29: history.v1.HistoryService.GetSharedItem:output_type -> history.v1.SharedItem
+	14, // 30: history.v1.HistoryService.MergeItems:output_type -> history.v1.MergeItemsResponse
+	16, // 31: history.v1.HistoryService.GetQuizStats:output_type -> history.v1.QuizStats
+	7,  // 32: history.v1.HistoryService.BatchGetMyItems:output_type -> history.v1.BatchGetItemsResponse
+	7,  // 33: history.v1.HistoryService.BatchGetItems:output_type -> history.v1.BatchGetItemsResponse
+	8,  // 34: history.v1.HistoryService.ShareItem:output_type -> history.v1.SharedItem
+	11, // 35: history.v1.HistoryService.UnshareItem:output_type -> history.v1.UnshareItemResponse
+	27, // [27:36] is the sub-list for method output_type
+	18, // [18:27] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
+}
+
+func init() { file_history_proto_init() }
+func file_history_proto_init() {
+	if File_history_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_history_proto_rawDesc), len(file_history_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   20,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_history_proto_goTypes,
+		DependencyIndexes: file_history_proto_depIdxs,
+		EnumInfos:         file_history_proto_enumTypes,
+		MessageInfos:      file_history_proto_msgTypes,
+	}.Build()
+	File_history_proto = out.File
+	file_history_proto_goTypes = nil
+	file_history_proto_depIdxs = nil
+}
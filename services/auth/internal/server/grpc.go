@@ -7,6 +7,8 @@ import (
 	"github.com/mibrgmv/whoami-server/auth/internal/config"
 	authgrpc "github.com/mibrgmv/whoami-server/auth/internal/grpc"
 	authv1 "github.com/mibrgmv/whoami-server/auth/internal/protogen/auth/v1"
+	guestv1 "github.com/mibrgmv/whoami-server/auth/internal/protogen/guest/v1"
+	historyv1 "github.com/mibrgmv/whoami-server/auth/internal/protogen/history/v1"
 	"github.com/mibrgmv/whoami-server/auth/internal/service"
 	"github.com/mibrgmv/whoami-server/shared/grpc/interceptor"
 	"github.com/mibrgmv/whoami-server/shared/keycloak"
@@ -14,10 +16,10 @@ import (
 	"google.golang.org/grpc/reflection"
 )
 
-func NewGrpcServer(cfg config.Config) *grpc.Server {
+func NewGrpcServer(cfg config.Config, historyClient historyv1.HistoryServiceClient, guestClient guestv1.GuestServiceClient) *grpc.Server {
 	logger := log.New(os.Stderr, "", log.Ldate|log.Ltime|log.Lshortfile)
 	kc := keycloak.NewClient(&cfg.Keycloak)
-	authService := service.NewAuthService(kc)
+	authService := service.NewAuthService(kc, historyClient, guestClient)
 
 	server := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(interceptor.DefaultUnaryInterceptors(logger)...),
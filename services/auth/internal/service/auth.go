@@ -3,11 +3,22 @@ package service
 import (
 	"context"
 	"errors"
+	"log"
 	"strings"
 
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	guestv1 "github.com/mibrgmv/whoami-server/auth/internal/protogen/guest/v1"
+	historyv1 "github.com/mibrgmv/whoami-server/auth/internal/protogen/history/v1"
+	"github.com/mibrgmv/whoami-server/shared/guest"
 	"github.com/mibrgmv/whoami-server/shared/keycloak"
+	"google.golang.org/grpc/metadata"
 )
 
+// GuestIDKey is the metadata key the gateway passes the anonymous session of
+// a guest with, once it has checked the signature of the session.
+const GuestIDKey = "guest_id"
+
 var (
 	ErrInvalidCredentials = errors.New("invalid credentials")
 	ErrUsernameExists     = errors.New("username already exists")
@@ -23,12 +34,16 @@ type AuthService interface {
 }
 
 type authService struct {
-	keycloak *keycloak.Client
+	keycloak      *keycloak.Client
+	historyClient historyv1.HistoryServiceClient
+	guestClient   guestv1.GuestServiceClient
 }
 
-func NewAuthService(keycloak *keycloak.Client) AuthService {
+func NewAuthService(keycloak *keycloak.Client, historyClient historyv1.HistoryServiceClient, guestClient guestv1.GuestServiceClient) AuthService {
 	return &authService{
-		keycloak: keycloak,
+		keycloak:      keycloak,
+		historyClient: historyClient,
+		guestClient:   guestClient,
 	}
 }
 
@@ -42,6 +57,15 @@ func (s *authService) Login(ctx context.Context, username, password string) (str
 		return "", "", "", 0, ErrInvalidCredentials
 	}
 
+	// the token has just been issued by keycloak, so its subject is trusted
+	// without checking the signature
+	var claims keycloak.Claims
+	if _, _, err := jwt.NewParser().ParseUnverified(tokens.AccessToken, &claims); err != nil {
+		log.Printf("failed to parse access token: %v", err)
+	} else {
+		s.mergeGuest(ctx, claims.Subject)
+	}
+
 	return tokens.AccessToken, tokens.RefreshToken, tokens.TokenType, tokens.ExpiresIn, nil
 }
 
@@ -73,6 +97,8 @@ func (s *authService) Register(ctx context.Context, username, email, password, f
 		return "", "", "", err
 	}
 
+	s.mergeGuest(ctx, keycloakResp.ID)
+
 	return keycloakResp.ID, keycloakResp.Username, keycloakResp.Email, nil
 }
 
@@ -88,3 +114,38 @@ func (s *authService) RefreshToken(ctx context.Context, refreshToken string) (st
 func (s *authService) Logout(ctx context.Context, refreshToken string) error {
 	return s.keycloak.RevokeToken(ctx, refreshToken)
 }
+
+// mergeGuest moves the attempts and the quizzes taken in the guest session of
+// the caller, if any, to the user. The guest ID comes from the gateway, which
+// only passes it on for a session it has verified. Failing to merge does not
+// fail signing in, what failed to merge is left under the session then.
+// Completions of the guest still on their way to the history service are
+// recorded for the user once the history has been merged.
+func (s *authService) mergeGuest(ctx context.Context, userID string) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get(GuestIDKey)) != 1 {
+		return
+	}
+
+	guestID, err := uuid.Parse(md.Get(GuestIDKey)[0])
+	if err != nil || !guest.IsID(guestID) {
+		log.Printf("ignoring invalid guest session ID for user %s", userID)
+		return
+	}
+
+	_, err = s.guestClient.MergeGuest(ctx, &guestv1.MergeGuestRequest{
+		GuestId: guestID.String(),
+		UserId:  userID,
+	})
+	if err != nil {
+		log.Printf("failed to merge guest attempts into user %s: %v", userID, err)
+	}
+
+	_, err = s.historyClient.MergeItems(ctx, &historyv1.MergeItemsRequest{
+		FromUserId: guestID.String(),
+		ToUserId:   userID,
+	})
+	if err != nil {
+		log.Printf("failed to merge guest history into user %s: %v", userID, err)
+	}
+}
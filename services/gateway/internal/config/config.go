@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/mibrgmv/whoami-server/shared/grpc"
 	"github.com/mibrgmv/whoami-server/shared/http"
 	"github.com/mibrgmv/whoami-server/shared/keycloak"
@@ -13,4 +15,12 @@ type Config struct {
 	QuizService    grpc.Config     `mapstructure:"quiz_service"`
 	UserService    grpc.Config     `mapstructure:"user_service"`
 	HistoryService grpc.Config     `mapstructure:"history_service"`
+	Guest          GuestConfig     `mapstructure:"guest"`
+}
+
+// GuestConfig configures the anonymous sessions of guests: Secret signs them
+// and TTL is how long they are valid.
+type GuestConfig struct {
+	Secret string        `mapstructure:"secret"`
+	TTL    time.Duration `mapstructure:"ttl"`
 }
@@ -0,0 +1,139 @@
+package middleware
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/shared/guest"
+)
+
+// GuestSessionHeader carries the anonymous session of a guest.
+const GuestSessionHeader = "X-Guest-Session"
+
+type GuestConfig struct {
+	Secret string
+	TTL    time.Duration
+}
+
+// Guest issues and checks anonymous sessions that let guests take quizzes
+// without an account. A session is "<guest id>.<expiry>.<signature>", signed
+// with HMAC-SHA256, so the gateway needs no storage to check it.
+type Guest struct {
+	config GuestConfig
+}
+
+func NewGuest(cfg GuestConfig) (*Guest, error) {
+	if cfg.Secret == "" {
+		return nil, errors.New("guest session secret is required")
+	}
+
+	return &Guest{config: cfg}, nil
+}
+
+// Issue starts a new guest session.
+func (g *Guest) Issue(c *gin.Context) {
+	guestID := guest.NewID()
+	expiresAt := time.Now().Add(g.config.TTL).UTC().Truncate(time.Second)
+
+	c.JSON(http.StatusCreated, gin.H{
+		"session":    g.sign(guestID, expiresAt),
+		"guest_id":   guestID.String(),
+		"expires_at": expiresAt,
+	})
+}
+
+// Session puts the guest ID of the session in GuestSessionHeader into the
+// request context. Signed in users and requests without a session pass as
+// they are, an invalid or expired session is rejected.
+func (g *Guest) Session(c *gin.Context) {
+	if userID, ok := c.Request.Context().Value("user_id").(string); ok && userID != "" {
+		c.Next()
+		return
+	}
+
+	session := c.GetHeader(GuestSessionHeader)
+	if session == "" {
+		c.Next()
+		return
+	}
+
+	guestID, err := g.verify(session, time.Now())
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": fmt.Sprintf("Invalid guest session: %v", err)})
+		c.Abort()
+		return
+	}
+
+	c.Set("guest_id", guestID.String())
+
+	ctx := context.WithValue(c.Request.Context(), "guest_id", guestID.String())
+	c.Request = c.Request.WithContext(ctx)
+	c.Next()
+}
+
+func (g *Guest) sign(guestID uuid.UUID, expiresAt time.Time) string {
+	payload := guestID.String() + "." + strconv.FormatInt(expiresAt.Unix(), 10)
+	return payload + "." + base64.RawURLEncoding.EncodeToString(g.mac(payload))
+}
+
+func (g *Guest) verify(session string, now time.Time) (uuid.UUID, error) {
+	i := strings.LastIndex(session, ".")
+	if i < 0 {
+		return uuid.Nil, errors.New("malformed session")
+	}
+	payload, signature := session[:i], session[i+1:]
+
+	expected := base64.RawURLEncoding.EncodeToString(g.mac(payload))
+	if !hmac.Equal([]byte(signature), []byte(expected)) {
+		return uuid.Nil, errors.New("invalid signature")
+	}
+
+	id, expiry, _ := strings.Cut(payload, ".")
+	guestID, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid guest ID: %w", err)
+	}
+	if !guest.IsID(guestID) {
+		return uuid.Nil, errors.New("invalid guest ID")
+	}
+
+	expiresAt, err := strconv.ParseInt(expiry, 10, 64)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid expiry: %w", err)
+	}
+
+	if now.After(time.Unix(expiresAt, 0)) {
+		return uuid.Nil, errors.New("session expired")
+	}
+
+	return guestID, nil
+}
+
+func (g *Guest) mac(payload string) []byte {
+	h := hmac.New(sha256.New, []byte(g.config.Secret))
+	h.Write([]byte(payload))
+	return h.Sum(nil)
+}
+
+// Optional runs the handler only for requests with an Authorization header, so
+// that routes open to guests still authenticate users who sent a token.
+func Optional(handler gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetHeader("Authorization") == "" {
+			c.Next()
+			return
+		}
+
+		handler(c)
+	}
+}
@@ -90,6 +90,7 @@ func (v *jwtValidator) handler(c *gin.Context) {
 	ctx = context.WithValue(ctx, "username", claims.PreferredUsername)
 	ctx = context.WithValue(ctx, "email", claims.Email)
 	ctx = context.WithValue(ctx, "email_verified", claims.EmailVerified)
+	ctx = context.WithValue(ctx, "roles", claims.RealmRoles())
 
 	c.Request = c.Request.WithContext(ctx)
 	c.Next()
@@ -2,12 +2,14 @@ package middleware
 
 import (
 	"net/http"
+	"slices"
 
 	"github.com/gin-gonic/gin"
 	"github.com/mibrgmv/whoami-server/shared/keycloak"
 )
 
-func RequireRole(role string) gin.HandlerFunc {
+// RequireRole lets through users with any of the given realm roles.
+func RequireRole(roles ...string) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		claims, exists := c.Get("claims")
 		if !exists {
@@ -23,12 +25,10 @@ func RequireRole(role string) gin.HandlerFunc {
 			return
 		}
 
-		if realmAccess, ok := keycloakClaims.RealmAccess["roles"].([]interface{}); ok {
-			for _, r := range realmAccess {
-				if roleStr, ok := r.(string); ok && roleStr == role {
-					c.Next()
-					return
-				}
+		for _, role := range roles {
+			if slices.Contains(keycloakClaims.RealmRoles(), role) {
+				c.Next()
+				return
 			}
 		}
 
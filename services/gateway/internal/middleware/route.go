@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Routes are route patterns like "GET /api/v1/quizzes/{id}", where a {param}
+// matches any single path segment.
+type Routes []string
+
+// Match reports whether a request with the method and path matches one of the
+// routes.
+func (r Routes) Match(method, path string) bool {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for _, route := range r {
+		routeMethod, pattern, _ := strings.Cut(route, " ")
+		if routeMethod == method && matchSegments(strings.Split(strings.Trim(pattern, "/"), "/"), segments) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchSegments(pattern, segments []string) bool {
+	if len(pattern) != len(segments) {
+		return false
+	}
+
+	for i, p := range pattern {
+		if strings.HasPrefix(p, "{") && strings.HasSuffix(p, "}") {
+			if segments[i] == "" {
+				return false
+			}
+			continue
+		}
+		if p != segments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// When runs the handler only for requests matching the routes.
+func When(routes Routes, handler gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !routes.Match(c.Request.Method, c.Request.URL.Path) {
+			c.Next()
+			return
+		}
+
+		handler(c)
+	}
+}
+
+// Unless runs the handler only for requests not matching the routes.
+func Unless(routes Routes, handler gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if routes.Match(c.Request.Method, c.Request.URL.Path) {
+			c.Next()
+			return
+		}
+
+		handler(c)
+	}
+}
@@ -0,0 +1,49 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mibrgmv/whoami-server/gateway/internal/middleware"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWhenUnless(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	routes := middleware.Routes{"GET /quizzes/{id}"}
+	reject := func(c *gin.Context) {
+		c.AbortWithStatus(http.StatusUnauthorized)
+	}
+
+	newRouter := func(handler gin.HandlerFunc) *gin.Engine {
+		router := gin.New()
+		router.Use(handler)
+		router.Any("/quizzes/*path", func(c *gin.Context) { c.Status(http.StatusOK) })
+		return router
+	}
+
+	tests := []struct {
+		name   string
+		router *gin.Engine
+		method string
+		path   string
+		want   int
+	}{
+		{name: "When, matching", router: newRouter(middleware.When(routes, reject)), method: http.MethodGet, path: "/quizzes/1", want: http.StatusUnauthorized},
+		{name: "When, other method", router: newRouter(middleware.When(routes, reject)), method: http.MethodPut, path: "/quizzes/1", want: http.StatusOK},
+		{name: "When, other path", router: newRouter(middleware.When(routes, reject)), method: http.MethodGet, path: "/quizzes/1/stats", want: http.StatusOK},
+		{name: "Unless, matching", router: newRouter(middleware.Unless(routes, reject)), method: http.MethodGet, path: "/quizzes/1", want: http.StatusOK},
+		{name: "Unless, other path", router: newRouter(middleware.Unless(routes, reject)), method: http.MethodGet, path: "/quizzes/1/stats", want: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			tt.router.ServeHTTP(w, httptest.NewRequest(tt.method, tt.path, nil))
+			assert.Equal(t, tt.want, w.Code)
+		})
+	}
+}
@@ -0,0 +1,691 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v5.29.3
+// source: attempt.proto
+
+package attemptv1
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	v1 "github.com/mibrgmv/whoami-server/gateway/internal/protogen/question/v1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AttemptStatus int32
+
+const (
+	AttemptStatus_ATTEMPT_STATUS_UNSPECIFIED AttemptStatus = 0
+	AttemptStatus_ATTEMPT_STATUS_IN_PROGRESS AttemptStatus = 1
+	AttemptStatus_ATTEMPT_STATUS_FINISHED    AttemptStatus = 2
+)
+
+// Enum value maps for AttemptStatus.
+var (
+	AttemptStatus_name = map[int32]string{
+		0: "ATTEMPT_STATUS_UNSPECIFIED",
+		1: "ATTEMPT_STATUS_IN_PROGRESS",
+		2: "ATTEMPT_STATUS_FINISHED",
+	}
+	AttemptStatus_value = map[string]int32{
+		"ATTEMPT_STATUS_UNSPECIFIED": 0,
+		"ATTEMPT_STATUS_IN_PROGRESS": 1,
+		"ATTEMPT_STATUS_FINISHED":    2,
+	}
+)
+
+func (x AttemptStatus) Enum() *AttemptStatus {
+	p := new(AttemptStatus)
+	*p = x
+	return p
+}
+
+func (x AttemptStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AttemptStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_attempt_proto_enumTypes[0].Descriptor()
+}
+
+func (AttemptStatus) Type() protoreflect.EnumType {
+	return &file_attempt_proto_enumTypes[0]
+}
+
+func (x AttemptStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AttemptStatus.Descriptor instead.
+func (AttemptStatus) EnumDescriptor() ([]byte, []int) {
+	return file_attempt_proto_rawDescGZIP(), []int{0}
+}
+
+type Attempt struct {
+	state          protoimpl.MessageState      `protogen:"open.v1"`
+	Id             string                      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	QuizId         string                      `protobuf:"bytes,2,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	QuizVersionId  string                      `protobuf:"bytes,3,opt,name=quiz_version_id,json=quizVersionId,proto3" json:"quiz_version_id,omitempty"`
+	UserId         string                      `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status         AttemptStatus               `protobuf:"varint,5,opt,name=status,proto3,enum=attempt.v1.AttemptStatus" json:"status,omitempty"`
+	Answers        []*v1.Answer                `protobuf:"bytes,6,rep,name=answers,proto3" json:"answers,omitempty"`
+	Evaluation     *v1.EvaluateAnswersResponse `protobuf:"bytes,7,opt,name=evaluation,proto3" json:"evaluation,omitempty"`
+	StartedAt      *timestamppb.Timestamp      `protobuf:"bytes,8,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt     *timestamppb.Timestamp      `protobuf:"bytes,9,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	Deadline       *timestamppb.Timestamp      `protobuf:"bytes,10,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	AnswerDeadline *timestamppb.Timestamp      `protobuf:"bytes,11,opt,name=answer_deadline,json=answerDeadline,proto3" json:"answer_deadline,omitempty"`
+	ElapsedTime    *durationpb.Duration        `protobuf:"bytes,12,opt,name=elapsed_time,json=elapsedTime,proto3" json:"elapsed_time,omitempty"`
+	QuestionIds    []string                    `protobuf:"bytes,13,rep,name=question_ids,json=questionIds,proto3" json:"question_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Attempt) Reset() {
+	*x = Attempt{}
+	mi := &file_attempt_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attempt) ProtoMessage() {}
+
+func (x *Attempt) ProtoReflect() protoreflect.Message {
+	mi := &file_attempt_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attempt.ProtoReflect.Descriptor instead.
+func (*Attempt) Descriptor() ([]byte, []int) {
+	return file_attempt_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Attempt) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Attempt) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *Attempt) GetQuizVersionId() string {
+	if x != nil {
+		return x.QuizVersionId
+	}
+	return ""
+}
+
+func (x *Attempt) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Attempt) GetStatus() AttemptStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AttemptStatus_ATTEMPT_STATUS_UNSPECIFIED
+}
+
+func (x *Attempt) GetAnswers() []*v1.Answer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *Attempt) GetEvaluation() *v1.EvaluateAnswersResponse {
+	if x != nil {
+		return x.Evaluation
+	}
+	return nil
+}
+
+func (x *Attempt) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *Attempt) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *Attempt) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *Attempt) GetAnswerDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AnswerDeadline
+	}
+	return nil
+}
+
+func (x *Attempt) GetElapsedTime() *durationpb.Duration {
+	if x != nil {
+		return x.ElapsedTime
+	}
+	return nil
+}
+
+func (x *Attempt) GetQuestionIds() []string {
+	if x != nil {
+		return x.QuestionIds
+	}
+	return nil
+}
+
+type StartAttemptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuizId        string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartAttemptRequest) Reset() {
+	*x = StartAttemptRequest{}
+	mi := &file_attempt_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartAttemptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartAttemptRequest) ProtoMessage() {}
+
+func (x *StartAttemptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_attempt_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartAttemptRequest.ProtoReflect.Descriptor instead.
+func (*StartAttemptRequest) Descriptor() ([]byte, []int) {
+	return file_attempt_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StartAttemptRequest) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+type GetAttemptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	QuizId        string                 `protobuf:"bytes,2,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttemptRequest) Reset() {
+	*x = GetAttemptRequest{}
+	mi := &file_attempt_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttemptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttemptRequest) ProtoMessage() {}
+
+func (x *GetAttemptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_attempt_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttemptRequest.ProtoReflect.Descriptor instead.
+func (*GetAttemptRequest) Descriptor() ([]byte, []int) {
+	return file_attempt_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetAttemptRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetAttemptRequest) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+type SubmitAnswerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttemptId     string                 `protobuf:"bytes,1,opt,name=attempt_id,json=attemptId,proto3" json:"attempt_id,omitempty"`
+	QuizId        string                 `protobuf:"bytes,2,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	Answer        *v1.Answer             `protobuf:"bytes,3,opt,name=answer,proto3" json:"answer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitAnswerRequest) Reset() {
+	*x = SubmitAnswerRequest{}
+	mi := &file_attempt_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitAnswerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAnswerRequest) ProtoMessage() {}
+
+func (x *SubmitAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_attempt_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAnswerRequest.ProtoReflect.Descriptor instead.
+func (*SubmitAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_attempt_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SubmitAnswerRequest) GetAttemptId() string {
+	if x != nil {
+		return x.AttemptId
+	}
+	return ""
+}
+
+func (x *SubmitAnswerRequest) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *SubmitAnswerRequest) GetAnswer() *v1.Answer {
+	if x != nil {
+		return x.Answer
+	}
+	return nil
+}
+
+type GetNextQuestionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AttemptId      string                 `protobuf:"bytes,1,opt,name=attempt_id,json=attemptId,proto3" json:"attempt_id,omitempty"`
+	QuizId         string                 `protobuf:"bytes,2,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	ShuffleOptions bool                   `protobuf:"varint,3,opt,name=shuffle_options,json=shuffleOptions,proto3" json:"shuffle_options,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetNextQuestionRequest) Reset() {
+	*x = GetNextQuestionRequest{}
+	mi := &file_attempt_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNextQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNextQuestionRequest) ProtoMessage() {}
+
+func (x *GetNextQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_attempt_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNextQuestionRequest.ProtoReflect.Descriptor instead.
+func (*GetNextQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_attempt_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetNextQuestionRequest) GetAttemptId() string {
+	if x != nil {
+		return x.AttemptId
+	}
+	return ""
+}
+
+func (x *GetNextQuestionRequest) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *GetNextQuestionRequest) GetShuffleOptions() bool {
+	if x != nil {
+		return x.ShuffleOptions
+	}
+	return false
+}
+
+type GetNextQuestionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Question      *v1.QuestionResponse   `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
+	Completed     bool                   `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNextQuestionResponse) Reset() {
+	*x = GetNextQuestionResponse{}
+	mi := &file_attempt_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNextQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNextQuestionResponse) ProtoMessage() {}
+
+func (x *GetNextQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_attempt_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNextQuestionResponse.ProtoReflect.Descriptor instead.
+func (*GetNextQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_attempt_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetNextQuestionResponse) GetQuestion() *v1.QuestionResponse {
+	if x != nil {
+		return x.Question
+	}
+	return nil
+}
+
+func (x *GetNextQuestionResponse) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+type FinishAttemptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	QuizId        string                 `protobuf:"bytes,2,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinishAttemptRequest) Reset() {
+	*x = FinishAttemptRequest{}
+	mi := &file_attempt_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishAttemptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishAttemptRequest) ProtoMessage() {}
+
+func (x *FinishAttemptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_attempt_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishAttemptRequest.ProtoReflect.Descriptor instead.
+func (*FinishAttemptRequest) Descriptor() ([]byte, []int) {
+	return file_attempt_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FinishAttemptRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FinishAttemptRequest) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+var File_attempt_proto protoreflect.FileDescriptor
+
+const file_attempt_proto_rawDesc = "" +
+	"\n" +
+	"\rattempt.proto\x12\n" +
+	"attempt.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x0equestion.proto\"\xf1\x04\n" +
+	"\aAttempt\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\aquiz_id\x18\x02 \x01(\tR\x06quizId\x12&\n" +
+	"\x0fquiz_version_id\x18\x03 \x01(\tR\rquizVersionId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x121\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x19.attempt.v1.AttemptStatusR\x06status\x12-\n" +
+	"\aanswers\x18\x06 \x03(\v2\x13.question.v1.AnswerR\aanswers\x12D\n" +
+	"\n" +
+	"evaluation\x18\a \x01(\v2$.question.v1.EvaluateAnswersResponseR\n" +
+	"evaluation\x129\n" +
+	"\n" +
+	"started_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\x126\n" +
+	"\bdeadline\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\bdeadline\x12C\n" +
+	"\x0fanswer_deadline\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x0eanswerDeadline\x12<\n" +
+	"\felapsed_time\x18\f \x01(\v2\x19.google.protobuf.DurationR\velapsedTime\x12!\n" +
+	"\fquestion_ids\x18\r \x03(\tR\vquestionIds\".\n" +
+	"\x13StartAttemptRequest\x12\x17\n" +
+	"\aquiz_id\x18\x01 \x01(\tR\x06quizId\"<\n" +
+	"\x11GetAttemptRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\aquiz_id\x18\x02 \x01(\tR\x06quizId\"z\n" +
+	"\x13SubmitAnswerRequest\x12\x1d\n" +
+	"\n" +
+	"attempt_id\x18\x01 \x01(\tR\tattemptId\x12\x17\n" +
+	"\aquiz_id\x18\x02 \x01(\tR\x06quizId\x12+\n" +
+	"\x06answer\x18\x03 \x01(\v2\x13.question.v1.AnswerR\x06answer\"y\n" +
+	"\x16GetNextQuestionRequest\x12\x1d\n" +
+	"\n" +
+	"attempt_id\x18\x01 \x01(\tR\tattemptId\x12\x17\n" +
+	"\aquiz_id\x18\x02 \x01(\tR\x06quizId\x12'\n" +
+	"\x0fshuffle_options\x18\x03 \x01(\bR\x0eshuffleOptions\"r\n" +
+	"\x17GetNextQuestionResponse\x129\n" +
+	"\bquestion\x18\x01 \x01(\v2\x1d.question.v1.QuestionResponseR\bquestion\x12\x1c\n" +
+	"\tcompleted\x18\x02 \x01(\bR\tcompleted\"?\n" +
+	"\x14FinishAttemptRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\aquiz_id\x18\x02 \x01(\tR\x06quizId*l\n" +
+	"\rAttemptStatus\x12\x1e\n" +
+	"\x1aATTEMPT_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aATTEMPT_STATUS_IN_PROGRESS\x10\x01\x12\x1b\n" +
+	"\x17ATTEMPT_STATUS_FINISHED\x10\x022\x8d\x06\n" +
+	"\x0eAttemptService\x12\x88\x01\n" +
+	"\fStartAttempt\x12\x1f.attempt.v1.StartAttemptRequest\x1a\x13.attempt.v1.Attempt\"B\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02':\x01*\"\"/api/v1/quizzes/{quiz_id}/attempts\x12\x86\x01\n" +
+	"\n" +
+	"GetAttempt\x12\x1d.attempt.v1.GetAttemptRequest\x1a\x13.attempt.v1.Attempt\"D\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02)\x12'/api/v1/quizzes/{quiz_id}/attempts/{id}\x12\x9d\x01\n" +
+	"\fSubmitAnswer\x12\x1f.attempt.v1.SubmitAnswerRequest\x1a\x13.attempt.v1.Attempt\"W\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02<:\x01*\"7/api/v1/quizzes/{quiz_id}/attempts/{attempt_id}/answers\x12\xad\x01\n" +
+	"\x0fGetNextQuestion\x12\".attempt.v1.GetNextQuestionRequest\x1a#.attempt.v1.GetNextQuestionResponse\"Q\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x026\x124/api/v1/quizzes/{quiz_id}/attempts/{attempt_id}/next\x12\x96\x01\n" +
+	"\rFinishAttempt\x12 .attempt.v1.FinishAttemptRequest\x1a\x13.attempt.v1.Attempt\"N\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x023:\x01*\"./api/v1/quizzes/{quiz_id}/attempts/{id}/finishBQZOgithub.com/mibrgmv/whoami-server/gateway/internal/protogen/attempt/v1;attemptv1b\x06proto3"
+
+var (
+	file_attempt_proto_rawDescOnce sync.Once
+	file_attempt_proto_rawDescData []byte
+)
+
+func file_attempt_proto_rawDescGZIP() []byte {
+	file_attempt_proto_rawDescOnce.Do(func() {
+		file_attempt_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_attempt_proto_rawDesc), len(file_attempt_proto_rawDesc)))
+	})
+	return file_attempt_proto_rawDescData
+}
+
+var file_attempt_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_attempt_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_attempt_proto_goTypes = []any{
+	(AttemptStatus)(0),                 // 0: attempt.v1.AttemptStatus
+	(*Attempt)(nil),                    // 1: attempt.v1.Attempt
+	(*StartAttemptRequest)(nil),        // 2: attempt.v1.StartAttemptRequest
+	(*GetAttemptRequest)(nil),          // 3: attempt.v1.GetAttemptRequest
+	(*SubmitAnswerRequest)(nil),        // 4: attempt.v1.SubmitAnswerRequest
+	(*GetNextQuestionRequest)(nil),     // 5: attempt.v1.GetNextQuestionRequest
+	(*GetNextQuestionResponse)(nil),    // 6: attempt.v1.GetNextQuestionResponse
+	(*FinishAttemptRequest)(nil),       // 7: attempt.v1.FinishAttemptRequest
+	(*v1.Answer)(nil),                  // 8: question.v1.Answer
+	(*v1.EvaluateAnswersResponse)(nil), // 9: question.v1.EvaluateAnswersResponse
+	(*timestamppb.Timestamp)(nil),      // 10: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),        // 11: google.protobuf.Duration
+	(*v1.QuestionResponse)(nil),        // 12: question.v1.QuestionResponse
+}
+var file_attempt_proto_depIdxs = []int32{
+	0,  // 0: attempt.v1.Attempt.status:type_name -> attempt.v1.AttemptStatus
+	8,  // 1: attempt.v1.Attempt.answers:type_name -> question.v1.Answer
+	9,  // 2: attempt.v1.Attempt.evaluation:type_name -> question.v1.EvaluateAnswersResponse
+	10, // 3: attempt.v1.Attempt.started_at:type_name -> google.protobuf.Timestamp
+	10, // 4: attempt.v1.Attempt.finished_at:type_name -> google.protobuf.Timestamp
+	10, // 5: attempt.v1.Attempt.deadline:type_name -> google.protobuf.Timestamp
+	10, // 6: attempt.v1.Attempt.answer_deadline:type_name -> google.protobuf.Timestamp
+	11, // 7: attempt.v1.Attempt.elapsed_time:type_name -> google.protobuf.Duration
+	8,  // 8: attempt.v1.SubmitAnswerRequest.answer:type_name -> question.v1.Answer
+	12, // 9: attempt.v1.GetNextQuestionResponse.question:type_name -> question.v1.QuestionResponse
+	2,  // 10: attempt.v1.AttemptService.StartAttempt:input_type -> attempt.v1.StartAttemptRequest
+	3,  // 11: attempt.v1.AttemptService.GetAttempt:input_type -> attempt.v1.GetAttemptRequest
+	4,  // 12: attempt.v1.AttemptService.SubmitAnswer:input_type -> attempt.v1.SubmitAnswerRequest
+	5,  // 13: attempt.v1.AttemptService.GetNextQuestion:input_type -> attempt.v1.GetNextQuestionRequest
+	7,  // 14: attempt.v1.AttemptService.FinishAttempt:input_type -> attempt.v1.FinishAttemptRequest
+	1,  // 15: attempt.v1.AttemptService.StartAttempt:output_type -> attempt.v1.Attempt
+	1,  // 16: attempt.v1.AttemptService.GetAttempt:output_type -> attempt.v1.Attempt
+	1,  // 17: attempt.v1.AttemptService.SubmitAnswer:output_type -> attempt.v1.Attempt
+	6,  // 18: attempt.v1.AttemptService.GetNextQuestion:output_type -> attempt.v1.GetNextQuestionResponse
+	1,  // 19: attempt.v1.AttemptService.FinishAttempt:output_type -> attempt.v1.Attempt
+	15, // [15:20] is the sub-list for method output_type
+	10, // [10:15] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_attempt_proto_init() }
+func file_attempt_proto_init() {
+	if File_attempt_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_attempt_proto_rawDesc), len(file_attempt_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_attempt_proto_goTypes,
+		DependencyIndexes: file_attempt_proto_depIdxs,
+		EnumInfos:         file_attempt_proto_enumTypes,
+		MessageInfos:      file_attempt_proto_msgTypes,
+	}.Build()
+	File_attempt_proto = out.File
+	file_attempt_proto_goTypes = nil
+	file_attempt_proto_depIdxs = nil
+}
@@ -0,0 +1,273 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: attempt.proto
+
+package attemptv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AttemptService_StartAttempt_FullMethodName    = "/attempt.v1.AttemptService/StartAttempt"
+	AttemptService_GetAttempt_FullMethodName      = "/attempt.v1.AttemptService/GetAttempt"
+	AttemptService_SubmitAnswer_FullMethodName    = "/attempt.v1.AttemptService/SubmitAnswer"
+	AttemptService_GetNextQuestion_FullMethodName = "/attempt.v1.AttemptService/GetNextQuestion"
+	AttemptService_FinishAttempt_FullMethodName   = "/attempt.v1.AttemptService/FinishAttempt"
+)
+
+// AttemptServiceClient is the client API for AttemptService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AttemptServiceClient interface {
+	StartAttempt(ctx context.Context, in *StartAttemptRequest, opts ...grpc.CallOption) (*Attempt, error)
+	GetAttempt(ctx context.Context, in *GetAttemptRequest, opts ...grpc.CallOption) (*Attempt, error)
+	SubmitAnswer(ctx context.Context, in *SubmitAnswerRequest, opts ...grpc.CallOption) (*Attempt, error)
+	GetNextQuestion(ctx context.Context, in *GetNextQuestionRequest, opts ...grpc.CallOption) (*GetNextQuestionResponse, error)
+	FinishAttempt(ctx context.Context, in *FinishAttemptRequest, opts ...grpc.CallOption) (*Attempt, error)
+}
+
+type attemptServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAttemptServiceClient(cc grpc.ClientConnInterface) AttemptServiceClient {
+	return &attemptServiceClient{cc}
+}
+
+func (c *attemptServiceClient) StartAttempt(ctx context.Context, in *StartAttemptRequest, opts ...grpc.CallOption) (*Attempt, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Attempt)
+	err := c.cc.Invoke(ctx, AttemptService_StartAttempt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attemptServiceClient) GetAttempt(ctx context.Context, in *GetAttemptRequest, opts ...grpc.CallOption) (*Attempt, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Attempt)
+	err := c.cc.Invoke(ctx, AttemptService_GetAttempt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attemptServiceClient) SubmitAnswer(ctx context.Context, in *SubmitAnswerRequest, opts ...grpc.CallOption) (*Attempt, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Attempt)
+	err := c.cc.Invoke(ctx, AttemptService_SubmitAnswer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attemptServiceClient) GetNextQuestion(ctx context.Context, in *GetNextQuestionRequest, opts ...grpc.CallOption) (*GetNextQuestionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNextQuestionResponse)
+	err := c.cc.Invoke(ctx, AttemptService_GetNextQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attemptServiceClient) FinishAttempt(ctx context.Context, in *FinishAttemptRequest, opts ...grpc.CallOption) (*Attempt, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Attempt)
+	err := c.cc.Invoke(ctx, AttemptService_FinishAttempt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AttemptServiceServer is the server API for AttemptService service.
+// All implementations must embed UnimplementedAttemptServiceServer
+// for forward compatibility.
+type AttemptServiceServer interface {
+	StartAttempt(context.Context, *StartAttemptRequest) (*Attempt, error)
+	GetAttempt(context.Context, *GetAttemptRequest) (*Attempt, error)
+	SubmitAnswer(context.Context, *SubmitAnswerRequest) (*Attempt, error)
+	GetNextQuestion(context.Context, *GetNextQuestionRequest) (*GetNextQuestionResponse, error)
+	FinishAttempt(context.Context, *FinishAttemptRequest) (*Attempt, error)
+	mustEmbedUnimplementedAttemptServiceServer()
+}
+
+// UnimplementedAttemptServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAttemptServiceServer struct{}
+
+func (UnimplementedAttemptServiceServer) StartAttempt(context.Context, *StartAttemptRequest) (*Attempt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartAttempt not implemented")
+}
+func (UnimplementedAttemptServiceServer) GetAttempt(context.Context, *GetAttemptRequest) (*Attempt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttempt not implemented")
+}
+func (UnimplementedAttemptServiceServer) SubmitAnswer(context.Context, *SubmitAnswerRequest) (*Attempt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAnswer not implemented")
+}
+func (UnimplementedAttemptServiceServer) GetNextQuestion(context.Context, *GetNextQuestionRequest) (*GetNextQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNextQuestion not implemented")
+}
+func (UnimplementedAttemptServiceServer) FinishAttempt(context.Context, *FinishAttemptRequest) (*Attempt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishAttempt not implemented")
+}
+func (UnimplementedAttemptServiceServer) mustEmbedUnimplementedAttemptServiceServer() {}
+func (UnimplementedAttemptServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeAttemptServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AttemptServiceServer will
+// result in compilation errors.
+type UnsafeAttemptServiceServer interface {
+	mustEmbedUnimplementedAttemptServiceServer()
+}
+
+func RegisterAttemptServiceServer(s grpc.ServiceRegistrar, srv AttemptServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAttemptServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AttemptService_ServiceDesc, srv)
+}
+
+func _AttemptService_StartAttempt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartAttemptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttemptServiceServer).StartAttempt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttemptService_StartAttempt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttemptServiceServer).StartAttempt(ctx, req.(*StartAttemptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttemptService_GetAttempt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttemptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttemptServiceServer).GetAttempt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttemptService_GetAttempt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttemptServiceServer).GetAttempt(ctx, req.(*GetAttemptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttemptService_SubmitAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitAnswerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttemptServiceServer).SubmitAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttemptService_SubmitAnswer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttemptServiceServer).SubmitAnswer(ctx, req.(*SubmitAnswerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttemptService_GetNextQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNextQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttemptServiceServer).GetNextQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttemptService_GetNextQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttemptServiceServer).GetNextQuestion(ctx, req.(*GetNextQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttemptService_FinishAttempt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishAttemptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttemptServiceServer).FinishAttempt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttemptService_FinishAttempt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttemptServiceServer).FinishAttempt(ctx, req.(*FinishAttemptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AttemptService_ServiceDesc is the grpc.ServiceDesc for AttemptService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AttemptService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "attempt.v1.AttemptService",
+	HandlerType: (*AttemptServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StartAttempt",
+			Handler:    _AttemptService_StartAttempt_Handler,
+		},
+		{
+			MethodName: "GetAttempt",
+			Handler:    _AttemptService_GetAttempt_Handler,
+		},
+		{
+			MethodName: "SubmitAnswer",
+			Handler:    _AttemptService_SubmitAnswer_Handler,
+		},
+		{
+			MethodName: "GetNextQuestion",
+			Handler:    _AttemptService_GetNextQuestion_Handler,
+		},
+		{
+			MethodName: "FinishAttempt",
+			Handler:    _AttemptService_FinishAttempt_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "attempt.proto",
+}
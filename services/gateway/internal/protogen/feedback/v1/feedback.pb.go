@@ -0,0 +1,1373 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v5.29.3
+// source: feedback.proto
+
+package feedbackv1
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CommentStatus int32
+
+const (
+	CommentStatus_COMMENT_STATUS_UNSPECIFIED CommentStatus = 0
+	CommentStatus_COMMENT_STATUS_VISIBLE     CommentStatus = 1
+	CommentStatus_COMMENT_STATUS_DELETED     CommentStatus = 2
+	CommentStatus_COMMENT_STATUS_HIDDEN      CommentStatus = 3
+)
+
+// Enum value maps for CommentStatus.
+var (
+	CommentStatus_name = map[int32]string{
+		0: "COMMENT_STATUS_UNSPECIFIED",
+		1: "COMMENT_STATUS_VISIBLE",
+		2: "COMMENT_STATUS_DELETED",
+		3: "COMMENT_STATUS_HIDDEN",
+	}
+	CommentStatus_value = map[string]int32{
+		"COMMENT_STATUS_UNSPECIFIED": 0,
+		"COMMENT_STATUS_VISIBLE":     1,
+		"COMMENT_STATUS_DELETED":     2,
+		"COMMENT_STATUS_HIDDEN":      3,
+	}
+)
+
+func (x CommentStatus) Enum() *CommentStatus {
+	p := new(CommentStatus)
+	*p = x
+	return p
+}
+
+func (x CommentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_feedback_proto_enumTypes[0].Descriptor()
+}
+
+func (CommentStatus) Type() protoreflect.EnumType {
+	return &file_feedback_proto_enumTypes[0]
+}
+
+func (x CommentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommentStatus.Descriptor instead.
+func (CommentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_feedback_proto_rawDescGZIP(), []int{0}
+}
+
+type ModerationAction int32
+
+const (
+	ModerationAction_MODERATION_ACTION_UNSPECIFIED ModerationAction = 0
+	ModerationAction_MODERATION_ACTION_HIDE        ModerationAction = 1
+	ModerationAction_MODERATION_ACTION_RESTORE     ModerationAction = 2
+)
+
+// Enum value maps for ModerationAction.
+var (
+	ModerationAction_name = map[int32]string{
+		0: "MODERATION_ACTION_UNSPECIFIED",
+		1: "MODERATION_ACTION_HIDE",
+		2: "MODERATION_ACTION_RESTORE",
+	}
+	ModerationAction_value = map[string]int32{
+		"MODERATION_ACTION_UNSPECIFIED": 0,
+		"MODERATION_ACTION_HIDE":        1,
+		"MODERATION_ACTION_RESTORE":     2,
+	}
+)
+
+func (x ModerationAction) Enum() *ModerationAction {
+	p := new(ModerationAction)
+	*p = x
+	return p
+}
+
+func (x ModerationAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModerationAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_feedback_proto_enumTypes[1].Descriptor()
+}
+
+func (ModerationAction) Type() protoreflect.EnumType {
+	return &file_feedback_proto_enumTypes[1]
+}
+
+func (x ModerationAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModerationAction.Descriptor instead.
+func (ModerationAction) EnumDescriptor() ([]byte, []int) {
+	return file_feedback_proto_rawDescGZIP(), []int{1}
+}
+
+type Rating struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuizId        string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Stars         int32                  `protobuf:"varint,3,opt,name=stars,proto3" json:"stars,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Rating) Reset() {
+	*x = Rating{}
+	mi := &file_feedback_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rating) ProtoMessage() {}
+
+func (x *Rating) ProtoReflect() protoreflect.Message {
+	mi := &file_feedback_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rating.ProtoReflect.Descriptor instead.
+func (*Rating) Descriptor() ([]byte, []int) {
+	return file_feedback_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Rating) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *Rating) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Rating) GetStars() int32 {
+	if x != nil {
+		return x.Stars
+	}
+	return 0
+}
+
+func (x *Rating) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Rating) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type QuizFeedback struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuizId        string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	RatingAverage float32                `protobuf:"fixed32,2,opt,name=rating_average,json=ratingAverage,proto3" json:"rating_average,omitempty"`
+	RatingCount   int64                  `protobuf:"varint,3,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`
+	LikeCount     int64                  `protobuf:"varint,4,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	CommentCount  int64                  `protobuf:"varint,5,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
+	MyRating      int32                  `protobuf:"varint,6,opt,name=my_rating,json=myRating,proto3" json:"my_rating,omitempty"`
+	Liked         bool                   `protobuf:"varint,7,opt,name=liked,proto3" json:"liked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizFeedback) Reset() {
+	*x = QuizFeedback{}
+	mi := &file_feedback_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizFeedback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizFeedback) ProtoMessage() {}
+
+func (x *QuizFeedback) ProtoReflect() protoreflect.Message {
+	mi := &file_feedback_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizFeedback.ProtoReflect.Descriptor instead.
+func (*QuizFeedback) Descriptor() ([]byte, []int) {
+	return file_feedback_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QuizFeedback) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *QuizFeedback) GetRatingAverage() float32 {
+	if x != nil {
+		return x.RatingAverage
+	}
+	return 0
+}
+
+func (x *QuizFeedback) GetRatingCount() int64 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+func (x *QuizFeedback) GetLikeCount() int64 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+func (x *QuizFeedback) GetCommentCount() int64 {
+	if x != nil {
+		return x.CommentCount
+	}
+	return 0
+}
+
+func (x *QuizFeedback) GetMyRating() int32 {
+	if x != nil {
+		return x.MyRating
+	}
+	return 0
+}
+
+func (x *QuizFeedback) GetLiked() bool {
+	if x != nil {
+		return x.Liked
+	}
+	return false
+}
+
+type Comment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	QuizId        string                 `protobuf:"bytes,2,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	ParentId      string                 `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	AuthorId      string                 `protobuf:"bytes,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Body          string                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	Status        CommentStatus          `protobuf:"varint,6,opt,name=status,proto3,enum=feedback.v1.CommentStatus" json:"status,omitempty"`
+	ReplyCount    int64                  `protobuf:"varint,7,opt,name=reply_count,json=replyCount,proto3" json:"reply_count,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	HiddenReason  string                 `protobuf:"bytes,10,opt,name=hidden_reason,json=hiddenReason,proto3" json:"hidden_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_feedback_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_feedback_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_feedback_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Comment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Comment) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *Comment) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Comment) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *Comment) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Comment) GetStatus() CommentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CommentStatus_COMMENT_STATUS_UNSPECIFIED
+}
+
+func (x *Comment) GetReplyCount() int64 {
+	if x != nil {
+		return x.ReplyCount
+	}
+	return 0
+}
+
+func (x *Comment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetHiddenReason() string {
+	if x != nil {
+		return x.HiddenReason
+	}
+	return ""
+}
+
+type RateQuizRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuizId        string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	Stars         int32                  `protobuf:"varint,2,opt,name=stars,proto3" json:"stars,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateQuizRequest) Reset() {
+	*x = RateQuizRequest{}
+	mi := &file_feedback_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateQuizRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateQuizRequest) ProtoMessage() {}
+
+func (x *RateQuizRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feedback_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateQuizRequest.ProtoReflect.Descriptor instead.
+func (*RateQuizRequest) Descriptor() ([]byte, []int) {
+	return file_feedback_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RateQuizRequest) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *RateQuizRequest) GetStars() int32 {
+	if x != nil {
+		return x.Stars
+	}
+	return 0
+}
+
+type DeleteRatingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuizId        string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRatingRequest) Reset() {
+	*x = DeleteRatingRequest{}
+	mi := &file_feedback_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRatingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRatingRequest) ProtoMessage() {}
+
+func (x *DeleteRatingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feedback_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRatingRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRatingRequest) Descriptor() ([]byte, []int) {
+	return file_feedback_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteRatingRequest) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+type DeleteRatingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuizId        string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRatingResponse) Reset() {
+	*x = DeleteRatingResponse{}
+	mi := &file_feedback_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRatingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRatingResponse) ProtoMessage() {}
+
+func (x *DeleteRatingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feedback_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRatingResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRatingResponse) Descriptor() ([]byte, []int) {
+	return file_feedback_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteRatingResponse) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *DeleteRatingResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type LikeQuizRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuizId        string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LikeQuizRequest) Reset() {
+	*x = LikeQuizRequest{}
+	mi := &file_feedback_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikeQuizRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeQuizRequest) ProtoMessage() {}
+
+func (x *LikeQuizRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feedback_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeQuizRequest.ProtoReflect.Descriptor instead.
+func (*LikeQuizRequest) Descriptor() ([]byte, []int) {
+	return file_feedback_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LikeQuizRequest) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+type UnlikeQuizRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuizId        string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlikeQuizRequest) Reset() {
+	*x = UnlikeQuizRequest{}
+	mi := &file_feedback_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlikeQuizRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlikeQuizRequest) ProtoMessage() {}
+
+func (x *UnlikeQuizRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feedback_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlikeQuizRequest.ProtoReflect.Descriptor instead.
+func (*UnlikeQuizRequest) Descriptor() ([]byte, []int) {
+	return file_feedback_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UnlikeQuizRequest) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+type GetQuizFeedbackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuizId        string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuizFeedbackRequest) Reset() {
+	*x = GetQuizFeedbackRequest{}
+	mi := &file_feedback_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuizFeedbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuizFeedbackRequest) ProtoMessage() {}
+
+func (x *GetQuizFeedbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feedback_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuizFeedbackRequest.ProtoReflect.Descriptor instead.
+func (*GetQuizFeedbackRequest) Descriptor() ([]byte, []int) {
+	return file_feedback_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetQuizFeedbackRequest) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+type CreateCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuizId        string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	ParentId      string                 `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCommentRequest) Reset() {
+	*x = CreateCommentRequest{}
+	mi := &file_feedback_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommentRequest) ProtoMessage() {}
+
+func (x *CreateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feedback_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommentRequest.ProtoReflect.Descriptor instead.
+func (*CreateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_feedback_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateCommentRequest) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *CreateCommentRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *CreateCommentRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type ListCommentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuizId        string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	ParentId      string                 `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsRequest) Reset() {
+	*x = ListCommentsRequest{}
+	mi := &file_feedback_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsRequest) ProtoMessage() {}
+
+func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feedback_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_feedback_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListCommentsRequest) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *ListCommentsRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *ListCommentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListCommentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsResponse) Reset() {
+	*x = ListCommentsResponse{}
+	mi := &file_feedback_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsResponse) ProtoMessage() {}
+
+func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feedback_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_feedback_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListCommentsResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *ListCommentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCommentRequest) Reset() {
+	*x = UpdateCommentRequest{}
+	mi := &file_feedback_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCommentRequest) ProtoMessage() {}
+
+func (x *UpdateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feedback_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCommentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_feedback_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateCommentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCommentRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type DeleteCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentRequest) Reset() {
+	*x = DeleteCommentRequest{}
+	mi := &file_feedback_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentRequest) ProtoMessage() {}
+
+func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feedback_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_feedback_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteCommentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentResponse) Reset() {
+	*x = DeleteCommentResponse{}
+	mi := &file_feedback_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentResponse) ProtoMessage() {}
+
+func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feedback_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCommentResponse) Descriptor() ([]byte, []int) {
+	return file_feedback_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteCommentResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteCommentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ModerateCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Action        ModerationAction       `protobuf:"varint,2,opt,name=action,proto3,enum=feedback.v1.ModerationAction" json:"action,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateCommentRequest) Reset() {
+	*x = ModerateCommentRequest{}
+	mi := &file_feedback_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateCommentRequest) ProtoMessage() {}
+
+func (x *ModerateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feedback_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateCommentRequest.ProtoReflect.Descriptor instead.
+func (*ModerateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_feedback_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ModerateCommentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ModerateCommentRequest) GetAction() ModerationAction {
+	if x != nil {
+		return x.Action
+	}
+	return ModerationAction_MODERATION_ACTION_UNSPECIFIED
+}
+
+func (x *ModerateCommentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListHiddenCommentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuizId        string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHiddenCommentsRequest) Reset() {
+	*x = ListHiddenCommentsRequest{}
+	mi := &file_feedback_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHiddenCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHiddenCommentsRequest) ProtoMessage() {}
+
+func (x *ListHiddenCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feedback_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHiddenCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListHiddenCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_feedback_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListHiddenCommentsRequest) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *ListHiddenCommentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListHiddenCommentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+var File_feedback_proto protoreflect.FileDescriptor
+
+const file_feedback_proto_rawDesc = "" +
+	"\n" +
+	"\x0efeedback.proto\x12\vfeedback.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xc6\x01\n" +
+	"\x06Rating\x12\x17\n" +
+	"\aquiz_id\x18\x01 \x01(\tR\x06quizId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05stars\x18\x03 \x01(\x05R\x05stars\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xe8\x01\n" +
+	"\fQuizFeedback\x12\x17\n" +
+	"\aquiz_id\x18\x01 \x01(\tR\x06quizId\x12%\n" +
+	"\x0erating_average\x18\x02 \x01(\x02R\rratingAverage\x12!\n" +
+	"\frating_count\x18\x03 \x01(\x03R\vratingCount\x12\x1d\n" +
+	"\n" +
+	"like_count\x18\x04 \x01(\x03R\tlikeCount\x12#\n" +
+	"\rcomment_count\x18\x05 \x01(\x03R\fcommentCount\x12\x1b\n" +
+	"\tmy_rating\x18\x06 \x01(\x05R\bmyRating\x12\x14\n" +
+	"\x05liked\x18\a \x01(\bR\x05liked\"\xf0\x02\n" +
+	"\aComment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\aquiz_id\x18\x02 \x01(\tR\x06quizId\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\tR\bparentId\x12\x1b\n" +
+	"\tauthor_id\x18\x04 \x01(\tR\bauthorId\x12\x12\n" +
+	"\x04body\x18\x05 \x01(\tR\x04body\x122\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x1a.feedback.v1.CommentStatusR\x06status\x12\x1f\n" +
+	"\vreply_count\x18\a \x01(\x03R\n" +
+	"replyCount\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12#\n" +
+	"\rhidden_reason\x18\n" +
+	" \x01(\tR\fhiddenReason\"@\n" +
+	"\x0fRateQuizRequest\x12\x17\n" +
+	"\aquiz_id\x18\x01 \x01(\tR\x06quizId\x12\x14\n" +
+	"\x05stars\x18\x02 \x01(\x05R\x05stars\".\n" +
+	"\x13DeleteRatingRequest\x12\x17\n" +
+	"\aquiz_id\x18\x01 \x01(\tR\x06quizId\"I\n" +
+	"\x14DeleteRatingResponse\x12\x17\n" +
+	"\aquiz_id\x18\x01 \x01(\tR\x06quizId\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"*\n" +
+	"\x0fLikeQuizRequest\x12\x17\n" +
+	"\aquiz_id\x18\x01 \x01(\tR\x06quizId\",\n" +
+	"\x11UnlikeQuizRequest\x12\x17\n" +
+	"\aquiz_id\x18\x01 \x01(\tR\x06quizId\"1\n" +
+	"\x16GetQuizFeedbackRequest\x12\x17\n" +
+	"\aquiz_id\x18\x01 \x01(\tR\x06quizId\"`\n" +
+	"\x14CreateCommentRequest\x12\x17\n" +
+	"\aquiz_id\x18\x01 \x01(\tR\x06quizId\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\tR\bparentId\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\"\x87\x01\n" +
+	"\x13ListCommentsRequest\x12\x17\n" +
+	"\aquiz_id\x18\x01 \x01(\tR\x06quizId\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\tR\bparentId\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"p\n" +
+	"\x14ListCommentsResponse\x120\n" +
+	"\bcomments\x18\x01 \x03(\v2\x14.feedback.v1.CommentR\bcomments\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\":\n" +
+	"\x14UpdateCommentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\"&\n" +
+	"\x14DeleteCommentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"A\n" +
+	"\x15DeleteCommentResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"w\n" +
+	"\x16ModerateCommentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x125\n" +
+	"\x06action\x18\x02 \x01(\x0e2\x1d.feedback.v1.ModerationActionR\x06action\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"p\n" +
+	"\x19ListHiddenCommentsRequest\x12\x17\n" +
+	"\aquiz_id\x18\x01 \x01(\tR\x06quizId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken*\x82\x01\n" +
+	"\rCommentStatus\x12\x1e\n" +
+	"\x1aCOMMENT_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16COMMENT_STATUS_VISIBLE\x10\x01\x12\x1a\n" +
+	"\x16COMMENT_STATUS_DELETED\x10\x02\x12\x19\n" +
+	"\x15COMMENT_STATUS_HIDDEN\x10\x03*p\n" +
+	"\x10ModerationAction\x12!\n" +
+	"\x1dMODERATION_ACTION_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16MODERATION_ACTION_HIDE\x10\x01\x12\x1d\n" +
+	"\x19MODERATION_ACTION_RESTORE\x10\x022\xa7\f\n" +
+	"\x0fFeedbackService\x12\x7f\n" +
+	"\bRateQuiz\x12\x1c.feedback.v1.RateQuizRequest\x1a\x13.feedback.v1.Rating\"@\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02%:\x01*\x1a /api/v1/quizzes/{quiz_id}/rating\x12\x92\x01\n" +
+	"\fDeleteRating\x12 .feedback.v1.DeleteRatingRequest\x1a!.feedback.v1.DeleteRatingResponse\"=\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\"* /api/v1/quizzes/{quiz_id}/rating\x12\x80\x01\n" +
+	"\bLikeQuiz\x12\x1c.feedback.v1.LikeQuizRequest\x1a\x19.feedback.v1.QuizFeedback\";\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02 \x1a\x1e/api/v1/quizzes/{quiz_id}/like\x12\x84\x01\n" +
+	"\n" +
+	"UnlikeQuiz\x12\x1e.feedback.v1.UnlikeQuizRequest\x1a\x19.feedback.v1.QuizFeedback\";\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02 *\x1e/api/v1/quizzes/{quiz_id}/like\x12\x92\x01\n" +
+	"\x0fGetQuizFeedback\x12#.feedback.v1.GetQuizFeedbackRequest\x1a\x19.feedback.v1.QuizFeedback\"?\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02$\x12\"/api/v1/quizzes/{quiz_id}/feedback\x12\x8c\x01\n" +
+	"\rCreateComment\x12!.feedback.v1.CreateCommentRequest\x1a\x14.feedback.v1.Comment\"B\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02':\x01*\"\"/api/v1/quizzes/{quiz_id}/comments\x12\x94\x01\n" +
+	"\fListComments\x12 .feedback.v1.ListCommentsRequest\x1a!.feedback.v1.ListCommentsResponse\"?\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02$\x12\"/api/v1/quizzes/{quiz_id}/comments\x12\x7f\n" +
+	"\rUpdateComment\x12!.feedback.v1.UpdateCommentRequest\x1a\x14.feedback.v1.Comment\"5\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x1a:\x01*\x1a\x15/api/v1/comments/{id}\x12\x8a\x01\n" +
+	"\rDeleteComment\x12!.feedback.v1.DeleteCommentRequest\x1a\".feedback.v1.DeleteCommentResponse\"2\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x17*\x15/api/v1/comments/{id}\x12\x8e\x01\n" +
+	"\x0fModerateComment\x12#.feedback.v1.ModerateCommentRequest\x1a\x14.feedback.v1.Comment\"@\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/moderation/comments/{id}\x12\x99\x01\n" +
+	"\x12ListHiddenComments\x12&.feedback.v1.ListHiddenCommentsRequest\x1a!.feedback.v1.ListCommentsResponse\"8\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/moderation/commentsBSZQgithub.com/mibrgmv/whoami-server/gateway/internal/protogen/feedback/v1;feedbackv1b\x06proto3"
+
+var (
+	file_feedback_proto_rawDescOnce sync.Once
+	file_feedback_proto_rawDescData []byte
+)
+
+func file_feedback_proto_rawDescGZIP() []byte {
+	file_feedback_proto_rawDescOnce.Do(func() {
+		file_feedback_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_feedback_proto_rawDesc), len(file_feedback_proto_rawDesc)))
+	})
+	return file_feedback_proto_rawDescData
+}
+
+var file_feedback_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_feedback_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_feedback_proto_goTypes = []any{
+	(CommentStatus)(0),                // 0: feedback.v1.CommentStatus
+	(ModerationAction)(0),             // 1: feedback.v1.ModerationAction
+	(*Rating)(nil),                    // 2: feedback.v1.Rating
+	(*QuizFeedback)(nil),              // 3: feedback.v1.QuizFeedback
+	(*Comment)(nil),                   // 4: feedback.v1.Comment
+	(*RateQuizRequest)(nil),           // 5: feedback.v1.RateQuizRequest
+	(*DeleteRatingRequest)(nil),       // 6: feedback.v1.DeleteRatingRequest
+	(*DeleteRatingResponse)(nil),      // 7: feedback.v1.DeleteRatingResponse
+	(*LikeQuizRequest)(nil),           // 8: feedback.v1.LikeQuizRequest
+	(*UnlikeQuizRequest)(nil),         // 9: feedback.v1.UnlikeQuizRequest
+	(*GetQuizFeedbackRequest)(nil),    // 10: feedback.v1.GetQuizFeedbackRequest
+	(*CreateCommentRequest)(nil),      // 11: feedback.v1.CreateCommentRequest
+	(*ListCommentsRequest)(nil),       // 12: feedback.v1.ListCommentsRequest
+	(*ListCommentsResponse)(nil),      // 13: feedback.v1.ListCommentsResponse
+	(*UpdateCommentRequest)(nil),      // 14: feedback.v1.UpdateCommentRequest
+	(*DeleteCommentRequest)(nil),      // 15: feedback.v1.DeleteCommentRequest
+	(*DeleteCommentResponse)(nil),     // 16: feedback.v1.DeleteCommentResponse
+	(*ModerateCommentRequest)(nil),    // 17: feedback.v1.ModerateCommentRequest
+	(*ListHiddenCommentsRequest)(nil), // 18: feedback.v1.ListHiddenCommentsRequest
+	(*timestamppb.Timestamp)(nil),     // 19: google.protobuf.Timestamp
+}
+var file_feedback_proto_depIdxs = []int32{
+	19, // 0: feedback.v1.Rating.created_at:type_name -> google.protobuf.Timestamp
+	19, // 1: feedback.v1.Rating.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: feedback.v1.Comment.status:type_name -> feedback.v1.CommentStatus
+	19, // 3: feedback.v1.Comment.created_at:type_name -> google.protobuf.Timestamp
+	19, // 4: feedback.v1.Comment.updated_at:type_name -> google.protobuf.Timestamp
+	4,  // 5: feedback.v1.ListCommentsResponse.comments:type_name -> feedback.v1.Comment
+	1,  // 6: feedback.v1.ModerateCommentRequest.action:type_name -> feedback.v1.ModerationAction
+	5,  // 7: feedback.v1.FeedbackService.RateQuiz:input_type -> feedback.v1.RateQuizRequest
+	6,  // 8: feedback.v1.FeedbackService.DeleteRating:input_type -> feedback.v1.DeleteRatingRequest
+	8,  // 9: feedback.v1.FeedbackService.LikeQuiz:input_type -> feedback.v1.LikeQuizRequest
+	9,  // 10: feedback.v1.FeedbackService.UnlikeQuiz:input_type -> feedback.v1.UnlikeQuizRequest
+	10, // 11: feedback.v1.FeedbackService.GetQuizFeedback:input_type -> feedback.v1.GetQuizFeedbackRequest
+	11, // 12: feedback.v1.FeedbackService.CreateComment:input_type -> feedback.v1.CreateCommentRequest
+	12, // 13: feedback.v1.FeedbackService.ListComments:input_type -> feedback.v1.ListCommentsRequest
+	14, // 14: feedback.v1.FeedbackService.UpdateComment:input_type -> feedback.v1.UpdateCommentRequest
+	15, // 15: feedback.v1.FeedbackService.DeleteComment:input_type -> feedback.v1.DeleteCommentRequest
+	17, // 16: feedback.v1.FeedbackService.ModerateComment:input_type -> feedback.v1.ModerateCommentRequest
+	18, // 17: feedback.v1.FeedbackService.ListHiddenComments:input_type -> feedback.v1.ListHiddenCommentsRequest
+	2,  // 18: feedback.v1.FeedbackService.RateQuiz:output_type -> feedback.v1.Rating
+	7,  // 19: feedback.v1.FeedbackService.DeleteRating:output_type -> feedback.v1.DeleteRatingResponse
+	3,  // 20: feedback.v1.FeedbackService.LikeQuiz:output_type -> feedback.v1.QuizFeedback
+	3,  // 21: feedback.v1.FeedbackService.UnlikeQuiz:output_type -> feedback.v1.QuizFeedback
+	3,  // 22: feedback.v1.FeedbackService.GetQuizFeedback:output_type -> feedback.v1.QuizFeedback
+	4,  // 23: feedback.v1.FeedbackService.CreateComment:output_type -> feedback.v1.Comment
+	13, // 24: feedback.v1.FeedbackService.ListComments:output_type -> feedback.v1.ListCommentsResponse
+	4,  // 25: feedback.v1.FeedbackService.UpdateComment:output_type -> feedback.v1.Comment
+	16, // 26: feedback.v1.FeedbackService.DeleteComment:output_type -> feedback.v1.DeleteCommentResponse
+	4,  // 27: feedback.v1.FeedbackService.ModerateComment:output_type -> feedback.v1.Comment
+	13, // 28: feedback.v1.FeedbackService.ListHiddenComments:output_type -> feedback.v1.ListCommentsResponse
+	18, // [18:29] is the sub-list for method output_type
+	7,  // [7:18] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_feedback_proto_init() }
+func file_feedback_proto_init() {
+	if File_feedback_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_feedback_proto_rawDesc), len(file_feedback_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_feedback_proto_goTypes,
+		DependencyIndexes: file_feedback_proto_depIdxs,
+		EnumInfos:         file_feedback_proto_enumTypes,
+		MessageInfos:      file_feedback_proto_msgTypes,
+	}.Build()
+	File_feedback_proto = out.File
+	file_feedback_proto_goTypes = nil
+	file_feedback_proto_depIdxs = nil
+}
@@ -0,0 +1,501 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: feedback.proto
+
+package feedbackv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	FeedbackService_RateQuiz_FullMethodName           = "/feedback.v1.FeedbackService/RateQuiz"
+	FeedbackService_DeleteRating_FullMethodName       = "/feedback.v1.FeedbackService/DeleteRating"
+	FeedbackService_LikeQuiz_FullMethodName           = "/feedback.v1.FeedbackService/LikeQuiz"
+	FeedbackService_UnlikeQuiz_FullMethodName         = "/feedback.v1.FeedbackService/UnlikeQuiz"
+	FeedbackService_GetQuizFeedback_FullMethodName    = "/feedback.v1.FeedbackService/GetQuizFeedback"
+	FeedbackService_CreateComment_FullMethodName      = "/feedback.v1.FeedbackService/CreateComment"
+	FeedbackService_ListComments_FullMethodName       = "/feedback.v1.FeedbackService/ListComments"
+	FeedbackService_UpdateComment_FullMethodName      = "/feedback.v1.FeedbackService/UpdateComment"
+	FeedbackService_DeleteComment_FullMethodName      = "/feedback.v1.FeedbackService/DeleteComment"
+	FeedbackService_ModerateComment_FullMethodName    = "/feedback.v1.FeedbackService/ModerateComment"
+	FeedbackService_ListHiddenComments_FullMethodName = "/feedback.v1.FeedbackService/ListHiddenComments"
+)
+
+// FeedbackServiceClient is the client API for FeedbackService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FeedbackServiceClient interface {
+	RateQuiz(ctx context.Context, in *RateQuizRequest, opts ...grpc.CallOption) (*Rating, error)
+	DeleteRating(ctx context.Context, in *DeleteRatingRequest, opts ...grpc.CallOption) (*DeleteRatingResponse, error)
+	LikeQuiz(ctx context.Context, in *LikeQuizRequest, opts ...grpc.CallOption) (*QuizFeedback, error)
+	UnlikeQuiz(ctx context.Context, in *UnlikeQuizRequest, opts ...grpc.CallOption) (*QuizFeedback, error)
+	GetQuizFeedback(ctx context.Context, in *GetQuizFeedbackRequest, opts ...grpc.CallOption) (*QuizFeedback, error)
+	CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*Comment, error)
+	ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error)
+	UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*Comment, error)
+	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error)
+	ModerateComment(ctx context.Context, in *ModerateCommentRequest, opts ...grpc.CallOption) (*Comment, error)
+	ListHiddenComments(ctx context.Context, in *ListHiddenCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error)
+}
+
+type feedbackServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFeedbackServiceClient(cc grpc.ClientConnInterface) FeedbackServiceClient {
+	return &feedbackServiceClient{cc}
+}
+
+func (c *feedbackServiceClient) RateQuiz(ctx context.Context, in *RateQuizRequest, opts ...grpc.CallOption) (*Rating, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Rating)
+	err := c.cc.Invoke(ctx, FeedbackService_RateQuiz_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedbackServiceClient) DeleteRating(ctx context.Context, in *DeleteRatingRequest, opts ...grpc.CallOption) (*DeleteRatingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRatingResponse)
+	err := c.cc.Invoke(ctx, FeedbackService_DeleteRating_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedbackServiceClient) LikeQuiz(ctx context.Context, in *LikeQuizRequest, opts ...grpc.CallOption) (*QuizFeedback, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuizFeedback)
+	err := c.cc.Invoke(ctx, FeedbackService_LikeQuiz_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedbackServiceClient) UnlikeQuiz(ctx context.Context, in *UnlikeQuizRequest, opts ...grpc.CallOption) (*QuizFeedback, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuizFeedback)
+	err := c.cc.Invoke(ctx, FeedbackService_UnlikeQuiz_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedbackServiceClient) GetQuizFeedback(ctx context.Context, in *GetQuizFeedbackRequest, opts ...grpc.CallOption) (*QuizFeedback, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuizFeedback)
+	err := c.cc.Invoke(ctx, FeedbackService_GetQuizFeedback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedbackServiceClient) CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*Comment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Comment)
+	err := c.cc.Invoke(ctx, FeedbackService_CreateComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedbackServiceClient) ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCommentsResponse)
+	err := c.cc.Invoke(ctx, FeedbackService_ListComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedbackServiceClient) UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*Comment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Comment)
+	err := c.cc.Invoke(ctx, FeedbackService_UpdateComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedbackServiceClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCommentResponse)
+	err := c.cc.Invoke(ctx, FeedbackService_DeleteComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedbackServiceClient) ModerateComment(ctx context.Context, in *ModerateCommentRequest, opts ...grpc.CallOption) (*Comment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Comment)
+	err := c.cc.Invoke(ctx, FeedbackService_ModerateComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedbackServiceClient) ListHiddenComments(ctx context.Context, in *ListHiddenCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCommentsResponse)
+	err := c.cc.Invoke(ctx, FeedbackService_ListHiddenComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FeedbackServiceServer is the server API for FeedbackService service.
+// All implementations must embed UnimplementedFeedbackServiceServer
+// for forward compatibility.
+type FeedbackServiceServer interface {
+	RateQuiz(context.Context, *RateQuizRequest) (*Rating, error)
+	DeleteRating(context.Context, *DeleteRatingRequest) (*DeleteRatingResponse, error)
+	LikeQuiz(context.Context, *LikeQuizRequest) (*QuizFeedback, error)
+	UnlikeQuiz(context.Context, *UnlikeQuizRequest) (*QuizFeedback, error)
+	GetQuizFeedback(context.Context, *GetQuizFeedbackRequest) (*QuizFeedback, error)
+	CreateComment(context.Context, *CreateCommentRequest) (*Comment, error)
+	ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error)
+	UpdateComment(context.Context, *UpdateCommentRequest) (*Comment, error)
+	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error)
+	ModerateComment(context.Context, *ModerateCommentRequest) (*Comment, error)
+	ListHiddenComments(context.Context, *ListHiddenCommentsRequest) (*ListCommentsResponse, error)
+	mustEmbedUnimplementedFeedbackServiceServer()
+}
+
+// UnimplementedFeedbackServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFeedbackServiceServer struct{}
+
+func (UnimplementedFeedbackServiceServer) RateQuiz(context.Context, *RateQuizRequest) (*Rating, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateQuiz not implemented")
+}
+func (UnimplementedFeedbackServiceServer) DeleteRating(context.Context, *DeleteRatingRequest) (*DeleteRatingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRating not implemented")
+}
+func (UnimplementedFeedbackServiceServer) LikeQuiz(context.Context, *LikeQuizRequest) (*QuizFeedback, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LikeQuiz not implemented")
+}
+func (UnimplementedFeedbackServiceServer) UnlikeQuiz(context.Context, *UnlikeQuizRequest) (*QuizFeedback, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlikeQuiz not implemented")
+}
+func (UnimplementedFeedbackServiceServer) GetQuizFeedback(context.Context, *GetQuizFeedbackRequest) (*QuizFeedback, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuizFeedback not implemented")
+}
+func (UnimplementedFeedbackServiceServer) CreateComment(context.Context, *CreateCommentRequest) (*Comment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateComment not implemented")
+}
+func (UnimplementedFeedbackServiceServer) ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListComments not implemented")
+}
+func (UnimplementedFeedbackServiceServer) UpdateComment(context.Context, *UpdateCommentRequest) (*Comment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateComment not implemented")
+}
+func (UnimplementedFeedbackServiceServer) DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (UnimplementedFeedbackServiceServer) ModerateComment(context.Context, *ModerateCommentRequest) (*Comment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateComment not implemented")
+}
+func (UnimplementedFeedbackServiceServer) ListHiddenComments(context.Context, *ListHiddenCommentsRequest) (*ListCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHiddenComments not implemented")
+}
+func (UnimplementedFeedbackServiceServer) mustEmbedUnimplementedFeedbackServiceServer() {}
+func (UnimplementedFeedbackServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeFeedbackServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FeedbackServiceServer will
+// result in compilation errors.
+type UnsafeFeedbackServiceServer interface {
+	mustEmbedUnimplementedFeedbackServiceServer()
+}
+
+func RegisterFeedbackServiceServer(s grpc.ServiceRegistrar, srv FeedbackServiceServer) {
+	// If the following call pancis, it indicates UnimplementedFeedbackServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FeedbackService_ServiceDesc, srv)
+}
+
+func _FeedbackService_RateQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateQuizRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedbackServiceServer).RateQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedbackService_RateQuiz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedbackServiceServer).RateQuiz(ctx, req.(*RateQuizRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedbackService_DeleteRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedbackServiceServer).DeleteRating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedbackService_DeleteRating_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedbackServiceServer).DeleteRating(ctx, req.(*DeleteRatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedbackService_LikeQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LikeQuizRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedbackServiceServer).LikeQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedbackService_LikeQuiz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedbackServiceServer).LikeQuiz(ctx, req.(*LikeQuizRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedbackService_UnlikeQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlikeQuizRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedbackServiceServer).UnlikeQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedbackService_UnlikeQuiz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedbackServiceServer).UnlikeQuiz(ctx, req.(*UnlikeQuizRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedbackService_GetQuizFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuizFeedbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedbackServiceServer).GetQuizFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedbackService_GetQuizFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedbackServiceServer).GetQuizFeedback(ctx, req.(*GetQuizFeedbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedbackService_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedbackServiceServer).CreateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedbackService_CreateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedbackServiceServer).CreateComment(ctx, req.(*CreateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedbackService_ListComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedbackServiceServer).ListComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedbackService_ListComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedbackServiceServer).ListComments(ctx, req.(*ListCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedbackService_UpdateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedbackServiceServer).UpdateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedbackService_UpdateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedbackServiceServer).UpdateComment(ctx, req.(*UpdateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedbackService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedbackServiceServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedbackService_DeleteComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedbackServiceServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedbackService_ModerateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedbackServiceServer).ModerateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedbackService_ModerateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedbackServiceServer).ModerateComment(ctx, req.(*ModerateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedbackService_ListHiddenComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHiddenCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedbackServiceServer).ListHiddenComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedbackService_ListHiddenComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedbackServiceServer).ListHiddenComments(ctx, req.(*ListHiddenCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FeedbackService_ServiceDesc is the grpc.ServiceDesc for FeedbackService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FeedbackService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "feedback.v1.FeedbackService",
+	HandlerType: (*FeedbackServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RateQuiz",
+			Handler:    _FeedbackService_RateQuiz_Handler,
+		},
+		{
+			MethodName: "DeleteRating",
+			Handler:    _FeedbackService_DeleteRating_Handler,
+		},
+		{
+			MethodName: "LikeQuiz",
+			Handler:    _FeedbackService_LikeQuiz_Handler,
+		},
+		{
+			MethodName: "UnlikeQuiz",
+			Handler:    _FeedbackService_UnlikeQuiz_Handler,
+		},
+		{
+			MethodName: "GetQuizFeedback",
+			Handler:    _FeedbackService_GetQuizFeedback_Handler,
+		},
+		{
+			MethodName: "CreateComment",
+			Handler:    _FeedbackService_CreateComment_Handler,
+		},
+		{
+			MethodName: "ListComments",
+			Handler:    _FeedbackService_ListComments_Handler,
+		},
+		{
+			MethodName: "UpdateComment",
+			Handler:    _FeedbackService_UpdateComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _FeedbackService_DeleteComment_Handler,
+		},
+		{
+			MethodName: "ModerateComment",
+			Handler:    _FeedbackService_ModerateComment_Handler,
+		},
+		{
+			MethodName: "ListHiddenComments",
+			Handler:    _FeedbackService_ListHiddenComments_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "feedback.proto",
+}
@@ -19,9 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	HistoryService_CreateItem_FullMethodName      = "/history.v1.HistoryService/CreateItem"
-	HistoryService_BatchGetMyItems_FullMethodName = "/history.v1.HistoryService/BatchGetMyItems"
-	HistoryService_BatchGetItems_FullMethodName   = "/history.v1.HistoryService/BatchGetItems"
+	HistoryService_CreateItem_FullMethodName         = "/history.v1.HistoryService/CreateItem"
+	HistoryService_GetRecommendations_FullMethodName = "/history.v1.HistoryService/GetRecommendations"
+	HistoryService_GetSharedItem_FullMethodName      = "/history.v1.HistoryService/GetSharedItem"
+	HistoryService_MergeItems_FullMethodName         = "/history.v1.HistoryService/MergeItems"
+	HistoryService_GetQuizStats_FullMethodName       = "/history.v1.HistoryService/GetQuizStats"
+	HistoryService_BatchGetMyItems_FullMethodName    = "/history.v1.HistoryService/BatchGetMyItems"
+	HistoryService_BatchGetItems_FullMethodName      = "/history.v1.HistoryService/BatchGetItems"
+	HistoryService_ShareItem_FullMethodName          = "/history.v1.HistoryService/ShareItem"
+	HistoryService_UnshareItem_FullMethodName        = "/history.v1.HistoryService/UnshareItem"
 )
 
 // HistoryServiceClient is the client API for HistoryService service.
@@ -29,8 +35,14 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type HistoryServiceClient interface {
 	CreateItem(ctx context.Context, in *CreateItemRequest, opts ...grpc.CallOption) (*QuizCompletionHistoryItem, error)
+	GetRecommendations(ctx context.Context, in *GetRecommendationsRequest, opts ...grpc.CallOption) (*GetRecommendationsResponse, error)
+	GetSharedItem(ctx context.Context, in *GetSharedItemRequest, opts ...grpc.CallOption) (*SharedItem, error)
+	MergeItems(ctx context.Context, in *MergeItemsRequest, opts ...grpc.CallOption) (*MergeItemsResponse, error)
+	GetQuizStats(ctx context.Context, in *GetQuizStatsRequest, opts ...grpc.CallOption) (*QuizStats, error)
 	BatchGetMyItems(ctx context.Context, in *BatchGetMyItemsRequest, opts ...grpc.CallOption) (*BatchGetItemsResponse, error)
 	BatchGetItems(ctx context.Context, in *BatchGetItemsRequest, opts ...grpc.CallOption) (*BatchGetItemsResponse, error)
+	ShareItem(ctx context.Context, in *ShareItemRequest, opts ...grpc.CallOption) (*SharedItem, error)
+	UnshareItem(ctx context.Context, in *UnshareItemRequest, opts ...grpc.CallOption) (*UnshareItemResponse, error)
 }
 
 type historyServiceClient struct {
@@ -51,6 +63,46 @@ func (c *historyServiceClient) CreateItem(ctx context.Context, in *CreateItemReq
 	return out, nil
 }
 
+func (c *historyServiceClient) GetRecommendations(ctx context.Context, in *GetRecommendationsRequest, opts ...grpc.CallOption) (*GetRecommendationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRecommendationsResponse)
+	err := c.cc.Invoke(ctx, HistoryService_GetRecommendations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) GetSharedItem(ctx context.Context, in *GetSharedItemRequest, opts ...grpc.CallOption) (*SharedItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SharedItem)
+	err := c.cc.Invoke(ctx, HistoryService_GetSharedItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) MergeItems(ctx context.Context, in *MergeItemsRequest, opts ...grpc.CallOption) (*MergeItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeItemsResponse)
+	err := c.cc.Invoke(ctx, HistoryService_MergeItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) GetQuizStats(ctx context.Context, in *GetQuizStatsRequest, opts ...grpc.CallOption) (*QuizStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuizStats)
+	err := c.cc.Invoke(ctx, HistoryService_GetQuizStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *historyServiceClient) BatchGetMyItems(ctx context.Context, in *BatchGetMyItemsRequest, opts ...grpc.CallOption) (*BatchGetItemsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BatchGetItemsResponse)
@@ -71,13 +123,39 @@ func (c *historyServiceClient) BatchGetItems(ctx context.Context, in *BatchGetIt
 	return out, nil
 }
 
+func (c *historyServiceClient) ShareItem(ctx context.Context, in *ShareItemRequest, opts ...grpc.CallOption) (*SharedItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SharedItem)
+	err := c.cc.Invoke(ctx, HistoryService_ShareItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) UnshareItem(ctx context.Context, in *UnshareItemRequest, opts ...grpc.CallOption) (*UnshareItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnshareItemResponse)
+	err := c.cc.Invoke(ctx, HistoryService_UnshareItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HistoryServiceServer is the server API for HistoryService service.
 // All implementations must embed UnimplementedHistoryServiceServer
 // for forward compatibility.
 type HistoryServiceServer interface {
 	CreateItem(context.Context, *CreateItemRequest) (*QuizCompletionHistoryItem, error)
+	GetRecommendations(context.Context, *GetRecommendationsRequest) (*GetRecommendationsResponse, error)
+	GetSharedItem(context.Context, *GetSharedItemRequest) (*SharedItem, error)
+	MergeItems(context.Context, *MergeItemsRequest) (*MergeItemsResponse, error)
+	GetQuizStats(context.Context, *GetQuizStatsRequest) (*QuizStats, error)
 	BatchGetMyItems(context.Context, *BatchGetMyItemsRequest) (*BatchGetItemsResponse, error)
 	BatchGetItems(context.Context, *BatchGetItemsRequest) (*BatchGetItemsResponse, error)
+	ShareItem(context.Context, *ShareItemRequest) (*SharedItem, error)
+	UnshareItem(context.Context, *UnshareItemRequest) (*UnshareItemResponse, error)
 	mustEmbedUnimplementedHistoryServiceServer()
 }
 
@@ -91,12 +169,30 @@ type UnimplementedHistoryServiceServer struct{}
 func (UnimplementedHistoryServiceServer) CreateItem(context.Context, *CreateItemRequest) (*QuizCompletionHistoryItem, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateItem not implemented")
 }
+func (UnimplementedHistoryServiceServer) GetRecommendations(context.Context, *GetRecommendationsRequest) (*GetRecommendationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecommendations not implemented")
+}
+func (UnimplementedHistoryServiceServer) GetSharedItem(context.Context, *GetSharedItemRequest) (*SharedItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSharedItem not implemented")
+}
+func (UnimplementedHistoryServiceServer) MergeItems(context.Context, *MergeItemsRequest) (*MergeItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeItems not implemented")
+}
+func (UnimplementedHistoryServiceServer) GetQuizStats(context.Context, *GetQuizStatsRequest) (*QuizStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuizStats not implemented")
+}
 func (UnimplementedHistoryServiceServer) BatchGetMyItems(context.Context, *BatchGetMyItemsRequest) (*BatchGetItemsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchGetMyItems not implemented")
 }
 func (UnimplementedHistoryServiceServer) BatchGetItems(context.Context, *BatchGetItemsRequest) (*BatchGetItemsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchGetItems not implemented")
 }
+func (UnimplementedHistoryServiceServer) ShareItem(context.Context, *ShareItemRequest) (*SharedItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareItem not implemented")
+}
+func (UnimplementedHistoryServiceServer) UnshareItem(context.Context, *UnshareItemRequest) (*UnshareItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnshareItem not implemented")
+}
 func (UnimplementedHistoryServiceServer) mustEmbedUnimplementedHistoryServiceServer() {}
 func (UnimplementedHistoryServiceServer) testEmbeddedByValue()                        {}
 
@@ -136,6 +232,78 @@ func _HistoryService_CreateItem_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_GetRecommendations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecommendationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).GetRecommendations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_GetRecommendations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).GetRecommendations(ctx, req.(*GetRecommendationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_GetSharedItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).GetSharedItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_GetSharedItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).GetSharedItem(ctx, req.(*GetSharedItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_MergeItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).MergeItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_MergeItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).MergeItems(ctx, req.(*MergeItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_GetQuizStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuizStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).GetQuizStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_GetQuizStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).GetQuizStats(ctx, req.(*GetQuizStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HistoryService_BatchGetMyItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BatchGetMyItemsRequest)
 	if err := dec(in); err != nil {
@@ -172,6 +340,42 @@ func _HistoryService_BatchGetItems_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_ShareItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).ShareItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_ShareItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).ShareItem(ctx, req.(*ShareItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_UnshareItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnshareItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).UnshareItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_UnshareItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).UnshareItem(ctx, req.(*UnshareItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HistoryService_ServiceDesc is the grpc.ServiceDesc for HistoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +387,22 @@ var HistoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateItem",
 			Handler:    _HistoryService_CreateItem_Handler,
 		},
+		{
+			MethodName: "GetRecommendations",
+			Handler:    _HistoryService_GetRecommendations_Handler,
+		},
+		{
+			MethodName: "GetSharedItem",
+			Handler:    _HistoryService_GetSharedItem_Handler,
+		},
+		{
+			MethodName: "MergeItems",
+			Handler:    _HistoryService_MergeItems_Handler,
+		},
+		{
+			MethodName: "GetQuizStats",
+			Handler:    _HistoryService_GetQuizStats_Handler,
+		},
 		{
 			MethodName: "BatchGetMyItems",
 			Handler:    _HistoryService_BatchGetMyItems_Handler,
@@ -191,6 +411,14 @@ var HistoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BatchGetItems",
 			Handler:    _HistoryService_BatchGetItems_Handler,
 		},
+		{
+			MethodName: "ShareItem",
+			Handler:    _HistoryService_ShareItem_Handler,
+		},
+		{
+			MethodName: "UnshareItem",
+			Handler:    _HistoryService_UnshareItem_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "history.proto",
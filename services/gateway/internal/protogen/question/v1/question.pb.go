@@ -8,6 +8,7 @@ package questionv1
 
 import (
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	v1 "github.com/mibrgmv/whoami-server/gateway/internal/protogen/quiz/v1"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -23,6 +24,64 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type QuestionType int32
+
+const (
+	QuestionType_QUESTION_TYPE_UNSPECIFIED   QuestionType = 0
+	QuestionType_QUESTION_TYPE_SINGLE_CHOICE QuestionType = 1
+	QuestionType_QUESTION_TYPE_MULTI_SELECT  QuestionType = 2
+	QuestionType_QUESTION_TYPE_LIKERT        QuestionType = 3
+	QuestionType_QUESTION_TYPE_NUMERIC       QuestionType = 4
+	QuestionType_QUESTION_TYPE_RANKING       QuestionType = 5
+)
+
+// Enum value maps for QuestionType.
+var (
+	QuestionType_name = map[int32]string{
+		0: "QUESTION_TYPE_UNSPECIFIED",
+		1: "QUESTION_TYPE_SINGLE_CHOICE",
+		2: "QUESTION_TYPE_MULTI_SELECT",
+		3: "QUESTION_TYPE_LIKERT",
+		4: "QUESTION_TYPE_NUMERIC",
+		5: "QUESTION_TYPE_RANKING",
+	}
+	QuestionType_value = map[string]int32{
+		"QUESTION_TYPE_UNSPECIFIED":   0,
+		"QUESTION_TYPE_SINGLE_CHOICE": 1,
+		"QUESTION_TYPE_MULTI_SELECT":  2,
+		"QUESTION_TYPE_LIKERT":        3,
+		"QUESTION_TYPE_NUMERIC":       4,
+		"QUESTION_TYPE_RANKING":       5,
+	}
+)
+
+func (x QuestionType) Enum() *QuestionType {
+	p := new(QuestionType)
+	*p = x
+	return p
+}
+
+func (x QuestionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QuestionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_question_proto_enumTypes[0].Descriptor()
+}
+
+func (QuestionType) Type() protoreflect.EnumType {
+	return &file_question_proto_enumTypes[0]
+}
+
+func (x QuestionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QuestionType.Descriptor instead.
+func (QuestionType) EnumDescriptor() ([]byte, []int) {
+	return file_question_proto_rawDescGZIP(), []int{0}
+}
+
 type OptionWeights struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Weights       []float32              `protobuf:"fixed32,1,rep,packed,name=weights,proto3" json:"weights,omitempty"`
@@ -67,19 +126,214 @@ func (x *OptionWeights) GetWeights() []float32 {
 	return nil
 }
 
+type Route struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NextQuestionId string                 `protobuf:"bytes,1,opt,name=next_question_id,json=nextQuestionId,proto3" json:"next_question_id,omitempty"`
+	End            bool                   `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Route) Reset() {
+	*x = Route{}
+	mi := &file_question_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Route) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Route) ProtoMessage() {}
+
+func (x *Route) ProtoReflect() protoreflect.Message {
+	mi := &file_question_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Route.ProtoReflect.Descriptor instead.
+func (*Route) Descriptor() ([]byte, []int) {
+	return file_question_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Route) GetNextQuestionId() string {
+	if x != nil {
+		return x.NextQuestionId
+	}
+	return ""
+}
+
+func (x *Route) GetEnd() bool {
+	if x != nil {
+		return x.End
+	}
+	return false
+}
+
+type Option struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Weights       []float32              `protobuf:"fixed32,3,rep,packed,name=weights,proto3" json:"weights,omitempty"`
+	Route         *Route                 `protobuf:"bytes,4,opt,name=route,proto3" json:"route,omitempty"`
+	ImageId       string                 `protobuf:"bytes,5,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Option) Reset() {
+	*x = Option{}
+	mi := &file_question_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Option) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Option) ProtoMessage() {}
+
+func (x *Option) ProtoReflect() protoreflect.Message {
+	mi := &file_question_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Option.ProtoReflect.Descriptor instead.
+func (*Option) Descriptor() ([]byte, []int) {
+	return file_question_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Option) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Option) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Option) GetWeights() []float32 {
+	if x != nil {
+		return x.Weights
+	}
+	return nil
+}
+
+func (x *Option) GetRoute() *Route {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+func (x *Option) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+type QuestionOption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	ImageId       string                 `protobuf:"bytes,3,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuestionOption) Reset() {
+	*x = QuestionOption{}
+	mi := &file_question_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuestionOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionOption) ProtoMessage() {}
+
+func (x *QuestionOption) ProtoReflect() protoreflect.Message {
+	mi := &file_question_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionOption.ProtoReflect.Descriptor instead.
+func (*QuestionOption) Descriptor() ([]byte, []int) {
+	return file_question_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QuestionOption) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *QuestionOption) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *QuestionOption) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
 type Question struct {
-	state          protoimpl.MessageState    `protogen:"open.v1"`
-	Id             string                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	QuizId         string                    `protobuf:"bytes,2,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
-	Body           string                    `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	QuizId string                 `protobuf:"bytes,2,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	Body   string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	// Deprecated: Marked as deprecated in question.proto.
 	OptionsWeights map[string]*OptionWeights `protobuf:"bytes,4,rep,name=options_weights,json=optionsWeights,proto3" json:"options_weights,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Type           QuestionType              `protobuf:"varint,5,opt,name=type,proto3,enum=question.v1.QuestionType" json:"type,omitempty"`
+	Options        []*Option                 `protobuf:"bytes,6,rep,name=options,proto3" json:"options,omitempty"`
+	Position       int32                     `protobuf:"varint,7,opt,name=position,proto3" json:"position,omitempty"`
+	Route          *Route                    `protobuf:"bytes,8,opt,name=route,proto3" json:"route,omitempty"`
+	Tags           []string                  `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	ImageId        string                    `protobuf:"bytes,10,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Question) Reset() {
 	*x = Question{}
-	mi := &file_question_proto_msgTypes[1]
+	mi := &file_question_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -91,7 +345,7 @@ func (x *Question) String() string {
 func (*Question) ProtoMessage() {}
 
 func (x *Question) ProtoReflect() protoreflect.Message {
-	mi := &file_question_proto_msgTypes[1]
+	mi := &file_question_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -104,7 +358,7 @@ func (x *Question) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Question.ProtoReflect.Descriptor instead.
 func (*Question) Descriptor() ([]byte, []int) {
-	return file_question_proto_rawDescGZIP(), []int{1}
+	return file_question_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Question) GetId() string {
@@ -128,6 +382,7 @@ func (x *Question) GetBody() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in question.proto.
 func (x *Question) GetOptionsWeights() map[string]*OptionWeights {
 	if x != nil {
 		return x.OptionsWeights
@@ -135,18 +390,66 @@ func (x *Question) GetOptionsWeights() map[string]*OptionWeights {
 	return nil
 }
 
+func (x *Question) GetType() QuestionType {
+	if x != nil {
+		return x.Type
+	}
+	return QuestionType_QUESTION_TYPE_UNSPECIFIED
+}
+
+func (x *Question) GetOptions() []*Option {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Question) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Question) GetRoute() *Route {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+func (x *Question) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Question) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
 type CreateQuestionRequest struct {
-	state          protoimpl.MessageState    `protogen:"open.v1"`
-	QuizId         string                    `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
-	Body           string                    `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	QuizId string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	Body   string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	// Deprecated: Marked as deprecated in question.proto.
 	OptionsWeights map[string]*OptionWeights `protobuf:"bytes,3,rep,name=options_weights,json=optionsWeights,proto3" json:"options_weights,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Type           QuestionType              `protobuf:"varint,4,opt,name=type,proto3,enum=question.v1.QuestionType" json:"type,omitempty"`
+	Options        []*Option                 `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty"`
+	Route          *Route                    `protobuf:"bytes,6,opt,name=route,proto3" json:"route,omitempty"`
+	Tags           []string                  `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	ImageId        string                    `protobuf:"bytes,8,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CreateQuestionRequest) Reset() {
 	*x = CreateQuestionRequest{}
-	mi := &file_question_proto_msgTypes[2]
+	mi := &file_question_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -158,7 +461,7 @@ func (x *CreateQuestionRequest) String() string {
 func (*CreateQuestionRequest) ProtoMessage() {}
 
 func (x *CreateQuestionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_question_proto_msgTypes[2]
+	mi := &file_question_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -171,7 +474,7 @@ func (x *CreateQuestionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateQuestionRequest.ProtoReflect.Descriptor instead.
 func (*CreateQuestionRequest) Descriptor() ([]byte, []int) {
-	return file_question_proto_rawDescGZIP(), []int{2}
+	return file_question_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateQuestionRequest) GetQuizId() string {
@@ -188,6 +491,7 @@ func (x *CreateQuestionRequest) GetBody() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in question.proto.
 func (x *CreateQuestionRequest) GetOptionsWeights() map[string]*OptionWeights {
 	if x != nil {
 		return x.OptionsWeights
@@ -195,6 +499,41 @@ func (x *CreateQuestionRequest) GetOptionsWeights() map[string]*OptionWeights {
 	return nil
 }
 
+func (x *CreateQuestionRequest) GetType() QuestionType {
+	if x != nil {
+		return x.Type
+	}
+	return QuestionType_QUESTION_TYPE_UNSPECIFIED
+}
+
+func (x *CreateQuestionRequest) GetOptions() []*Option {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CreateQuestionRequest) GetRoute() *Route {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+func (x *CreateQuestionRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateQuestionRequest) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
 type BatchCreateQuestionsRequest struct {
 	state         protoimpl.MessageState   `protogen:"open.v1"`
 	QuizId        string                   `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
@@ -205,7 +544,7 @@ type BatchCreateQuestionsRequest struct {
 
 func (x *BatchCreateQuestionsRequest) Reset() {
 	*x = BatchCreateQuestionsRequest{}
-	mi := &file_question_proto_msgTypes[3]
+	mi := &file_question_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -217,7 +556,7 @@ func (x *BatchCreateQuestionsRequest) String() string {
 func (*BatchCreateQuestionsRequest) ProtoMessage() {}
 
 func (x *BatchCreateQuestionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_question_proto_msgTypes[3]
+	mi := &file_question_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -230,7 +569,7 @@ func (x *BatchCreateQuestionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchCreateQuestionsRequest.ProtoReflect.Descriptor instead.
 func (*BatchCreateQuestionsRequest) Descriptor() ([]byte, []int) {
-	return file_question_proto_rawDescGZIP(), []int{3}
+	return file_question_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *BatchCreateQuestionsRequest) GetQuizId() string {
@@ -256,7 +595,7 @@ type BatchCreateQuestionsResponse struct {
 
 func (x *BatchCreateQuestionsResponse) Reset() {
 	*x = BatchCreateQuestionsResponse{}
-	mi := &file_question_proto_msgTypes[4]
+	mi := &file_question_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -268,7 +607,7 @@ func (x *BatchCreateQuestionsResponse) String() string {
 func (*BatchCreateQuestionsResponse) ProtoMessage() {}
 
 func (x *BatchCreateQuestionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_question_proto_msgTypes[4]
+	mi := &file_question_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -281,7 +620,7 @@ func (x *BatchCreateQuestionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchCreateQuestionsResponse.ProtoReflect.Descriptor instead.
 func (*BatchCreateQuestionsResponse) Descriptor() ([]byte, []int) {
-	return file_question_proto_rawDescGZIP(), []int{4}
+	return file_question_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *BatchCreateQuestionsResponse) GetQuestions() []*Question {
@@ -292,15 +631,16 @@ func (x *BatchCreateQuestionsResponse) GetQuestions() []*Question {
 }
 
 type BatchGetQuestionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	QuizId        string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	QuizId         string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	ShuffleOptions bool                   `protobuf:"varint,2,opt,name=shuffle_options,json=shuffleOptions,proto3" json:"shuffle_options,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *BatchGetQuestionsRequest) Reset() {
 	*x = BatchGetQuestionsRequest{}
-	mi := &file_question_proto_msgTypes[5]
+	mi := &file_question_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -312,7 +652,7 @@ func (x *BatchGetQuestionsRequest) String() string {
 func (*BatchGetQuestionsRequest) ProtoMessage() {}
 
 func (x *BatchGetQuestionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_question_proto_msgTypes[5]
+	mi := &file_question_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -325,7 +665,7 @@ func (x *BatchGetQuestionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetQuestionsRequest.ProtoReflect.Descriptor instead.
 func (*BatchGetQuestionsRequest) Descriptor() ([]byte, []int) {
-	return file_question_proto_rawDescGZIP(), []int{5}
+	return file_question_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *BatchGetQuestionsRequest) GetQuizId() string {
@@ -335,6 +675,13 @@ func (x *BatchGetQuestionsRequest) GetQuizId() string {
 	return ""
 }
 
+func (x *BatchGetQuestionsRequest) GetShuffleOptions() bool {
+	if x != nil {
+		return x.ShuffleOptions
+	}
+	return false
+}
+
 type BatchGetQuestionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Questions     []*QuestionResponse    `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"`
@@ -344,7 +691,7 @@ type BatchGetQuestionsResponse struct {
 
 func (x *BatchGetQuestionsResponse) Reset() {
 	*x = BatchGetQuestionsResponse{}
-	mi := &file_question_proto_msgTypes[6]
+	mi := &file_question_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -356,7 +703,7 @@ func (x *BatchGetQuestionsResponse) String() string {
 func (*BatchGetQuestionsResponse) ProtoMessage() {}
 
 func (x *BatchGetQuestionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_question_proto_msgTypes[6]
+	mi := &file_question_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -369,7 +716,7 @@ func (x *BatchGetQuestionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetQuestionsResponse.ProtoReflect.Descriptor instead.
 func (*BatchGetQuestionsResponse) Descriptor() ([]byte, []int) {
-	return file_question_proto_rawDescGZIP(), []int{6}
+	return file_question_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *BatchGetQuestionsResponse) GetQuestions() []*QuestionResponse {
@@ -380,18 +727,23 @@ func (x *BatchGetQuestionsResponse) GetQuestions() []*QuestionResponse {
 }
 
 type QuestionResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	QuizId        string                 `protobuf:"bytes,2,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
-	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
-	Options       []string               `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	QuizId string                 `protobuf:"bytes,2,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	Body   string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	// Deprecated: Marked as deprecated in question.proto.
+	Options       []string          `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"`
+	Type          QuestionType      `protobuf:"varint,5,opt,name=type,proto3,enum=question.v1.QuestionType" json:"type,omitempty"`
+	Choices       []*QuestionOption `protobuf:"bytes,6,rep,name=choices,proto3" json:"choices,omitempty"`
+	Position      int32             `protobuf:"varint,7,opt,name=position,proto3" json:"position,omitempty"`
+	ImageId       string            `protobuf:"bytes,8,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *QuestionResponse) Reset() {
 	*x = QuestionResponse{}
-	mi := &file_question_proto_msgTypes[7]
+	mi := &file_question_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -403,7 +755,7 @@ func (x *QuestionResponse) String() string {
 func (*QuestionResponse) ProtoMessage() {}
 
 func (x *QuestionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_question_proto_msgTypes[7]
+	mi := &file_question_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -416,7 +768,7 @@ func (x *QuestionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuestionResponse.ProtoReflect.Descriptor instead.
 func (*QuestionResponse) Descriptor() ([]byte, []int) {
-	return file_question_proto_rawDescGZIP(), []int{7}
+	return file_question_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *QuestionResponse) GetId() string {
@@ -440,6 +792,7 @@ func (x *QuestionResponse) GetBody() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in question.proto.
 func (x *QuestionResponse) GetOptions() []string {
 	if x != nil {
 		return x.Options
@@ -447,18 +800,266 @@ func (x *QuestionResponse) GetOptions() []string {
 	return nil
 }
 
-type Answer struct {
+func (x *QuestionResponse) GetType() QuestionType {
+	if x != nil {
+		return x.Type
+	}
+	return QuestionType_QUESTION_TYPE_UNSPECIFIED
+}
+
+func (x *QuestionResponse) GetChoices() []*QuestionOption {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+func (x *QuestionResponse) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *QuestionResponse) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+type UpdateQuestionRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	QuizId string                 `protobuf:"bytes,2,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	Body   string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	// Deprecated: Marked as deprecated in question.proto.
+	OptionsWeights map[string]*OptionWeights `protobuf:"bytes,4,rep,name=options_weights,json=optionsWeights,proto3" json:"options_weights,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Type           QuestionType              `protobuf:"varint,5,opt,name=type,proto3,enum=question.v1.QuestionType" json:"type,omitempty"`
+	Options        []*Option                 `protobuf:"bytes,6,rep,name=options,proto3" json:"options,omitempty"`
+	Route          *Route                    `protobuf:"bytes,7,opt,name=route,proto3" json:"route,omitempty"`
+	Tags           []string                  `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	ImageId        string                    `protobuf:"bytes,9,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateQuestionRequest) Reset() {
+	*x = UpdateQuestionRequest{}
+	mi := &file_question_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateQuestionRequest) ProtoMessage() {}
+
+func (x *UpdateQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_question_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateQuestionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_question_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateQuestionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateQuestionRequest) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *UpdateQuestionRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in question.proto.
+func (x *UpdateQuestionRequest) GetOptionsWeights() map[string]*OptionWeights {
+	if x != nil {
+		return x.OptionsWeights
+	}
+	return nil
+}
+
+func (x *UpdateQuestionRequest) GetType() QuestionType {
+	if x != nil {
+		return x.Type
+	}
+	return QuestionType_QUESTION_TYPE_UNSPECIFIED
+}
+
+func (x *UpdateQuestionRequest) GetOptions() []*Option {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *UpdateQuestionRequest) GetRoute() *Route {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+func (x *UpdateQuestionRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateQuestionRequest) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+type DeleteQuestionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	QuizId        string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
-	QuestionId    string                 `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
-	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	QuizId        string                 `protobuf:"bytes,2,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteQuestionRequest) Reset() {
+	*x = DeleteQuestionRequest{}
+	mi := &file_question_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQuestionRequest) ProtoMessage() {}
+
+func (x *DeleteQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_question_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQuestionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_question_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteQuestionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteQuestionRequest) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+type DeleteQuestionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteQuestionResponse) Reset() {
+	*x = DeleteQuestionResponse{}
+	mi := &file_question_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQuestionResponse) ProtoMessage() {}
+
+func (x *DeleteQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_question_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQuestionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_question_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteQuestionResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteQuestionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type Answer struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	QuizId     string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	QuestionId string                 `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	// Deprecated: Marked as deprecated in question.proto.
+	Body string `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	// Deprecated: Marked as deprecated in question.proto.
+	Options       []string `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"`
+	Value         *float32 `protobuf:"fixed32,5,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	OptionId      string   `protobuf:"bytes,6,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	OptionIds     []string `protobuf:"bytes,7,rep,name=option_ids,json=optionIds,proto3" json:"option_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Answer) Reset() {
 	*x = Answer{}
-	mi := &file_question_proto_msgTypes[8]
+	mi := &file_question_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -470,7 +1071,7 @@ func (x *Answer) String() string {
 func (*Answer) ProtoMessage() {}
 
 func (x *Answer) ProtoReflect() protoreflect.Message {
-	mi := &file_question_proto_msgTypes[8]
+	mi := &file_question_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -483,7 +1084,7 @@ func (x *Answer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Answer.ProtoReflect.Descriptor instead.
 func (*Answer) Descriptor() ([]byte, []int) {
-	return file_question_proto_rawDescGZIP(), []int{8}
+	return file_question_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Answer) GetQuizId() string {
@@ -500,6 +1101,7 @@ func (x *Answer) GetQuestionId() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in question.proto.
 func (x *Answer) GetBody() string {
 	if x != nil {
 		return x.Body
@@ -507,17 +1109,47 @@ func (x *Answer) GetBody() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in question.proto.
+func (x *Answer) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Answer) GetValue() float32 {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return 0
+}
+
+func (x *Answer) GetOptionId() string {
+	if x != nil {
+		return x.OptionId
+	}
+	return ""
+}
+
+func (x *Answer) GetOptionIds() []string {
+	if x != nil {
+		return x.OptionIds
+	}
+	return nil
+}
+
 type EvaluateAnswersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	QuizId        string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
-	Answers       []*Answer              `protobuf:"bytes,2,rep,name=answers,proto3" json:"answers,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	QuizId         string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	Answers        []*Answer              `protobuf:"bytes,2,rep,name=answers,proto3" json:"answers,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *EvaluateAnswersRequest) Reset() {
 	*x = EvaluateAnswersRequest{}
-	mi := &file_question_proto_msgTypes[9]
+	mi := &file_question_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -529,7 +1161,7 @@ func (x *EvaluateAnswersRequest) String() string {
 func (*EvaluateAnswersRequest) ProtoMessage() {}
 
 func (x *EvaluateAnswersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_question_proto_msgTypes[9]
+	mi := &file_question_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -542,7 +1174,7 @@ func (x *EvaluateAnswersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EvaluateAnswersRequest.ProtoReflect.Descriptor instead.
 func (*EvaluateAnswersRequest) Descriptor() ([]byte, []int) {
-	return file_question_proto_rawDescGZIP(), []int{9}
+	return file_question_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *EvaluateAnswersRequest) GetQuizId() string {
@@ -559,16 +1191,100 @@ func (x *EvaluateAnswersRequest) GetAnswers() []*Answer {
 	return nil
 }
 
-type EvaluateAnswersResponse struct {
+func (x *EvaluateAnswersRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type ResultScore struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Total         float32                `protobuf:"fixed32,2,opt,name=total,proto3" json:"total,omitempty"`
+	Percentage    float32                `protobuf:"fixed32,3,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	Rank          int32                  `protobuf:"varint,4,opt,name=rank,proto3" json:"rank,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *ResultScore) Reset() {
+	*x = ResultScore{}
+	mi := &file_question_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResultScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultScore) ProtoMessage() {}
+
+func (x *ResultScore) ProtoReflect() protoreflect.Message {
+	mi := &file_question_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResultScore.ProtoReflect.Descriptor instead.
+func (*ResultScore) Descriptor() ([]byte, []int) {
+	return file_question_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ResultScore) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *ResultScore) GetTotal() float32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ResultScore) GetPercentage() float32 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+func (x *ResultScore) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+type EvaluateAnswersResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Result         string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Scores         []*ResultScore         `protobuf:"bytes,2,rep,name=scores,proto3" json:"scores,omitempty"`
+	TiedResults    []string               `protobuf:"bytes,3,rep,name=tied_results,json=tiedResults,proto3" json:"tied_results,omitempty"`
+	TieBreakPolicy v1.TieBreakPolicy      `protobuf:"varint,4,opt,name=tie_break_policy,json=tieBreakPolicy,proto3,enum=quiz.v1.TieBreakPolicy" json:"tie_break_policy,omitempty"`
+	ScoringModel   v1.ScoringModel        `protobuf:"varint,5,opt,name=scoring_model,json=scoringModel,proto3,enum=quiz.v1.ScoringModel" json:"scoring_model,omitempty"`
+	Score          float32                `protobuf:"fixed32,6,opt,name=score,proto3" json:"score,omitempty"`
+	MaxScore       float32                `protobuf:"fixed32,7,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	CorrectAnswers int32                  `protobuf:"varint,8,opt,name=correct_answers,json=correctAnswers,proto3" json:"correct_answers,omitempty"`
+	Traits         []*TraitScore          `protobuf:"bytes,9,rep,name=traits,proto3" json:"traits,omitempty"`
+	ResultDetail   *v1.ResultDetail       `protobuf:"bytes,10,opt,name=result_detail,json=resultDetail,proto3" json:"result_detail,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
 func (x *EvaluateAnswersResponse) Reset() {
 	*x = EvaluateAnswersResponse{}
-	mi := &file_question_proto_msgTypes[10]
+	mi := &file_question_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -580,7 +1296,7 @@ func (x *EvaluateAnswersResponse) String() string {
 func (*EvaluateAnswersResponse) ProtoMessage() {}
 
 func (x *EvaluateAnswersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_question_proto_msgTypes[10]
+	mi := &file_question_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -593,7 +1309,7 @@ func (x *EvaluateAnswersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EvaluateAnswersResponse.ProtoReflect.Descriptor instead.
 func (*EvaluateAnswersResponse) Descriptor() ([]byte, []int) {
-	return file_question_proto_rawDescGZIP(), []int{10}
+	return file_question_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *EvaluateAnswersResponse) GetResult() string {
@@ -603,25 +1319,182 @@ func (x *EvaluateAnswersResponse) GetResult() string {
 	return ""
 }
 
+func (x *EvaluateAnswersResponse) GetScores() []*ResultScore {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *EvaluateAnswersResponse) GetTiedResults() []string {
+	if x != nil {
+		return x.TiedResults
+	}
+	return nil
+}
+
+func (x *EvaluateAnswersResponse) GetTieBreakPolicy() v1.TieBreakPolicy {
+	if x != nil {
+		return x.TieBreakPolicy
+	}
+	return v1.TieBreakPolicy(0)
+}
+
+func (x *EvaluateAnswersResponse) GetScoringModel() v1.ScoringModel {
+	if x != nil {
+		return x.ScoringModel
+	}
+	return v1.ScoringModel(0)
+}
+
+func (x *EvaluateAnswersResponse) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *EvaluateAnswersResponse) GetMaxScore() float32 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *EvaluateAnswersResponse) GetCorrectAnswers() int32 {
+	if x != nil {
+		return x.CorrectAnswers
+	}
+	return 0
+}
+
+func (x *EvaluateAnswersResponse) GetTraits() []*TraitScore {
+	if x != nil {
+		return x.Traits
+	}
+	return nil
+}
+
+func (x *EvaluateAnswersResponse) GetResultDetail() *v1.ResultDetail {
+	if x != nil {
+		return x.ResultDetail
+	}
+	return nil
+}
+
+type TraitScore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Positive      string                 `protobuf:"bytes,1,opt,name=positive,proto3" json:"positive,omitempty"`
+	Negative      string                 `protobuf:"bytes,2,opt,name=negative,proto3" json:"negative,omitempty"`
+	Score         float32                `protobuf:"fixed32,3,opt,name=score,proto3" json:"score,omitempty"`
+	Pole          string                 `protobuf:"bytes,4,opt,name=pole,proto3" json:"pole,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TraitScore) Reset() {
+	*x = TraitScore{}
+	mi := &file_question_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TraitScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraitScore) ProtoMessage() {}
+
+func (x *TraitScore) ProtoReflect() protoreflect.Message {
+	mi := &file_question_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraitScore.ProtoReflect.Descriptor instead.
+func (*TraitScore) Descriptor() ([]byte, []int) {
+	return file_question_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TraitScore) GetPositive() string {
+	if x != nil {
+		return x.Positive
+	}
+	return ""
+}
+
+func (x *TraitScore) GetNegative() string {
+	if x != nil {
+		return x.Negative
+	}
+	return ""
+}
+
+func (x *TraitScore) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TraitScore) GetPole() string {
+	if x != nil {
+		return x.Pole
+	}
+	return ""
+}
+
 var File_question_proto protoreflect.FileDescriptor
 
 const file_question_proto_rawDesc = "" +
 	"\n" +
-	"\x0equestion.proto\x12\vquestion.v1\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\")\n" +
+	"\x0equestion.proto\x12\vquestion.v1\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\n" +
+	"quiz.proto\")\n" +
 	"\rOptionWeights\x12\x18\n" +
-	"\aweights\x18\x01 \x03(\x02R\aweights\"\xfa\x01\n" +
+	"\aweights\x18\x01 \x03(\x02R\aweights\"C\n" +
+	"\x05Route\x12(\n" +
+	"\x10next_question_id\x18\x01 \x01(\tR\x0enextQuestionId\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\bR\x03end\"\x8b\x01\n" +
+	"\x06Option\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x18\n" +
+	"\aweights\x18\x03 \x03(\x02R\aweights\x12(\n" +
+	"\x05route\x18\x04 \x01(\v2\x12.question.v1.RouteR\x05route\x12\x19\n" +
+	"\bimage_id\x18\x05 \x01(\tR\aimageId\"O\n" +
+	"\x0eQuestionOption\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x19\n" +
+	"\bimage_id\x18\x03 \x01(\tR\aimageId\"\xd1\x03\n" +
 	"\bQuestion\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\aquiz_id\x18\x02 \x01(\tR\x06quizId\x12\x12\n" +
-	"\x04body\x18\x03 \x01(\tR\x04body\x12R\n" +
-	"\x0foptions_weights\x18\x04 \x03(\v2).question.v1.Question.OptionsWeightsEntryR\x0eoptionsWeights\x1a]\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12V\n" +
+	"\x0foptions_weights\x18\x04 \x03(\v2).question.v1.Question.OptionsWeightsEntryB\x02\x18\x01R\x0eoptionsWeights\x12-\n" +
+	"\x04type\x18\x05 \x01(\x0e2\x19.question.v1.QuestionTypeR\x04type\x12-\n" +
+	"\aoptions\x18\x06 \x03(\v2\x13.question.v1.OptionR\aoptions\x12\x1a\n" +
+	"\bposition\x18\a \x01(\x05R\bposition\x12(\n" +
+	"\x05route\x18\b \x01(\v2\x12.question.v1.RouteR\x05route\x12\x12\n" +
+	"\x04tags\x18\t \x03(\tR\x04tags\x12\x19\n" +
+	"\bimage_id\x18\n" +
+	" \x01(\tR\aimageId\x1a]\n" +
 	"\x13OptionsWeightsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x120\n" +
-	"\x05value\x18\x02 \x01(\v2\x1a.question.v1.OptionWeightsR\x05value:\x028\x01\"\x84\x02\n" +
+	"\x05value\x18\x02 \x01(\v2\x1a.question.v1.OptionWeightsR\x05value:\x028\x01\"\xbf\x03\n" +
 	"\x15CreateQuestionRequest\x12\x17\n" +
 	"\aquiz_id\x18\x01 \x01(\tR\x06quizId\x12\x12\n" +
-	"\x04body\x18\x02 \x01(\tR\x04body\x12_\n" +
-	"\x0foptions_weights\x18\x03 \x03(\v26.question.v1.CreateQuestionRequest.OptionsWeightsEntryR\x0eoptionsWeights\x1a]\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\x12c\n" +
+	"\x0foptions_weights\x18\x03 \x03(\v26.question.v1.CreateQuestionRequest.OptionsWeightsEntryB\x02\x18\x01R\x0eoptionsWeights\x12-\n" +
+	"\x04type\x18\x04 \x01(\x0e2\x19.question.v1.QuestionTypeR\x04type\x12-\n" +
+	"\aoptions\x18\x05 \x03(\v2\x13.question.v1.OptionR\aoptions\x12(\n" +
+	"\x05route\x18\x06 \x01(\v2\x12.question.v1.RouteR\x05route\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\x12\x19\n" +
+	"\bimage_id\x18\b \x01(\tR\aimageId\x1a]\n" +
 	"\x13OptionsWeightsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x120\n" +
 	"\x05value\x18\x02 \x01(\v2\x1a.question.v1.OptionWeightsR\x05value:\x028\x01\"v\n" +
@@ -629,26 +1502,87 @@ const file_question_proto_rawDesc = "" +
 	"\aquiz_id\x18\x01 \x01(\tR\x06quizId\x12>\n" +
 	"\brequests\x18\x02 \x03(\v2\".question.v1.CreateQuestionRequestR\brequests\"S\n" +
 	"\x1cBatchCreateQuestionsResponse\x123\n" +
-	"\tquestions\x18\x01 \x03(\v2\x15.question.v1.QuestionR\tquestions\"3\n" +
+	"\tquestions\x18\x01 \x03(\v2\x15.question.v1.QuestionR\tquestions\"\\\n" +
 	"\x18BatchGetQuestionsRequest\x12\x17\n" +
-	"\aquiz_id\x18\x01 \x01(\tR\x06quizId\"X\n" +
+	"\aquiz_id\x18\x01 \x01(\tR\x06quizId\x12'\n" +
+	"\x0fshuffle_options\x18\x02 \x01(\bR\x0eshuffleOptions\"X\n" +
 	"\x19BatchGetQuestionsResponse\x12;\n" +
-	"\tquestions\x18\x01 \x03(\v2\x1d.question.v1.QuestionResponseR\tquestions\"i\n" +
+	"\tquestions\x18\x01 \x03(\v2\x1d.question.v1.QuestionResponseR\tquestions\"\x8a\x02\n" +
 	"\x10QuestionResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\aquiz_id\x18\x02 \x01(\tR\x06quizId\x12\x12\n" +
-	"\x04body\x18\x03 \x01(\tR\x04body\x12\x18\n" +
-	"\aoptions\x18\x04 \x03(\tR\aoptions\"V\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12\x1c\n" +
+	"\aoptions\x18\x04 \x03(\tB\x02\x18\x01R\aoptions\x12-\n" +
+	"\x04type\x18\x05 \x01(\x0e2\x19.question.v1.QuestionTypeR\x04type\x125\n" +
+	"\achoices\x18\x06 \x03(\v2\x1b.question.v1.QuestionOptionR\achoices\x12\x1a\n" +
+	"\bposition\x18\a \x01(\x05R\bposition\x12\x19\n" +
+	"\bimage_id\x18\b \x01(\tR\aimageId\"\xcf\x03\n" +
+	"\x15UpdateQuestionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\aquiz_id\x18\x02 \x01(\tR\x06quizId\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12c\n" +
+	"\x0foptions_weights\x18\x04 \x03(\v26.question.v1.UpdateQuestionRequest.OptionsWeightsEntryB\x02\x18\x01R\x0eoptionsWeights\x12-\n" +
+	"\x04type\x18\x05 \x01(\x0e2\x19.question.v1.QuestionTypeR\x04type\x12-\n" +
+	"\aoptions\x18\x06 \x03(\v2\x13.question.v1.OptionR\aoptions\x12(\n" +
+	"\x05route\x18\a \x01(\v2\x12.question.v1.RouteR\x05route\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x12\x19\n" +
+	"\bimage_id\x18\t \x01(\tR\aimageId\x1a]\n" +
+	"\x13OptionsWeightsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x120\n" +
+	"\x05value\x18\x02 \x01(\v2\x1a.question.v1.OptionWeightsR\x05value:\x028\x01\"@\n" +
+	"\x15DeleteQuestionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\aquiz_id\x18\x02 \x01(\tR\x06quizId\"B\n" +
+	"\x16DeleteQuestionResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xd9\x01\n" +
 	"\x06Answer\x12\x17\n" +
 	"\aquiz_id\x18\x01 \x01(\tR\x06quizId\x12\x1f\n" +
 	"\vquestion_id\x18\x02 \x01(\tR\n" +
-	"questionId\x12\x12\n" +
-	"\x04body\x18\x03 \x01(\tR\x04body\"`\n" +
+	"questionId\x12\x16\n" +
+	"\x04body\x18\x03 \x01(\tB\x02\x18\x01R\x04body\x12\x1c\n" +
+	"\aoptions\x18\x04 \x03(\tB\x02\x18\x01R\aoptions\x12\x19\n" +
+	"\x05value\x18\x05 \x01(\x02H\x00R\x05value\x88\x01\x01\x12\x1b\n" +
+	"\toption_id\x18\x06 \x01(\tR\boptionId\x12\x1d\n" +
+	"\n" +
+	"option_ids\x18\a \x03(\tR\toptionIdsB\b\n" +
+	"\x06_value\"\x89\x01\n" +
 	"\x16EvaluateAnswersRequest\x12\x17\n" +
 	"\aquiz_id\x18\x01 \x01(\tR\x06quizId\x12-\n" +
-	"\aanswers\x18\x02 \x03(\v2\x13.question.v1.AnswerR\aanswers\"1\n" +
+	"\aanswers\x18\x02 \x03(\v2\x13.question.v1.AnswerR\aanswers\x12'\n" +
+	"\x0fidempotency_key\x18\x03 \x01(\tR\x0eidempotencyKey\"o\n" +
+	"\vResultScore\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\tR\x06result\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x02R\x05total\x12\x1e\n" +
+	"\n" +
+	"percentage\x18\x03 \x01(\x02R\n" +
+	"percentage\x12\x12\n" +
+	"\x04rank\x18\x04 \x01(\x05R\x04rank\"\xce\x03\n" +
 	"\x17EvaluateAnswersResponse\x12\x16\n" +
-	"\x06result\x18\x01 \x01(\tR\x06result2\x8e\x04\n" +
+	"\x06result\x18\x01 \x01(\tR\x06result\x120\n" +
+	"\x06scores\x18\x02 \x03(\v2\x18.question.v1.ResultScoreR\x06scores\x12!\n" +
+	"\ftied_results\x18\x03 \x03(\tR\vtiedResults\x12A\n" +
+	"\x10tie_break_policy\x18\x04 \x01(\x0e2\x17.quiz.v1.TieBreakPolicyR\x0etieBreakPolicy\x12:\n" +
+	"\rscoring_model\x18\x05 \x01(\x0e2\x15.quiz.v1.ScoringModelR\fscoringModel\x12\x14\n" +
+	"\x05score\x18\x06 \x01(\x02R\x05score\x12\x1b\n" +
+	"\tmax_score\x18\a \x01(\x02R\bmaxScore\x12'\n" +
+	"\x0fcorrect_answers\x18\b \x01(\x05R\x0ecorrectAnswers\x12/\n" +
+	"\x06traits\x18\t \x03(\v2\x17.question.v1.TraitScoreR\x06traits\x12:\n" +
+	"\rresult_detail\x18\n" +
+	" \x01(\v2\x15.quiz.v1.ResultDetailR\fresultDetail\"n\n" +
+	"\n" +
+	"TraitScore\x12\x1a\n" +
+	"\bpositive\x18\x01 \x01(\tR\bpositive\x12\x1a\n" +
+	"\bnegative\x18\x02 \x01(\tR\bnegative\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x02R\x05score\x12\x12\n" +
+	"\x04pole\x18\x04 \x01(\tR\x04pole*\xbe\x01\n" +
+	"\fQuestionType\x12\x1d\n" +
+	"\x19QUESTION_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bQUESTION_TYPE_SINGLE_CHOICE\x10\x01\x12\x1e\n" +
+	"\x1aQUESTION_TYPE_MULTI_SELECT\x10\x02\x12\x18\n" +
+	"\x14QUESTION_TYPE_LIKERT\x10\x03\x12\x19\n" +
+	"\x15QUESTION_TYPE_NUMERIC\x10\x04\x12\x19\n" +
+	"\x15QUESTION_TYPE_RANKING\x10\x052\xc9\x06\n" +
 	"\x0fQuestionService\x12\xb0\x01\n" +
 	"\x14BatchCreateQuestions\x12(.question.v1.BatchCreateQuestionsRequest\x1a).question.v1.BatchCreateQuestionsResponse\"C\x92A\x12b\x10\n" +
 	"\x0e\n" +
@@ -661,7 +1595,15 @@ const file_question_proto_rawDesc = "" +
 	"\x0fEvaluateAnswers\x12#.question.v1.EvaluateAnswersRequest\x1a$.question.v1.EvaluateAnswersResponse\"B\x92A\x12b\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02':\x01*\"\"/api/v1/quizzes/{quiz_id}/evaluateBSZQgithub.com/mibrgmv/whoami-server/gateway/internal/protogen/question/v1;questionv1b\x06proto3"
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02':\x01*\"\"/api/v1/quizzes/{quiz_id}/evaluate\x12\x95\x01\n" +
+	"\x0eUpdateQuestion\x12\".question.v1.UpdateQuestionRequest\x1a\x15.question.v1.Question\"H\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02-:\x01*\x1a(/api/v1/quizzes/{quiz_id}/questions/{id}\x12\xa0\x01\n" +
+	"\x0eDeleteQuestion\x12\".question.v1.DeleteQuestionRequest\x1a#.question.v1.DeleteQuestionResponse\"E\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02**(/api/v1/quizzes/{quiz_id}/questions/{id}BSZQgithub.com/mibrgmv/whoami-server/gateway/internal/protogen/question/v1;questionv1b\x06proto3"
 
 var (
 	file_question_proto_rawDescOnce sync.Once
@@ -675,42 +1617,79 @@ func file_question_proto_rawDescGZIP() []byte {
 	return file_question_proto_rawDescData
 }
 
-var file_question_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_question_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_question_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_question_proto_goTypes = []any{
-	(*OptionWeights)(nil),                // 0: question.v1.OptionWeights
-	(*Question)(nil),                     // 1: question.v1.Question
-	(*CreateQuestionRequest)(nil),        // 2: question.v1.CreateQuestionRequest
-	(*BatchCreateQuestionsRequest)(nil),  // 3: question.v1.BatchCreateQuestionsRequest
-	(*BatchCreateQuestionsResponse)(nil), // 4: question.v1.BatchCreateQuestionsResponse
-	(*BatchGetQuestionsRequest)(nil),     // 5: question.v1.BatchGetQuestionsRequest
-	(*BatchGetQuestionsResponse)(nil),    // 6: question.v1.BatchGetQuestionsResponse
-	(*QuestionResponse)(nil),             // 7: question.v1.QuestionResponse
-	(*Answer)(nil),                       // 8: question.v1.Answer
-	(*EvaluateAnswersRequest)(nil),       // 9: question.v1.EvaluateAnswersRequest
-	(*EvaluateAnswersResponse)(nil),      // 10: question.v1.EvaluateAnswersResponse
-	nil,                                  // 11: question.v1.Question.OptionsWeightsEntry
-	nil,                                  // 12: question.v1.CreateQuestionRequest.OptionsWeightsEntry
+	(QuestionType)(0),                    // 0: question.v1.QuestionType
+	(*OptionWeights)(nil),                // 1: question.v1.OptionWeights
+	(*Route)(nil),                        // 2: question.v1.Route
+	(*Option)(nil),                       // 3: question.v1.Option
+	(*QuestionOption)(nil),               // 4: question.v1.QuestionOption
+	(*Question)(nil),                     // 5: question.v1.Question
+	(*CreateQuestionRequest)(nil),        // 6: question.v1.CreateQuestionRequest
+	(*BatchCreateQuestionsRequest)(nil),  // 7: question.v1.BatchCreateQuestionsRequest
+	(*BatchCreateQuestionsResponse)(nil), // 8: question.v1.BatchCreateQuestionsResponse
+	(*BatchGetQuestionsRequest)(nil),     // 9: question.v1.BatchGetQuestionsRequest
+	(*BatchGetQuestionsResponse)(nil),    // 10: question.v1.BatchGetQuestionsResponse
+	(*QuestionResponse)(nil),             // 11: question.v1.QuestionResponse
+	(*UpdateQuestionRequest)(nil),        // 12: question.v1.UpdateQuestionRequest
+	(*DeleteQuestionRequest)(nil),        // 13: question.v1.DeleteQuestionRequest
+	(*DeleteQuestionResponse)(nil),       // 14: question.v1.DeleteQuestionResponse
+	(*Answer)(nil),                       // 15: question.v1.Answer
+	(*EvaluateAnswersRequest)(nil),       // 16: question.v1.EvaluateAnswersRequest
+	(*ResultScore)(nil),                  // 17: question.v1.ResultScore
+	(*EvaluateAnswersResponse)(nil),      // 18: question.v1.EvaluateAnswersResponse
+	(*TraitScore)(nil),                   // 19: question.v1.TraitScore
+	nil,                                  // 20: question.v1.Question.OptionsWeightsEntry
+	nil,                                  // 21: question.v1.CreateQuestionRequest.OptionsWeightsEntry
+	nil,                                  // 22: question.v1.UpdateQuestionRequest.OptionsWeightsEntry
+	(v1.TieBreakPolicy)(0),               // 23: quiz.v1.TieBreakPolicy
+	(v1.ScoringModel)(0),                 // 24: quiz.v1.ScoringModel
+	(*v1.ResultDetail)(nil),              // 25: quiz.v1.ResultDetail
 }
 var file_question_proto_depIdxs = []int32{
-	11, // 0: question.v1.Question.options_weights:type_name -> question.v1.Question.OptionsWeightsEntry
-	12, // 1: question.v1.CreateQuestionRequest.options_weights:type_name -> question.v1.CreateQuestionRequest.OptionsWeightsEntry
-	2,  // 2: question.v1.BatchCreateQuestionsRequest.requests:type_name -> question.v1.CreateQuestionRequest
-	1,  // 3: question.v1.BatchCreateQuestionsResponse.questions:type_name -> question.v1.Question
-	7,  // 4: question.v1.BatchGetQuestionsResponse.questions:type_name -> question.v1.QuestionResponse
-	8,  // 5: question.v1.EvaluateAnswersRequest.answers:type_name -> question.v1.Answer
-	0,  // 6: question.v1.Question.OptionsWeightsEntry.value:type_name -> question.v1.OptionWeights
-	0,  // 7: question.v1.CreateQuestionRequest.OptionsWeightsEntry.value:type_name -> question.v1.OptionWeights
-	3,  // 8: question.v1.QuestionService.BatchCreateQuestions:input_type -> question.v1.BatchCreateQuestionsRequest
-	5,  // 9: question.v1.QuestionService.BatchGetQuestions:input_type -> question.v1.BatchGetQuestionsRequest
-	9,  // 10: question.v1.QuestionService.EvaluateAnswers:input_type -> question.v1.EvaluateAnswersRequest
-	4,  // 11: question.v1.QuestionService.BatchCreateQuestions:output_type -> question.v1.BatchCreateQuestionsResponse
-	6,  // 12: question.v1.QuestionService.BatchGetQuestions:output_type -> question.v1.BatchGetQuestionsResponse
-	10, // 13: question.v1.QuestionService.EvaluateAnswers:output_type -> question.v1.EvaluateAnswersResponse
-	11, // [11:14] is the sub-list for method output_type
-	8,  // [8:11] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	2,  // 0: question.v1.Option.route:type_name -> question.v1.Route
+	20, // 1: question.v1.Question.options_weights:type_name -> question.v1.Question.OptionsWeightsEntry
+	0,  // 2: question.v1.Question.type:type_name -> question.v1.QuestionType
+	3,  // 3: question.v1.Question.options:type_name -> question.v1.Option
+	2,  // 4: question.v1.Question.route:type_name -> question.v1.Route
+	21, // 5: question.v1.CreateQuestionRequest.options_weights:type_name -> question.v1.CreateQuestionRequest.OptionsWeightsEntry
+	0,  // 6: question.v1.CreateQuestionRequest.type:type_name -> question.v1.QuestionType
+	3,  // 7: question.v1.CreateQuestionRequest.options:type_name -> question.v1.Option
+	2,  // 8: question.v1.CreateQuestionRequest.route:type_name -> question.v1.Route
+	6,  // 9: question.v1.BatchCreateQuestionsRequest.requests:type_name -> question.v1.CreateQuestionRequest
+	5,  // 10: question.v1.BatchCreateQuestionsResponse.questions:type_name -> question.v1.Question
+	11, // 11: question.v1.BatchGetQuestionsResponse.questions:type_name -> question.v1.QuestionResponse
+	0,  // 12: question.v1.QuestionResponse.type:type_name -> question.v1.QuestionType
+	4,  // 13: question.v1.QuestionResponse.choices:type_name -> question.v1.QuestionOption
+	22, // 14: question.v1.UpdateQuestionRequest.options_weights:type_name -> question.v1.UpdateQuestionRequest.OptionsWeightsEntry
+	0,  // 15: question.v1.UpdateQuestionRequest.type:type_name -> question.v1.QuestionType
+	3,  // 16: question.v1.UpdateQuestionRequest.options:type_name -> question.v1.Option
+	2,  // 17: question.v1.UpdateQuestionRequest.route:type_name -> question.v1.Route
+	15, // 18: question.v1.EvaluateAnswersRequest.answers:type_name -> question.v1.Answer
+	17, // 19: question.v1.EvaluateAnswersResponse.scores:type_name -> question.v1.ResultScore
+	23, // 20: question.v1.EvaluateAnswersResponse.tie_break_policy:type_name -> quiz.v1.TieBreakPolicy
+	24, // 21: question.v1.EvaluateAnswersResponse.scoring_model:type_name -> quiz.v1.ScoringModel
+	19, // 22: question.v1.EvaluateAnswersResponse.traits:type_name -> question.v1.TraitScore
+	25, // 23: question.v1.EvaluateAnswersResponse.result_detail:type_name -> quiz.v1.ResultDetail
+	1,  // 24: question.v1.Question.OptionsWeightsEntry.value:type_name -> question.v1.OptionWeights
+	1,  // 25: question.v1.CreateQuestionRequest.OptionsWeightsEntry.value:type_name -> question.v1.OptionWeights
+	1,  // 26: question.v1.UpdateQuestionRequest.OptionsWeightsEntry.value:type_name -> question.v1.OptionWeights
+	7,  // 27: question.v1.QuestionService.BatchCreateQuestions:input_type -> question.v1.BatchCreateQuestionsRequest
+	9,  // 28: question.v1.QuestionService.BatchGetQuestions:input_type -> question.v1.BatchGetQuestionsRequest
+	16, // 29: question.v1.QuestionService.EvaluateAnswers:input_type -> question.v1.EvaluateAnswersRequest
+	12, // 30: question.v1.QuestionService.UpdateQuestion:input_type -> question.v1.UpdateQuestionRequest
+	13, // 31: question.v1.QuestionService.DeleteQuestion:input_type -> question.v1.DeleteQuestionRequest
+	8,  // 32: question.v1.QuestionService.BatchCreateQuestions:output_type -> question.v1.BatchCreateQuestionsResponse
+	10, // 33: question.v1.QuestionService.BatchGetQuestions:output_type -> question.v1.BatchGetQuestionsResponse
+	18, // 34: question.v1.QuestionService.EvaluateAnswers:output_type -> question.v1.EvaluateAnswersResponse
+	5,  // 35: question.v1.QuestionService.UpdateQuestion:output_type -> question.v1.Question
+	14, // 36: question.v1.QuestionService.DeleteQuestion:output_type -> question.v1.DeleteQuestionResponse
+	32, // [32:37] is the sub-list for method output_type
+	27, // [27:32] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_question_proto_init() }
@@ -718,18 +1697,20 @@ func file_question_proto_init() {
 	if File_question_proto != nil {
 		return
 	}
+	file_question_proto_msgTypes[14].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_question_proto_rawDesc), len(file_question_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   13,
+			NumEnums:      1,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_question_proto_goTypes,
 		DependencyIndexes: file_question_proto_depIdxs,
+		EnumInfos:         file_question_proto_enumTypes,
 		MessageInfos:      file_question_proto_msgTypes,
 	}.Build()
 	File_question_proto = out.File
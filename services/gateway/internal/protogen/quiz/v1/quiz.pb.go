@@ -11,6 +11,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -23,30 +24,1793 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type QuizStatus int32
+
+const (
+	QuizStatus_QUIZ_STATUS_UNSPECIFIED QuizStatus = 0
+	QuizStatus_QUIZ_STATUS_DRAFT       QuizStatus = 1
+	QuizStatus_QUIZ_STATUS_PUBLISHED   QuizStatus = 2
+	QuizStatus_QUIZ_STATUS_ARCHIVED    QuizStatus = 3
+)
+
+// Enum value maps for QuizStatus.
+var (
+	QuizStatus_name = map[int32]string{
+		0: "QUIZ_STATUS_UNSPECIFIED",
+		1: "QUIZ_STATUS_DRAFT",
+		2: "QUIZ_STATUS_PUBLISHED",
+		3: "QUIZ_STATUS_ARCHIVED",
+	}
+	QuizStatus_value = map[string]int32{
+		"QUIZ_STATUS_UNSPECIFIED": 0,
+		"QUIZ_STATUS_DRAFT":       1,
+		"QUIZ_STATUS_PUBLISHED":   2,
+		"QUIZ_STATUS_ARCHIVED":    3,
+	}
+)
+
+func (x QuizStatus) Enum() *QuizStatus {
+	p := new(QuizStatus)
+	*p = x
+	return p
+}
+
+func (x QuizStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QuizStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_quiz_proto_enumTypes[0].Descriptor()
+}
+
+func (QuizStatus) Type() protoreflect.EnumType {
+	return &file_quiz_proto_enumTypes[0]
+}
+
+func (x QuizStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QuizStatus.Descriptor instead.
+func (QuizStatus) EnumDescriptor() ([]byte, []int) {
+	return file_quiz_proto_rawDescGZIP(), []int{0}
+}
+
+type TieBreakPolicy int32
+
+const (
+	TieBreakPolicy_TIE_BREAK_POLICY_UNSPECIFIED         TieBreakPolicy = 0
+	TieBreakPolicy_TIE_BREAK_POLICY_FIRST_DECLARED      TieBreakPolicy = 1
+	TieBreakPolicy_TIE_BREAK_POLICY_RANDOM              TieBreakPolicy = 2
+	TieBreakPolicy_TIE_BREAK_POLICY_REPORT_ALL          TieBreakPolicy = 3
+	TieBreakPolicy_TIE_BREAK_POLICY_TIEBREAKER_QUESTION TieBreakPolicy = 4
+)
+
+// Enum value maps for TieBreakPolicy.
+var (
+	TieBreakPolicy_name = map[int32]string{
+		0: "TIE_BREAK_POLICY_UNSPECIFIED",
+		1: "TIE_BREAK_POLICY_FIRST_DECLARED",
+		2: "TIE_BREAK_POLICY_RANDOM",
+		3: "TIE_BREAK_POLICY_REPORT_ALL",
+		4: "TIE_BREAK_POLICY_TIEBREAKER_QUESTION",
+	}
+	TieBreakPolicy_value = map[string]int32{
+		"TIE_BREAK_POLICY_UNSPECIFIED":         0,
+		"TIE_BREAK_POLICY_FIRST_DECLARED":      1,
+		"TIE_BREAK_POLICY_RANDOM":              2,
+		"TIE_BREAK_POLICY_REPORT_ALL":          3,
+		"TIE_BREAK_POLICY_TIEBREAKER_QUESTION": 4,
+	}
+)
+
+func (x TieBreakPolicy) Enum() *TieBreakPolicy {
+	p := new(TieBreakPolicy)
+	*p = x
+	return p
+}
+
+func (x TieBreakPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TieBreakPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_quiz_proto_enumTypes[1].Descriptor()
+}
+
+func (TieBreakPolicy) Type() protoreflect.EnumType {
+	return &file_quiz_proto_enumTypes[1]
+}
+
+func (x TieBreakPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TieBreakPolicy.Descriptor instead.
+func (TieBreakPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_quiz_proto_rawDescGZIP(), []int{1}
+}
+
+type ScoringModel int32
+
+const (
+	ScoringModel_SCORING_MODEL_UNSPECIFIED  ScoringModel = 0
+	ScoringModel_SCORING_MODEL_WEIGHTED_SUM ScoringModel = 1
+	ScoringModel_SCORING_MODEL_KNOWLEDGE    ScoringModel = 2
+	ScoringModel_SCORING_MODEL_TRAITS       ScoringModel = 3
+	ScoringModel_SCORING_MODEL_BANDS        ScoringModel = 4
+)
+
+// Enum value maps for ScoringModel.
+var (
+	ScoringModel_name = map[int32]string{
+		0: "SCORING_MODEL_UNSPECIFIED",
+		1: "SCORING_MODEL_WEIGHTED_SUM",
+		2: "SCORING_MODEL_KNOWLEDGE",
+		3: "SCORING_MODEL_TRAITS",
+		4: "SCORING_MODEL_BANDS",
+	}
+	ScoringModel_value = map[string]int32{
+		"SCORING_MODEL_UNSPECIFIED":  0,
+		"SCORING_MODEL_WEIGHTED_SUM": 1,
+		"SCORING_MODEL_KNOWLEDGE":    2,
+		"SCORING_MODEL_TRAITS":       3,
+		"SCORING_MODEL_BANDS":        4,
+	}
+)
+
+func (x ScoringModel) Enum() *ScoringModel {
+	p := new(ScoringModel)
+	*p = x
+	return p
+}
+
+func (x ScoringModel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScoringModel) Descriptor() protoreflect.EnumDescriptor {
+	return file_quiz_proto_enumTypes[2].Descriptor()
+}
+
+func (ScoringModel) Type() protoreflect.EnumType {
+	return &file_quiz_proto_enumTypes[2]
+}
+
+func (x ScoringModel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScoringModel.Descriptor instead.
+func (ScoringModel) EnumDescriptor() ([]byte, []int) {
+	return file_quiz_proto_rawDescGZIP(), []int{2}
+}
+
+type QuizSortOrder int32
+
+const (
+	QuizSortOrder_QUIZ_SORT_ORDER_UNSPECIFIED    QuizSortOrder = 0
+	QuizSortOrder_QUIZ_SORT_ORDER_NEWEST         QuizSortOrder = 1
+	QuizSortOrder_QUIZ_SORT_ORDER_MOST_COMPLETED QuizSortOrder = 2
+	QuizSortOrder_QUIZ_SORT_ORDER_TITLE          QuizSortOrder = 3
+	QuizSortOrder_QUIZ_SORT_ORDER_TOP_RATED      QuizSortOrder = 4
+	QuizSortOrder_QUIZ_SORT_ORDER_MOST_LIKED     QuizSortOrder = 5
+)
+
+// Enum value maps for QuizSortOrder.
+var (
+	QuizSortOrder_name = map[int32]string{
+		0: "QUIZ_SORT_ORDER_UNSPECIFIED",
+		1: "QUIZ_SORT_ORDER_NEWEST",
+		2: "QUIZ_SORT_ORDER_MOST_COMPLETED",
+		3: "QUIZ_SORT_ORDER_TITLE",
+		4: "QUIZ_SORT_ORDER_TOP_RATED",
+		5: "QUIZ_SORT_ORDER_MOST_LIKED",
+	}
+	QuizSortOrder_value = map[string]int32{
+		"QUIZ_SORT_ORDER_UNSPECIFIED":    0,
+		"QUIZ_SORT_ORDER_NEWEST":         1,
+		"QUIZ_SORT_ORDER_MOST_COMPLETED": 2,
+		"QUIZ_SORT_ORDER_TITLE":          3,
+		"QUIZ_SORT_ORDER_TOP_RATED":      4,
+		"QUIZ_SORT_ORDER_MOST_LIKED":     5,
+	}
+)
+
+func (x QuizSortOrder) Enum() *QuizSortOrder {
+	p := new(QuizSortOrder)
+	*p = x
+	return p
+}
+
+func (x QuizSortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QuizSortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_quiz_proto_enumTypes[3].Descriptor()
+}
+
+func (QuizSortOrder) Type() protoreflect.EnumType {
+	return &file_quiz_proto_enumTypes[3]
+}
+
+func (x QuizSortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QuizSortOrder.Descriptor instead.
+func (QuizSortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_quiz_proto_rawDescGZIP(), []int{3}
+}
+
+type RecommendationReason int32
+
+const (
+	RecommendationReason_RECOMMENDATION_REASON_UNSPECIFIED   RecommendationReason = 0
+	RecommendationReason_RECOMMENDATION_REASON_SIMILAR_USERS RecommendationReason = 1
+	RecommendationReason_RECOMMENDATION_REASON_POPULAR       RecommendationReason = 2
+)
+
+// Enum value maps for RecommendationReason.
+var (
+	RecommendationReason_name = map[int32]string{
+		0: "RECOMMENDATION_REASON_UNSPECIFIED",
+		1: "RECOMMENDATION_REASON_SIMILAR_USERS",
+		2: "RECOMMENDATION_REASON_POPULAR",
+	}
+	RecommendationReason_value = map[string]int32{
+		"RECOMMENDATION_REASON_UNSPECIFIED":   0,
+		"RECOMMENDATION_REASON_SIMILAR_USERS": 1,
+		"RECOMMENDATION_REASON_POPULAR":       2,
+	}
+)
+
+func (x RecommendationReason) Enum() *RecommendationReason {
+	p := new(RecommendationReason)
+	*p = x
+	return p
+}
+
+func (x RecommendationReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecommendationReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_quiz_proto_enumTypes[4].Descriptor()
+}
+
+func (RecommendationReason) Type() protoreflect.EnumType {
+	return &file_quiz_proto_enumTypes[4]
+}
+
+func (x RecommendationReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RecommendationReason.Descriptor instead.
+func (RecommendationReason) EnumDescriptor() ([]byte, []int) {
+	return file_quiz_proto_rawDescGZIP(), []int{4}
+}
+
+type StatsInterval int32
+
+const (
+	StatsInterval_STATS_INTERVAL_UNSPECIFIED StatsInterval = 0
+	StatsInterval_STATS_INTERVAL_DAY         StatsInterval = 1
+	StatsInterval_STATS_INTERVAL_WEEK        StatsInterval = 2
+	StatsInterval_STATS_INTERVAL_MONTH       StatsInterval = 3
+)
+
+// Enum value maps for StatsInterval.
+var (
+	StatsInterval_name = map[int32]string{
+		0: "STATS_INTERVAL_UNSPECIFIED",
+		1: "STATS_INTERVAL_DAY",
+		2: "STATS_INTERVAL_WEEK",
+		3: "STATS_INTERVAL_MONTH",
+	}
+	StatsInterval_value = map[string]int32{
+		"STATS_INTERVAL_UNSPECIFIED": 0,
+		"STATS_INTERVAL_DAY":         1,
+		"STATS_INTERVAL_WEEK":        2,
+		"STATS_INTERVAL_MONTH":       3,
+	}
+)
+
+func (x StatsInterval) Enum() *StatsInterval {
+	p := new(StatsInterval)
+	*p = x
+	return p
+}
+
+func (x StatsInterval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatsInterval) Descriptor() protoreflect.EnumDescriptor {
+	return file_quiz_proto_enumTypes[5].Descriptor()
+}
+
+func (StatsInterval) Type() protoreflect.EnumType {
+	return &file_quiz_proto_enumTypes[5]
+}
+
+func (x StatsInterval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatsInterval.Descriptor instead.
+func (StatsInterval) EnumDescriptor() ([]byte, []int) {
+	return file_quiz_proto_rawDescGZIP(), []int{5}
+}
+
 type Quiz struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Id                       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title                    string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Results                  []string               `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	AuthorId                 string                 `protobuf:"bytes,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Status                   QuizStatus             `protobuf:"varint,5,opt,name=status,proto3,enum=quiz.v1.QuizStatus" json:"status,omitempty"`
+	TieBreakPolicy           TieBreakPolicy         `protobuf:"varint,6,opt,name=tie_break_policy,json=tieBreakPolicy,proto3,enum=quiz.v1.TieBreakPolicy" json:"tie_break_policy,omitempty"`
+	TieBreakSeed             int64                  `protobuf:"varint,7,opt,name=tie_break_seed,json=tieBreakSeed,proto3" json:"tie_break_seed,omitempty"`
+	TiebreakerQuestionId     string                 `protobuf:"bytes,8,opt,name=tiebreaker_question_id,json=tiebreakerQuestionId,proto3" json:"tiebreaker_question_id,omitempty"`
+	ScoringModel             ScoringModel           `protobuf:"varint,9,opt,name=scoring_model,json=scoringModel,proto3,enum=quiz.v1.ScoringModel" json:"scoring_model,omitempty"`
+	TraitAxes                []*TraitAxis           `protobuf:"bytes,10,rep,name=trait_axes,json=traitAxes,proto3" json:"trait_axes,omitempty"`
+	ScoreThresholds          []float32              `protobuf:"fixed32,11,rep,packed,name=score_thresholds,json=scoreThresholds,proto3" json:"score_thresholds,omitempty"`
+	TimeLimitSeconds         int32                  `protobuf:"varint,12,opt,name=time_limit_seconds,json=timeLimitSeconds,proto3" json:"time_limit_seconds,omitempty"`
+	QuestionTimeLimitSeconds int32                  `protobuf:"varint,13,opt,name=question_time_limit_seconds,json=questionTimeLimitSeconds,proto3" json:"question_time_limit_seconds,omitempty"`
+	EarlyTermination         bool                   `protobuf:"varint,14,opt,name=early_termination,json=earlyTermination,proto3" json:"early_termination,omitempty"`
+	QuestionDraw             *QuestionDraw          `protobuf:"bytes,15,opt,name=question_draw,json=questionDraw,proto3" json:"question_draw,omitempty"`
+	ResultDetails            []*ResultDetail        `protobuf:"bytes,16,rep,name=result_details,json=resultDetails,proto3" json:"result_details,omitempty"`
+	Description              string                 `protobuf:"bytes,17,opt,name=description,proto3" json:"description,omitempty"`
+	Tags                     []string               `protobuf:"bytes,18,rep,name=tags,proto3" json:"tags,omitempty"`
+	Language                 string                 `protobuf:"bytes,19,opt,name=language,proto3" json:"language,omitempty"`
+	CreatedAt                *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CompletionCount          int64                  `protobuf:"varint,21,opt,name=completion_count,json=completionCount,proto3" json:"completion_count,omitempty"`
+	CategoryIds              []string               `protobuf:"bytes,22,rep,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	DisplayLanguage          string                 `protobuf:"bytes,23,opt,name=display_language,json=displayLanguage,proto3" json:"display_language,omitempty"`
+	AvailableLanguages       []string               `protobuf:"bytes,24,rep,name=available_languages,json=availableLanguages,proto3" json:"available_languages,omitempty"`
+	RatingAverage            float32                `protobuf:"fixed32,25,opt,name=rating_average,json=ratingAverage,proto3" json:"rating_average,omitempty"`
+	RatingCount              int64                  `protobuf:"varint,26,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`
+	LikeCount                int64                  `protobuf:"varint,27,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *Quiz) Reset() {
+	*x = Quiz{}
+	mi := &file_quiz_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Quiz) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quiz) ProtoMessage() {}
+
+func (x *Quiz) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quiz.ProtoReflect.Descriptor instead.
+func (*Quiz) Descriptor() ([]byte, []int) {
+	return file_quiz_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Quiz) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Quiz) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Quiz) GetResults() []string {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *Quiz) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *Quiz) GetStatus() QuizStatus {
+	if x != nil {
+		return x.Status
+	}
+	return QuizStatus_QUIZ_STATUS_UNSPECIFIED
+}
+
+func (x *Quiz) GetTieBreakPolicy() TieBreakPolicy {
+	if x != nil {
+		return x.TieBreakPolicy
+	}
+	return TieBreakPolicy_TIE_BREAK_POLICY_UNSPECIFIED
+}
+
+func (x *Quiz) GetTieBreakSeed() int64 {
+	if x != nil {
+		return x.TieBreakSeed
+	}
+	return 0
+}
+
+func (x *Quiz) GetTiebreakerQuestionId() string {
+	if x != nil {
+		return x.TiebreakerQuestionId
+	}
+	return ""
+}
+
+func (x *Quiz) GetScoringModel() ScoringModel {
+	if x != nil {
+		return x.ScoringModel
+	}
+	return ScoringModel_SCORING_MODEL_UNSPECIFIED
+}
+
+func (x *Quiz) GetTraitAxes() []*TraitAxis {
+	if x != nil {
+		return x.TraitAxes
+	}
+	return nil
+}
+
+func (x *Quiz) GetScoreThresholds() []float32 {
+	if x != nil {
+		return x.ScoreThresholds
+	}
+	return nil
+}
+
+func (x *Quiz) GetTimeLimitSeconds() int32 {
+	if x != nil {
+		return x.TimeLimitSeconds
+	}
+	return 0
+}
+
+func (x *Quiz) GetQuestionTimeLimitSeconds() int32 {
+	if x != nil {
+		return x.QuestionTimeLimitSeconds
+	}
+	return 0
+}
+
+func (x *Quiz) GetEarlyTermination() bool {
+	if x != nil {
+		return x.EarlyTermination
+	}
+	return false
+}
+
+func (x *Quiz) GetQuestionDraw() *QuestionDraw {
+	if x != nil {
+		return x.QuestionDraw
+	}
+	return nil
+}
+
+func (x *Quiz) GetResultDetails() []*ResultDetail {
+	if x != nil {
+		return x.ResultDetails
+	}
+	return nil
+}
+
+func (x *Quiz) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Quiz) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Quiz) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *Quiz) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Quiz) GetCompletionCount() int64 {
+	if x != nil {
+		return x.CompletionCount
+	}
+	return 0
+}
+
+func (x *Quiz) GetCategoryIds() []string {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *Quiz) GetDisplayLanguage() string {
+	if x != nil {
+		return x.DisplayLanguage
+	}
+	return ""
+}
+
+func (x *Quiz) GetAvailableLanguages() []string {
+	if x != nil {
+		return x.AvailableLanguages
+	}
+	return nil
+}
+
+func (x *Quiz) GetRatingAverage() float32 {
+	if x != nil {
+		return x.RatingAverage
+	}
+	return 0
+}
+
+func (x *Quiz) GetRatingCount() int64 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+func (x *Quiz) GetLikeCount() int64 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+type ResultDetail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ImageId       string                 `protobuf:"bytes,3,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResultDetail) Reset() {
+	*x = ResultDetail{}
+	mi := &file_quiz_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResultDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultDetail) ProtoMessage() {}
+
+func (x *ResultDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResultDetail.ProtoReflect.Descriptor instead.
+func (*ResultDetail) Descriptor() ([]byte, []int) {
+	return file_quiz_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ResultDetail) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ResultDetail) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ResultDetail) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+type QuestionDraw struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	PerTag        map[string]int32       `protobuf:"bytes,2,rep,name=per_tag,json=perTag,proto3" json:"per_tag,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuestionDraw) Reset() {
+	*x = QuestionDraw{}
+	mi := &file_quiz_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuestionDraw) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionDraw) ProtoMessage() {}
+
+func (x *QuestionDraw) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionDraw.ProtoReflect.Descriptor instead.
+func (*QuestionDraw) Descriptor() ([]byte, []int) {
+	return file_quiz_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QuestionDraw) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *QuestionDraw) GetPerTag() map[string]int32 {
+	if x != nil {
+		return x.PerTag
+	}
+	return nil
+}
+
+type TraitAxis struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Positive      string                 `protobuf:"bytes,1,opt,name=positive,proto3" json:"positive,omitempty"`
+	Negative      string                 `protobuf:"bytes,2,opt,name=negative,proto3" json:"negative,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TraitAxis) Reset() {
+	*x = TraitAxis{}
+	mi := &file_quiz_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TraitAxis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraitAxis) ProtoMessage() {}
+
+func (x *TraitAxis) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraitAxis.ProtoReflect.Descriptor instead.
+func (*TraitAxis) Descriptor() ([]byte, []int) {
+	return file_quiz_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TraitAxis) GetPositive() string {
+	if x != nil {
+		return x.Positive
+	}
+	return ""
+}
+
+func (x *TraitAxis) GetNegative() string {
+	if x != nil {
+		return x.Negative
+	}
+	return ""
+}
+
+type CreateQuizRequest struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Title                    string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Results                  []string               `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	TieBreakPolicy           TieBreakPolicy         `protobuf:"varint,3,opt,name=tie_break_policy,json=tieBreakPolicy,proto3,enum=quiz.v1.TieBreakPolicy" json:"tie_break_policy,omitempty"`
+	TieBreakSeed             int64                  `protobuf:"varint,4,opt,name=tie_break_seed,json=tieBreakSeed,proto3" json:"tie_break_seed,omitempty"`
+	ScoringModel             ScoringModel           `protobuf:"varint,5,opt,name=scoring_model,json=scoringModel,proto3,enum=quiz.v1.ScoringModel" json:"scoring_model,omitempty"`
+	TraitAxes                []*TraitAxis           `protobuf:"bytes,6,rep,name=trait_axes,json=traitAxes,proto3" json:"trait_axes,omitempty"`
+	ScoreThresholds          []float32              `protobuf:"fixed32,7,rep,packed,name=score_thresholds,json=scoreThresholds,proto3" json:"score_thresholds,omitempty"`
+	TimeLimitSeconds         int32                  `protobuf:"varint,8,opt,name=time_limit_seconds,json=timeLimitSeconds,proto3" json:"time_limit_seconds,omitempty"`
+	QuestionTimeLimitSeconds int32                  `protobuf:"varint,9,opt,name=question_time_limit_seconds,json=questionTimeLimitSeconds,proto3" json:"question_time_limit_seconds,omitempty"`
+	EarlyTermination         bool                   `protobuf:"varint,10,opt,name=early_termination,json=earlyTermination,proto3" json:"early_termination,omitempty"`
+	QuestionDraw             *QuestionDraw          `protobuf:"bytes,11,opt,name=question_draw,json=questionDraw,proto3" json:"question_draw,omitempty"`
+	ResultDetails            []*ResultDetail        `protobuf:"bytes,12,rep,name=result_details,json=resultDetails,proto3" json:"result_details,omitempty"`
+	Description              string                 `protobuf:"bytes,13,opt,name=description,proto3" json:"description,omitempty"`
+	Tags                     []string               `protobuf:"bytes,14,rep,name=tags,proto3" json:"tags,omitempty"`
+	Language                 string                 `protobuf:"bytes,15,opt,name=language,proto3" json:"language,omitempty"`
+	CategoryIds              []string               `protobuf:"bytes,16,rep,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *CreateQuizRequest) Reset() {
+	*x = CreateQuizRequest{}
+	mi := &file_quiz_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateQuizRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQuizRequest) ProtoMessage() {}
+
+func (x *CreateQuizRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQuizRequest.ProtoReflect.Descriptor instead.
+func (*CreateQuizRequest) Descriptor() ([]byte, []int) {
+	return file_quiz_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateQuizRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateQuizRequest) GetResults() []string {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *CreateQuizRequest) GetTieBreakPolicy() TieBreakPolicy {
+	if x != nil {
+		return x.TieBreakPolicy
+	}
+	return TieBreakPolicy_TIE_BREAK_POLICY_UNSPECIFIED
+}
+
+func (x *CreateQuizRequest) GetTieBreakSeed() int64 {
+	if x != nil {
+		return x.TieBreakSeed
+	}
+	return 0
+}
+
+func (x *CreateQuizRequest) GetScoringModel() ScoringModel {
+	if x != nil {
+		return x.ScoringModel
+	}
+	return ScoringModel_SCORING_MODEL_UNSPECIFIED
+}
+
+func (x *CreateQuizRequest) GetTraitAxes() []*TraitAxis {
+	if x != nil {
+		return x.TraitAxes
+	}
+	return nil
+}
+
+func (x *CreateQuizRequest) GetScoreThresholds() []float32 {
+	if x != nil {
+		return x.ScoreThresholds
+	}
+	return nil
+}
+
+func (x *CreateQuizRequest) GetTimeLimitSeconds() int32 {
+	if x != nil {
+		return x.TimeLimitSeconds
+	}
+	return 0
+}
+
+func (x *CreateQuizRequest) GetQuestionTimeLimitSeconds() int32 {
+	if x != nil {
+		return x.QuestionTimeLimitSeconds
+	}
+	return 0
+}
+
+func (x *CreateQuizRequest) GetEarlyTermination() bool {
+	if x != nil {
+		return x.EarlyTermination
+	}
+	return false
+}
+
+func (x *CreateQuizRequest) GetQuestionDraw() *QuestionDraw {
+	if x != nil {
+		return x.QuestionDraw
+	}
+	return nil
+}
+
+func (x *CreateQuizRequest) GetResultDetails() []*ResultDetail {
+	if x != nil {
+		return x.ResultDetails
+	}
+	return nil
+}
+
+func (x *CreateQuizRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateQuizRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateQuizRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *CreateQuizRequest) GetCategoryIds() []string {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+type GetQuizRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuizRequest) Reset() {
+	*x = GetQuizRequest{}
+	mi := &file_quiz_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuizRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuizRequest) ProtoMessage() {}
+
+func (x *GetQuizRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuizRequest.ProtoReflect.Descriptor instead.
+func (*GetQuizRequest) Descriptor() ([]byte, []int) {
+	return file_quiz_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetQuizRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type BatchGetQuizzesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Search        string                 `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+	AuthorId      string                 `protobuf:"bytes,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Tag           string                 `protobuf:"bytes,5,opt,name=tag,proto3" json:"tag,omitempty"`
+	Language      string                 `protobuf:"bytes,6,opt,name=language,proto3" json:"language,omitempty"`
+	Status        QuizStatus             `protobuf:"varint,7,opt,name=status,proto3,enum=quiz.v1.QuizStatus" json:"status,omitempty"`
+	SortOrder     QuizSortOrder          `protobuf:"varint,8,opt,name=sort_order,json=sortOrder,proto3,enum=quiz.v1.QuizSortOrder" json:"sort_order,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,9,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetQuizzesRequest) Reset() {
+	*x = BatchGetQuizzesRequest{}
+	mi := &file_quiz_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetQuizzesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetQuizzesRequest) ProtoMessage() {}
+
+func (x *BatchGetQuizzesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetQuizzesRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetQuizzesRequest) Descriptor() ([]byte, []int) {
+	return file_quiz_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BatchGetQuizzesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *BatchGetQuizzesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *BatchGetQuizzesRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *BatchGetQuizzesRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *BatchGetQuizzesRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *BatchGetQuizzesRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *BatchGetQuizzesRequest) GetStatus() QuizStatus {
+	if x != nil {
+		return x.Status
+	}
+	return QuizStatus_QUIZ_STATUS_UNSPECIFIED
+}
+
+func (x *BatchGetQuizzesRequest) GetSortOrder() QuizSortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return QuizSortOrder_QUIZ_SORT_ORDER_UNSPECIFIED
+}
+
+func (x *BatchGetQuizzesRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+type BatchGetQuizzesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quizzes       []*Quiz                `protobuf:"bytes,1,rep,name=quizzes,proto3" json:"quizzes,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TagFacets     []*TagFacet            `protobuf:"bytes,3,rep,name=tag_facets,json=tagFacets,proto3" json:"tag_facets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetQuizzesResponse) Reset() {
+	*x = BatchGetQuizzesResponse{}
+	mi := &file_quiz_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetQuizzesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetQuizzesResponse) ProtoMessage() {}
+
+func (x *BatchGetQuizzesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetQuizzesResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetQuizzesResponse) Descriptor() ([]byte, []int) {
+	return file_quiz_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BatchGetQuizzesResponse) GetQuizzes() []*Quiz {
+	if x != nil {
+		return x.Quizzes
+	}
+	return nil
+}
+
+func (x *BatchGetQuizzesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *BatchGetQuizzesResponse) GetTagFacets() []*TagFacet {
+	if x != nil {
+		return x.TagFacets
+	}
+	return nil
+}
+
+type TagFacet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagFacet) Reset() {
+	*x = TagFacet{}
+	mi := &file_quiz_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagFacet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagFacet) ProtoMessage() {}
+
+func (x *TagFacet) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagFacet.ProtoReflect.Descriptor instead.
+func (*TagFacet) Descriptor() ([]byte, []int) {
+	return file_quiz_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TagFacet) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TagFacet) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type RecommendRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecommendRequest) Reset() {
+	*x = RecommendRequest{}
+	mi := &file_quiz_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendRequest) ProtoMessage() {}
+
+func (x *RecommendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendRequest.ProtoReflect.Descriptor instead.
+func (*RecommendRequest) Descriptor() ([]byte, []int) {
+	return file_quiz_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RecommendRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type RecommendResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quizzes       []*RecommendedQuiz     `protobuf:"bytes,1,rep,name=quizzes,proto3" json:"quizzes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecommendResponse) Reset() {
+	*x = RecommendResponse{}
+	mi := &file_quiz_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendResponse) ProtoMessage() {}
+
+func (x *RecommendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendResponse.ProtoReflect.Descriptor instead.
+func (*RecommendResponse) Descriptor() ([]byte, []int) {
+	return file_quiz_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RecommendResponse) GetQuizzes() []*RecommendedQuiz {
+	if x != nil {
+		return x.Quizzes
+	}
+	return nil
+}
+
+type RecommendedQuiz struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quiz          *Quiz                  `protobuf:"bytes,1,opt,name=quiz,proto3" json:"quiz,omitempty"`
+	Score         float32                `protobuf:"fixed32,2,opt,name=score,proto3" json:"score,omitempty"`
+	Reason        RecommendationReason   `protobuf:"varint,3,opt,name=reason,proto3,enum=quiz.v1.RecommendationReason" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecommendedQuiz) Reset() {
+	*x = RecommendedQuiz{}
+	mi := &file_quiz_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendedQuiz) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendedQuiz) ProtoMessage() {}
+
+func (x *RecommendedQuiz) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendedQuiz.ProtoReflect.Descriptor instead.
+func (*RecommendedQuiz) Descriptor() ([]byte, []int) {
+	return file_quiz_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RecommendedQuiz) GetQuiz() *Quiz {
+	if x != nil {
+		return x.Quiz
+	}
+	return nil
+}
+
+func (x *RecommendedQuiz) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *RecommendedQuiz) GetReason() RecommendationReason {
+	if x != nil {
+		return x.Reason
+	}
+	return RecommendationReason_RECOMMENDATION_REASON_UNSPECIFIED
+}
+
+type GetQuizStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Interval      StatsInterval          `protobuf:"varint,4,opt,name=interval,proto3,enum=quiz.v1.StatsInterval" json:"interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuizStatsRequest) Reset() {
+	*x = GetQuizStatsRequest{}
+	mi := &file_quiz_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuizStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuizStatsRequest) ProtoMessage() {}
+
+func (x *GetQuizStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuizStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetQuizStatsRequest) Descriptor() ([]byte, []int) {
+	return file_quiz_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetQuizStatsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetQuizStatsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetQuizStatsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetQuizStatsRequest) GetInterval() StatsInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return StatsInterval_STATS_INTERVAL_UNSPECIFIED
+}
+
+type QuizStats struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	QuizId           string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	TotalCompletions int64                  `protobuf:"varint,2,opt,name=total_completions,json=totalCompletions,proto3" json:"total_completions,omitempty"`
+	UniqueUsers      int64                  `protobuf:"varint,3,opt,name=unique_users,json=uniqueUsers,proto3" json:"unique_users,omitempty"`
+	Results          []*ResultShare         `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty"`
+	Buckets          []*CompletionBucket    `protobuf:"bytes,5,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	Interval         StatsInterval          `protobuf:"varint,6,opt,name=interval,proto3,enum=quiz.v1.StatsInterval" json:"interval,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *QuizStats) Reset() {
+	*x = QuizStats{}
+	mi := &file_quiz_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizStats) ProtoMessage() {}
+
+func (x *QuizStats) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizStats.ProtoReflect.Descriptor instead.
+func (*QuizStats) Descriptor() ([]byte, []int) {
+	return file_quiz_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *QuizStats) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *QuizStats) GetTotalCompletions() int64 {
+	if x != nil {
+		return x.TotalCompletions
+	}
+	return 0
+}
+
+func (x *QuizStats) GetUniqueUsers() int64 {
+	if x != nil {
+		return x.UniqueUsers
+	}
+	return 0
+}
+
+func (x *QuizStats) GetResults() []*ResultShare {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *QuizStats) GetBuckets() []*CompletionBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *QuizStats) GetInterval() StatsInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return StatsInterval_STATS_INTERVAL_UNSPECIFIED
+}
+
+type ResultShare struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Percentage    float32                `protobuf:"fixed32,3,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResultShare) Reset() {
+	*x = ResultShare{}
+	mi := &file_quiz_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResultShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultShare) ProtoMessage() {}
+
+func (x *ResultShare) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResultShare.ProtoReflect.Descriptor instead.
+func (*ResultShare) Descriptor() ([]byte, []int) {
+	return file_quiz_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ResultShare) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *ResultShare) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ResultShare) GetPercentage() float32 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+type CompletionBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	Completions   int64                  `protobuf:"varint,2,opt,name=completions,proto3" json:"completions,omitempty"`
+	UniqueUsers   int64                  `protobuf:"varint,3,opt,name=unique_users,json=uniqueUsers,proto3" json:"unique_users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompletionBucket) Reset() {
+	*x = CompletionBucket{}
+	mi := &file_quiz_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompletionBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompletionBucket) ProtoMessage() {}
+
+func (x *CompletionBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompletionBucket.ProtoReflect.Descriptor instead.
+func (*CompletionBucket) Descriptor() ([]byte, []int) {
+	return file_quiz_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CompletionBucket) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *CompletionBucket) GetCompletions() int64 {
+	if x != nil {
+		return x.Completions
+	}
+	return 0
+}
+
+func (x *CompletionBucket) GetUniqueUsers() int64 {
+	if x != nil {
+		return x.UniqueUsers
+	}
+	return 0
+}
+
+type UpdateQuizRequest struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Id                       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title                    string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Results                  []string               `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	TieBreakPolicy           TieBreakPolicy         `protobuf:"varint,4,opt,name=tie_break_policy,json=tieBreakPolicy,proto3,enum=quiz.v1.TieBreakPolicy" json:"tie_break_policy,omitempty"`
+	TieBreakSeed             int64                  `protobuf:"varint,5,opt,name=tie_break_seed,json=tieBreakSeed,proto3" json:"tie_break_seed,omitempty"`
+	TiebreakerQuestionId     string                 `protobuf:"bytes,6,opt,name=tiebreaker_question_id,json=tiebreakerQuestionId,proto3" json:"tiebreaker_question_id,omitempty"`
+	ScoringModel             ScoringModel           `protobuf:"varint,7,opt,name=scoring_model,json=scoringModel,proto3,enum=quiz.v1.ScoringModel" json:"scoring_model,omitempty"`
+	TraitAxes                []*TraitAxis           `protobuf:"bytes,8,rep,name=trait_axes,json=traitAxes,proto3" json:"trait_axes,omitempty"`
+	ScoreThresholds          []float32              `protobuf:"fixed32,9,rep,packed,name=score_thresholds,json=scoreThresholds,proto3" json:"score_thresholds,omitempty"`
+	TimeLimitSeconds         *int32                 `protobuf:"varint,10,opt,name=time_limit_seconds,json=timeLimitSeconds,proto3,oneof" json:"time_limit_seconds,omitempty"`
+	QuestionTimeLimitSeconds *int32                 `protobuf:"varint,11,opt,name=question_time_limit_seconds,json=questionTimeLimitSeconds,proto3,oneof" json:"question_time_limit_seconds,omitempty"`
+	EarlyTermination         *bool                  `protobuf:"varint,12,opt,name=early_termination,json=earlyTermination,proto3,oneof" json:"early_termination,omitempty"`
+	QuestionDraw             *QuestionDraw          `protobuf:"bytes,13,opt,name=question_draw,json=questionDraw,proto3" json:"question_draw,omitempty"`
+	ResultDetails            []*ResultDetail        `protobuf:"bytes,14,rep,name=result_details,json=resultDetails,proto3" json:"result_details,omitempty"`
+	Description              *string                `protobuf:"bytes,15,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Tags                     []string               `protobuf:"bytes,16,rep,name=tags,proto3" json:"tags,omitempty"`
+	Language                 *string                `protobuf:"bytes,17,opt,name=language,proto3,oneof" json:"language,omitempty"`
+	CategoryIds              []string               `protobuf:"bytes,18,rep,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *UpdateQuizRequest) Reset() {
+	*x = UpdateQuizRequest{}
+	mi := &file_quiz_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateQuizRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateQuizRequest) ProtoMessage() {}
+
+func (x *UpdateQuizRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateQuizRequest.ProtoReflect.Descriptor instead.
+func (*UpdateQuizRequest) Descriptor() ([]byte, []int) {
+	return file_quiz_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateQuizRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateQuizRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateQuizRequest) GetResults() []string {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *UpdateQuizRequest) GetTieBreakPolicy() TieBreakPolicy {
+	if x != nil {
+		return x.TieBreakPolicy
+	}
+	return TieBreakPolicy_TIE_BREAK_POLICY_UNSPECIFIED
+}
+
+func (x *UpdateQuizRequest) GetTieBreakSeed() int64 {
+	if x != nil {
+		return x.TieBreakSeed
+	}
+	return 0
+}
+
+func (x *UpdateQuizRequest) GetTiebreakerQuestionId() string {
+	if x != nil {
+		return x.TiebreakerQuestionId
+	}
+	return ""
+}
+
+func (x *UpdateQuizRequest) GetScoringModel() ScoringModel {
+	if x != nil {
+		return x.ScoringModel
+	}
+	return ScoringModel_SCORING_MODEL_UNSPECIFIED
+}
+
+func (x *UpdateQuizRequest) GetTraitAxes() []*TraitAxis {
+	if x != nil {
+		return x.TraitAxes
+	}
+	return nil
+}
+
+func (x *UpdateQuizRequest) GetScoreThresholds() []float32 {
+	if x != nil {
+		return x.ScoreThresholds
+	}
+	return nil
+}
+
+func (x *UpdateQuizRequest) GetTimeLimitSeconds() int32 {
+	if x != nil && x.TimeLimitSeconds != nil {
+		return *x.TimeLimitSeconds
+	}
+	return 0
+}
+
+func (x *UpdateQuizRequest) GetQuestionTimeLimitSeconds() int32 {
+	if x != nil && x.QuestionTimeLimitSeconds != nil {
+		return *x.QuestionTimeLimitSeconds
+	}
+	return 0
+}
+
+func (x *UpdateQuizRequest) GetEarlyTermination() bool {
+	if x != nil && x.EarlyTermination != nil {
+		return *x.EarlyTermination
+	}
+	return false
+}
+
+func (x *UpdateQuizRequest) GetQuestionDraw() *QuestionDraw {
+	if x != nil {
+		return x.QuestionDraw
+	}
+	return nil
+}
+
+func (x *UpdateQuizRequest) GetResultDetails() []*ResultDetail {
+	if x != nil {
+		return x.ResultDetails
+	}
+	return nil
+}
+
+func (x *UpdateQuizRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateQuizRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateQuizRequest) GetLanguage() string {
+	if x != nil && x.Language != nil {
+		return *x.Language
+	}
+	return ""
+}
+
+func (x *UpdateQuizRequest) GetCategoryIds() []string {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+type DeleteQuizRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Results       []string               `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Quiz) Reset() {
-	*x = Quiz{}
-	mi := &file_quiz_proto_msgTypes[0]
+func (x *DeleteQuizRequest) Reset() {
+	*x = DeleteQuizRequest{}
+	mi := &file_quiz_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Quiz) String() string {
+func (x *DeleteQuizRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Quiz) ProtoMessage() {}
+func (*DeleteQuizRequest) ProtoMessage() {}
 
-func (x *Quiz) ProtoReflect() protoreflect.Message {
-	mi := &file_quiz_proto_msgTypes[0]
+func (x *DeleteQuizRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -57,55 +1821,92 @@ func (x *Quiz) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Quiz.ProtoReflect.Descriptor instead.
-func (*Quiz) Descriptor() ([]byte, []int) {
-	return file_quiz_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use DeleteQuizRequest.ProtoReflect.Descriptor instead.
+func (*DeleteQuizRequest) Descriptor() ([]byte, []int) {
+	return file_quiz_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *Quiz) GetId() string {
+func (x *DeleteQuizRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Quiz) GetTitle() string {
+type DeleteQuizResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteQuizResponse) Reset() {
+	*x = DeleteQuizResponse{}
+	mi := &file_quiz_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteQuizResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQuizResponse) ProtoMessage() {}
+
+func (x *DeleteQuizResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_proto_msgTypes[18]
 	if x != nil {
-		return x.Title
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQuizResponse.ProtoReflect.Descriptor instead.
+func (*DeleteQuizResponse) Descriptor() ([]byte, []int) {
+	return file_quiz_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeleteQuizResponse) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *Quiz) GetResults() []string {
+func (x *DeleteQuizResponse) GetMessage() string {
 	if x != nil {
-		return x.Results
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-type CreateQuizRequest struct {
+type PublishQuizRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Results       []string               `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateQuizRequest) Reset() {
-	*x = CreateQuizRequest{}
-	mi := &file_quiz_proto_msgTypes[1]
+func (x *PublishQuizRequest) Reset() {
+	*x = PublishQuizRequest{}
+	mi := &file_quiz_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateQuizRequest) String() string {
+func (x *PublishQuizRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateQuizRequest) ProtoMessage() {}
+func (*PublishQuizRequest) ProtoMessage() {}
 
-func (x *CreateQuizRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_quiz_proto_msgTypes[1]
+func (x *PublishQuizRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -116,47 +1917,180 @@ func (x *CreateQuizRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateQuizRequest.ProtoReflect.Descriptor instead.
-func (*CreateQuizRequest) Descriptor() ([]byte, []int) {
-	return file_quiz_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use PublishQuizRequest.ProtoReflect.Descriptor instead.
+func (*PublishQuizRequest) Descriptor() ([]byte, []int) {
+	return file_quiz_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *CreateQuizRequest) GetTitle() string {
+func (x *PublishQuizRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ArchiveQuizRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveQuizRequest) Reset() {
+	*x = ArchiveQuizRequest{}
+	mi := &file_quiz_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveQuizRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveQuizRequest) ProtoMessage() {}
+
+func (x *ArchiveQuizRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveQuizRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveQuizRequest) Descriptor() ([]byte, []int) {
+	return file_quiz_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ArchiveQuizRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type QuizVersion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	QuizId        string                 `protobuf:"bytes,2,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	Version       int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Results       []string               `protobuf:"bytes,5,rep,name=results,proto3" json:"results,omitempty"`
+	Questions     []*QuizVersionQuestion `protobuf:"bytes,6,rep,name=questions,proto3" json:"questions,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizVersion) Reset() {
+	*x = QuizVersion{}
+	mi := &file_quiz_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizVersion) ProtoMessage() {}
+
+func (x *QuizVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizVersion.ProtoReflect.Descriptor instead.
+func (*QuizVersion) Descriptor() ([]byte, []int) {
+	return file_quiz_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *QuizVersion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *QuizVersion) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *QuizVersion) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *QuizVersion) GetTitle() string {
 	if x != nil {
 		return x.Title
 	}
 	return ""
 }
 
-func (x *CreateQuizRequest) GetResults() []string {
+func (x *QuizVersion) GetResults() []string {
 	if x != nil {
 		return x.Results
 	}
 	return nil
 }
 
-type GetQuizRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+func (x *QuizVersion) GetQuestions() []*QuizVersionQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *QuizVersion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type QuizVersionQuestion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Body  string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	// Deprecated: Marked as deprecated in quiz.proto.
+	Options       []string             `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+	Choices       []*QuizVersionOption `protobuf:"bytes,4,rep,name=choices,proto3" json:"choices,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetQuizRequest) Reset() {
-	*x = GetQuizRequest{}
-	mi := &file_quiz_proto_msgTypes[2]
+func (x *QuizVersionQuestion) Reset() {
+	*x = QuizVersionQuestion{}
+	mi := &file_quiz_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetQuizRequest) String() string {
+func (x *QuizVersionQuestion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetQuizRequest) ProtoMessage() {}
+func (*QuizVersionQuestion) ProtoMessage() {}
 
-func (x *GetQuizRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_quiz_proto_msgTypes[2]
+func (x *QuizVersionQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -167,41 +2101,63 @@ func (x *GetQuizRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetQuizRequest.ProtoReflect.Descriptor instead.
-func (*GetQuizRequest) Descriptor() ([]byte, []int) {
-	return file_quiz_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use QuizVersionQuestion.ProtoReflect.Descriptor instead.
+func (*QuizVersionQuestion) Descriptor() ([]byte, []int) {
+	return file_quiz_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *GetQuizRequest) GetId() string {
+func (x *QuizVersionQuestion) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type BatchGetQuizzesRequest struct {
+func (x *QuizVersionQuestion) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in quiz.proto.
+func (x *QuizVersionQuestion) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *QuizVersionQuestion) GetChoices() []*QuizVersionOption {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+type QuizVersionOption struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BatchGetQuizzesRequest) Reset() {
-	*x = BatchGetQuizzesRequest{}
-	mi := &file_quiz_proto_msgTypes[3]
+func (x *QuizVersionOption) Reset() {
+	*x = QuizVersionOption{}
+	mi := &file_quiz_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BatchGetQuizzesRequest) String() string {
+func (x *QuizVersionOption) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchGetQuizzesRequest) ProtoMessage() {}
+func (*QuizVersionOption) ProtoMessage() {}
 
-func (x *BatchGetQuizzesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_quiz_proto_msgTypes[3]
+func (x *QuizVersionOption) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -212,48 +2168,48 @@ func (x *BatchGetQuizzesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchGetQuizzesRequest.ProtoReflect.Descriptor instead.
-func (*BatchGetQuizzesRequest) Descriptor() ([]byte, []int) {
-	return file_quiz_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use QuizVersionOption.ProtoReflect.Descriptor instead.
+func (*QuizVersionOption) Descriptor() ([]byte, []int) {
+	return file_quiz_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *BatchGetQuizzesRequest) GetPageSize() int32 {
+func (x *QuizVersionOption) GetId() string {
 	if x != nil {
-		return x.PageSize
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *BatchGetQuizzesRequest) GetPageToken() string {
+func (x *QuizVersionOption) GetText() string {
 	if x != nil {
-		return x.PageToken
+		return x.Text
 	}
 	return ""
 }
 
-type BatchGetQuizzesResponse struct {
+type GetQuizVersionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Quizzes       []*Quiz                `protobuf:"bytes,1,rep,name=quizzes,proto3" json:"quizzes,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	QuizId        string                 `protobuf:"bytes,2,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BatchGetQuizzesResponse) Reset() {
-	*x = BatchGetQuizzesResponse{}
-	mi := &file_quiz_proto_msgTypes[4]
+func (x *GetQuizVersionRequest) Reset() {
+	*x = GetQuizVersionRequest{}
+	mi := &file_quiz_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BatchGetQuizzesResponse) String() string {
+func (x *GetQuizVersionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchGetQuizzesResponse) ProtoMessage() {}
+func (*GetQuizVersionRequest) ProtoMessage() {}
 
-func (x *BatchGetQuizzesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_quiz_proto_msgTypes[4]
+func (x *GetQuizVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -264,21 +2220,21 @@ func (x *BatchGetQuizzesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchGetQuizzesResponse.ProtoReflect.Descriptor instead.
-func (*BatchGetQuizzesResponse) Descriptor() ([]byte, []int) {
-	return file_quiz_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use GetQuizVersionRequest.ProtoReflect.Descriptor instead.
+func (*GetQuizVersionRequest) Descriptor() ([]byte, []int) {
+	return file_quiz_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *BatchGetQuizzesResponse) GetQuizzes() []*Quiz {
+func (x *GetQuizVersionRequest) GetId() string {
 	if x != nil {
-		return x.Quizzes
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *BatchGetQuizzesResponse) GetNextPageToken() string {
+func (x *GetQuizVersionRequest) GetQuizId() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.QuizId
 	}
 	return ""
 }
@@ -288,23 +2244,213 @@ var File_quiz_proto protoreflect.FileDescriptor
 const file_quiz_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"quiz.proto\x12\aquiz.v1\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"F\n" +
+	"quiz.proto\x12\aquiz.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xfd\b\n" +
 	"\x04Quiz\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
-	"\aresults\x18\x03 \x03(\tR\aresults\"C\n" +
+	"\aresults\x18\x03 \x03(\tR\aresults\x12\x1b\n" +
+	"\tauthor_id\x18\x04 \x01(\tR\bauthorId\x12+\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x13.quiz.v1.QuizStatusR\x06status\x12A\n" +
+	"\x10tie_break_policy\x18\x06 \x01(\x0e2\x17.quiz.v1.TieBreakPolicyR\x0etieBreakPolicy\x12$\n" +
+	"\x0etie_break_seed\x18\a \x01(\x03R\ftieBreakSeed\x124\n" +
+	"\x16tiebreaker_question_id\x18\b \x01(\tR\x14tiebreakerQuestionId\x12:\n" +
+	"\rscoring_model\x18\t \x01(\x0e2\x15.quiz.v1.ScoringModelR\fscoringModel\x121\n" +
+	"\n" +
+	"trait_axes\x18\n" +
+	" \x03(\v2\x12.quiz.v1.TraitAxisR\ttraitAxes\x12)\n" +
+	"\x10score_thresholds\x18\v \x03(\x02R\x0fscoreThresholds\x12,\n" +
+	"\x12time_limit_seconds\x18\f \x01(\x05R\x10timeLimitSeconds\x12=\n" +
+	"\x1bquestion_time_limit_seconds\x18\r \x01(\x05R\x18questionTimeLimitSeconds\x12+\n" +
+	"\x11early_termination\x18\x0e \x01(\bR\x10earlyTermination\x12:\n" +
+	"\rquestion_draw\x18\x0f \x01(\v2\x15.quiz.v1.QuestionDrawR\fquestionDraw\x12<\n" +
+	"\x0eresult_details\x18\x10 \x03(\v2\x15.quiz.v1.ResultDetailR\rresultDetails\x12 \n" +
+	"\vdescription\x18\x11 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04tags\x18\x12 \x03(\tR\x04tags\x12\x1a\n" +
+	"\blanguage\x18\x13 \x01(\tR\blanguage\x129\n" +
+	"\n" +
+	"created_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12)\n" +
+	"\x10completion_count\x18\x15 \x01(\x03R\x0fcompletionCount\x12!\n" +
+	"\fcategory_ids\x18\x16 \x03(\tR\vcategoryIds\x12)\n" +
+	"\x10display_language\x18\x17 \x01(\tR\x0fdisplayLanguage\x12/\n" +
+	"\x13available_languages\x18\x18 \x03(\tR\x12availableLanguages\x12%\n" +
+	"\x0erating_average\x18\x19 \x01(\x02R\rratingAverage\x12!\n" +
+	"\frating_count\x18\x1a \x01(\x03R\vratingCount\x12\x1d\n" +
+	"\n" +
+	"like_count\x18\x1b \x01(\x03R\tlikeCount\"a\n" +
+	"\fResultDetail\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x19\n" +
+	"\bimage_id\x18\x03 \x01(\tR\aimageId\"\x9b\x01\n" +
+	"\fQuestionDraw\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\x12:\n" +
+	"\aper_tag\x18\x02 \x03(\v2!.quiz.v1.QuestionDraw.PerTagEntryR\x06perTag\x1a9\n" +
+	"\vPerTagEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"C\n" +
+	"\tTraitAxis\x12\x1a\n" +
+	"\bpositive\x18\x01 \x01(\tR\bpositive\x12\x1a\n" +
+	"\bnegative\x18\x02 \x01(\tR\bnegative\"\xcf\x05\n" +
 	"\x11CreateQuizRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
-	"\aresults\x18\x02 \x03(\tR\aresults\" \n" +
+	"\aresults\x18\x02 \x03(\tR\aresults\x12A\n" +
+	"\x10tie_break_policy\x18\x03 \x01(\x0e2\x17.quiz.v1.TieBreakPolicyR\x0etieBreakPolicy\x12$\n" +
+	"\x0etie_break_seed\x18\x04 \x01(\x03R\ftieBreakSeed\x12:\n" +
+	"\rscoring_model\x18\x05 \x01(\x0e2\x15.quiz.v1.ScoringModelR\fscoringModel\x121\n" +
+	"\n" +
+	"trait_axes\x18\x06 \x03(\v2\x12.quiz.v1.TraitAxisR\ttraitAxes\x12)\n" +
+	"\x10score_thresholds\x18\a \x03(\x02R\x0fscoreThresholds\x12,\n" +
+	"\x12time_limit_seconds\x18\b \x01(\x05R\x10timeLimitSeconds\x12=\n" +
+	"\x1bquestion_time_limit_seconds\x18\t \x01(\x05R\x18questionTimeLimitSeconds\x12+\n" +
+	"\x11early_termination\x18\n" +
+	" \x01(\bR\x10earlyTermination\x12:\n" +
+	"\rquestion_draw\x18\v \x01(\v2\x15.quiz.v1.QuestionDrawR\fquestionDraw\x12<\n" +
+	"\x0eresult_details\x18\f \x03(\v2\x15.quiz.v1.ResultDetailR\rresultDetails\x12 \n" +
+	"\vdescription\x18\r \x01(\tR\vdescription\x12\x12\n" +
+	"\x04tags\x18\x0e \x03(\tR\x04tags\x12\x1a\n" +
+	"\blanguage\x18\x0f \x01(\tR\blanguage\x12!\n" +
+	"\fcategory_ids\x18\x10 \x03(\tR\vcategoryIds\" \n" +
 	"\x0eGetQuizRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"T\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xbc\x02\n" +
 	"\x16BatchGetQuizzesRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x02 \x01(\tR\tpageToken\"j\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06search\x18\x03 \x01(\tR\x06search\x12\x1b\n" +
+	"\tauthor_id\x18\x04 \x01(\tR\bauthorId\x12\x10\n" +
+	"\x03tag\x18\x05 \x01(\tR\x03tag\x12\x1a\n" +
+	"\blanguage\x18\x06 \x01(\tR\blanguage\x12+\n" +
+	"\x06status\x18\a \x01(\x0e2\x13.quiz.v1.QuizStatusR\x06status\x125\n" +
+	"\n" +
+	"sort_order\x18\b \x01(\x0e2\x16.quiz.v1.QuizSortOrderR\tsortOrder\x12\x1f\n" +
+	"\vcategory_id\x18\t \x01(\tR\n" +
+	"categoryId\"\x9c\x01\n" +
 	"\x17BatchGetQuizzesResponse\x12'\n" +
 	"\aquizzes\x18\x01 \x03(\v2\r.quiz.v1.QuizR\aquizzes\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken2\xe2\x02\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x120\n" +
+	"\n" +
+	"tag_facets\x18\x03 \x03(\v2\x11.quiz.v1.TagFacetR\ttagFacets\"2\n" +
+	"\bTagFacet\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"/\n" +
+	"\x10RecommendRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\"G\n" +
+	"\x11RecommendResponse\x122\n" +
+	"\aquizzes\x18\x01 \x03(\v2\x18.quiz.v1.RecommendedQuizR\aquizzes\"\x81\x01\n" +
+	"\x0fRecommendedQuiz\x12!\n" +
+	"\x04quiz\x18\x01 \x01(\v2\r.quiz.v1.QuizR\x04quiz\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x02R\x05score\x125\n" +
+	"\x06reason\x18\x03 \x01(\x0e2\x1d.quiz.v1.RecommendationReasonR\x06reason\"\xb5\x01\n" +
+	"\x13GetQuizStatsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x122\n" +
+	"\binterval\x18\x04 \x01(\x0e2\x16.quiz.v1.StatsIntervalR\binterval\"\x8d\x02\n" +
+	"\tQuizStats\x12\x17\n" +
+	"\aquiz_id\x18\x01 \x01(\tR\x06quizId\x12+\n" +
+	"\x11total_completions\x18\x02 \x01(\x03R\x10totalCompletions\x12!\n" +
+	"\funique_users\x18\x03 \x01(\x03R\vuniqueUsers\x12.\n" +
+	"\aresults\x18\x04 \x03(\v2\x14.quiz.v1.ResultShareR\aresults\x123\n" +
+	"\abuckets\x18\x05 \x03(\v2\x19.quiz.v1.CompletionBucketR\abuckets\x122\n" +
+	"\binterval\x18\x06 \x01(\x0e2\x16.quiz.v1.StatsIntervalR\binterval\"[\n" +
+	"\vResultShare\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\tR\x06result\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x12\x1e\n" +
+	"\n" +
+	"percentage\x18\x03 \x01(\x02R\n" +
+	"percentage\"\x89\x01\n" +
+	"\x10CompletionBucket\x120\n" +
+	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12 \n" +
+	"\vcompletions\x18\x02 \x01(\x03R\vcompletions\x12!\n" +
+	"\funique_users\x18\x03 \x01(\x03R\vuniqueUsers\"\x98\a\n" +
+	"\x11UpdateQuizRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\aresults\x18\x03 \x03(\tR\aresults\x12A\n" +
+	"\x10tie_break_policy\x18\x04 \x01(\x0e2\x17.quiz.v1.TieBreakPolicyR\x0etieBreakPolicy\x12$\n" +
+	"\x0etie_break_seed\x18\x05 \x01(\x03R\ftieBreakSeed\x124\n" +
+	"\x16tiebreaker_question_id\x18\x06 \x01(\tR\x14tiebreakerQuestionId\x12:\n" +
+	"\rscoring_model\x18\a \x01(\x0e2\x15.quiz.v1.ScoringModelR\fscoringModel\x121\n" +
+	"\n" +
+	"trait_axes\x18\b \x03(\v2\x12.quiz.v1.TraitAxisR\ttraitAxes\x12)\n" +
+	"\x10score_thresholds\x18\t \x03(\x02R\x0fscoreThresholds\x121\n" +
+	"\x12time_limit_seconds\x18\n" +
+	" \x01(\x05H\x00R\x10timeLimitSeconds\x88\x01\x01\x12B\n" +
+	"\x1bquestion_time_limit_seconds\x18\v \x01(\x05H\x01R\x18questionTimeLimitSeconds\x88\x01\x01\x120\n" +
+	"\x11early_termination\x18\f \x01(\bH\x02R\x10earlyTermination\x88\x01\x01\x12:\n" +
+	"\rquestion_draw\x18\r \x01(\v2\x15.quiz.v1.QuestionDrawR\fquestionDraw\x12<\n" +
+	"\x0eresult_details\x18\x0e \x03(\v2\x15.quiz.v1.ResultDetailR\rresultDetails\x12%\n" +
+	"\vdescription\x18\x0f \x01(\tH\x03R\vdescription\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\x10 \x03(\tR\x04tags\x12\x1f\n" +
+	"\blanguage\x18\x11 \x01(\tH\x04R\blanguage\x88\x01\x01\x12!\n" +
+	"\fcategory_ids\x18\x12 \x03(\tR\vcategoryIdsB\x15\n" +
+	"\x13_time_limit_secondsB\x1e\n" +
+	"\x1c_question_time_limit_secondsB\x14\n" +
+	"\x12_early_terminationB\x0e\n" +
+	"\f_descriptionB\v\n" +
+	"\t_language\"#\n" +
+	"\x11DeleteQuizRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\">\n" +
+	"\x12DeleteQuizResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"$\n" +
+	"\x12PublishQuizRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"$\n" +
+	"\x12ArchiveQuizRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xf7\x01\n" +
+	"\vQuizVersion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\aquiz_id\x18\x02 \x01(\tR\x06quizId\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversion\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x18\n" +
+	"\aresults\x18\x05 \x03(\tR\aresults\x12:\n" +
+	"\tquestions\x18\x06 \x03(\v2\x1c.quiz.v1.QuizVersionQuestionR\tquestions\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x8d\x01\n" +
+	"\x13QuizVersionQuestion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\x12\x1c\n" +
+	"\aoptions\x18\x03 \x03(\tB\x02\x18\x01R\aoptions\x124\n" +
+	"\achoices\x18\x04 \x03(\v2\x1a.quiz.v1.QuizVersionOptionR\achoices\"7\n" +
+	"\x11QuizVersionOption\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"@\n" +
+	"\x15GetQuizVersionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\aquiz_id\x18\x02 \x01(\tR\x06quizId*u\n" +
+	"\n" +
+	"QuizStatus\x12\x1b\n" +
+	"\x17QUIZ_STATUS_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11QUIZ_STATUS_DRAFT\x10\x01\x12\x19\n" +
+	"\x15QUIZ_STATUS_PUBLISHED\x10\x02\x12\x18\n" +
+	"\x14QUIZ_STATUS_ARCHIVED\x10\x03*\xbf\x01\n" +
+	"\x0eTieBreakPolicy\x12 \n" +
+	"\x1cTIE_BREAK_POLICY_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fTIE_BREAK_POLICY_FIRST_DECLARED\x10\x01\x12\x1b\n" +
+	"\x17TIE_BREAK_POLICY_RANDOM\x10\x02\x12\x1f\n" +
+	"\x1bTIE_BREAK_POLICY_REPORT_ALL\x10\x03\x12(\n" +
+	"$TIE_BREAK_POLICY_TIEBREAKER_QUESTION\x10\x04*\x9d\x01\n" +
+	"\fScoringModel\x12\x1d\n" +
+	"\x19SCORING_MODEL_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aSCORING_MODEL_WEIGHTED_SUM\x10\x01\x12\x1b\n" +
+	"\x17SCORING_MODEL_KNOWLEDGE\x10\x02\x12\x18\n" +
+	"\x14SCORING_MODEL_TRAITS\x10\x03\x12\x17\n" +
+	"\x13SCORING_MODEL_BANDS\x10\x04*\xca\x01\n" +
+	"\rQuizSortOrder\x12\x1f\n" +
+	"\x1bQUIZ_SORT_ORDER_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16QUIZ_SORT_ORDER_NEWEST\x10\x01\x12\"\n" +
+	"\x1eQUIZ_SORT_ORDER_MOST_COMPLETED\x10\x02\x12\x19\n" +
+	"\x15QUIZ_SORT_ORDER_TITLE\x10\x03\x12\x1d\n" +
+	"\x19QUIZ_SORT_ORDER_TOP_RATED\x10\x04\x12\x1e\n" +
+	"\x1aQUIZ_SORT_ORDER_MOST_LIKED\x10\x05*\x89\x01\n" +
+	"\x14RecommendationReason\x12%\n" +
+	"!RECOMMENDATION_REASON_UNSPECIFIED\x10\x00\x12'\n" +
+	"#RECOMMENDATION_REASON_SIMILAR_USERS\x10\x01\x12!\n" +
+	"\x1dRECOMMENDATION_REASON_POPULAR\x10\x02*z\n" +
+	"\rStatsInterval\x12\x1e\n" +
+	"\x1aSTATS_INTERVAL_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12STATS_INTERVAL_DAY\x10\x01\x12\x17\n" +
+	"\x13STATS_INTERVAL_WEEK\x10\x02\x12\x18\n" +
+	"\x14STATS_INTERVAL_MONTH\x10\x032\xf1\t\n" +
 	"\vQuizService\x12h\n" +
 	"\n" +
 	"CreateQuiz\x12\x1a.quiz.v1.CreateQuizRequest\x1a\r.quiz.v1.Quiz\"/\x92A\x12b\x10\n" +
@@ -314,11 +2460,41 @@ const file_quiz_proto_rawDesc = "" +
 	"\aGetQuiz\x12\x17.quiz.v1.GetQuizRequest\x1a\r.quiz.v1.Quiz\"1\x92A\x12b\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/quizzes/{id}\x12\x82\x01\n" +
-	"\x0fBatchGetQuizzes\x12\x1f.quiz.v1.BatchGetQuizzesRequest\x1a .quiz.v1.BatchGetQuizzesResponse\",\x92A\x12b\x10\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/quizzes/{id}\x12|\n" +
+	"\tRecommend\x12\x19.quiz.v1.RecommendRequest\x1a\x1a.quiz.v1.RecommendResponse\"8\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/quizzes/recommended\x12y\n" +
+	"\fGetQuizStats\x12\x1c.quiz.v1.GetQuizStatsRequest\x1a\x12.quiz.v1.QuizStats\"7\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/quizzes/{id}/stats\x12\xae\x01\n" +
+	"\x0fBatchGetQuizzes\x12\x1f.quiz.v1.BatchGetQuizzesRequest\x1a .quiz.v1.BatchGetQuizzesResponse\"X\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02=Z*\x12(/api/v1/categories/{category_id}/quizzes\x12\x0f/api/v1/quizzes\x12m\n" +
+	"\n" +
+	"UpdateQuiz\x12\x1a.quiz.v1.UpdateQuizRequest\x1a\r.quiz.v1.Quiz\"4\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x19:\x01*\x1a\x14/api/v1/quizzes/{id}\x12x\n" +
+	"\n" +
+	"DeleteQuiz\x12\x1a.quiz.v1.DeleteQuizRequest\x1a\x1b.quiz.v1.DeleteQuizResponse\"1\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x16*\x14/api/v1/quizzes/{id}\x12w\n" +
+	"\vPublishQuiz\x12\x1b.quiz.v1.PublishQuizRequest\x1a\r.quiz.v1.Quiz\"<\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/quizzes/{id}/publish\x12w\n" +
+	"\vArchiveQuiz\x12\x1b.quiz.v1.ArchiveQuizRequest\x1a\r.quiz.v1.Quiz\"<\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/quizzes/{id}/archive\x12\x8c\x01\n" +
+	"\x0eGetQuizVersion\x12\x1e.quiz.v1.GetQuizVersionRequest\x1a\x14.quiz.v1.QuizVersion\"D\x92A\x12b\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/v1/quizzesBKZIgithub.com/mibrgmv/whoami-server/gateway/internal/protogen/quiz/v1;quizv1b\x06proto3"
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02)\x12'/api/v1/quizzes/{quiz_id}/versions/{id}BKZIgithub.com/mibrgmv/whoami-server/gateway/internal/protogen/quiz/v1;quizv1b\x06proto3"
 
 var (
 	file_quiz_proto_rawDescOnce sync.Once
@@ -332,27 +2508,104 @@ func file_quiz_proto_rawDescGZIP() []byte {
 	return file_quiz_proto_rawDescData
 }
 
-var file_quiz_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_quiz_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_quiz_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_quiz_proto_goTypes = []any{
-	(*Quiz)(nil),                    // 0: quiz.v1.Quiz
-	(*CreateQuizRequest)(nil),       // 1: quiz.v1.CreateQuizRequest
-	(*GetQuizRequest)(nil),          // 2: quiz.v1.GetQuizRequest
-	(*BatchGetQuizzesRequest)(nil),  // 3: quiz.v1.BatchGetQuizzesRequest
-	(*BatchGetQuizzesResponse)(nil), // 4: quiz.v1.BatchGetQuizzesResponse
+	(QuizStatus)(0),                 // 0: quiz.v1.QuizStatus
+	(TieBreakPolicy)(0),             // 1: quiz.v1.TieBreakPolicy
+	(ScoringModel)(0),               // 2: quiz.v1.ScoringModel
+	(QuizSortOrder)(0),              // 3: quiz.v1.QuizSortOrder
+	(RecommendationReason)(0),       // 4: quiz.v1.RecommendationReason
+	(StatsInterval)(0),              // 5: quiz.v1.StatsInterval
+	(*Quiz)(nil),                    // 6: quiz.v1.Quiz
+	(*ResultDetail)(nil),            // 7: quiz.v1.ResultDetail
+	(*QuestionDraw)(nil),            // 8: quiz.v1.QuestionDraw
+	(*TraitAxis)(nil),               // 9: quiz.v1.TraitAxis
+	(*CreateQuizRequest)(nil),       // 10: quiz.v1.CreateQuizRequest
+	(*GetQuizRequest)(nil),          // 11: quiz.v1.GetQuizRequest
+	(*BatchGetQuizzesRequest)(nil),  // 12: quiz.v1.BatchGetQuizzesRequest
+	(*BatchGetQuizzesResponse)(nil), // 13: quiz.v1.BatchGetQuizzesResponse
+	(*TagFacet)(nil),                // 14: quiz.v1.TagFacet
+	(*RecommendRequest)(nil),        // 15: quiz.v1.RecommendRequest
+	(*RecommendResponse)(nil),       // 16: quiz.v1.RecommendResponse
+	(*RecommendedQuiz)(nil),         // 17: quiz.v1.RecommendedQuiz
+	(*GetQuizStatsRequest)(nil),     // 18: quiz.v1.GetQuizStatsRequest
+	(*QuizStats)(nil),               // 19: quiz.v1.QuizStats
+	(*ResultShare)(nil),             // 20: quiz.v1.ResultShare
+	(*CompletionBucket)(nil),        // 21: quiz.v1.CompletionBucket
+	(*UpdateQuizRequest)(nil),       // 22: quiz.v1.UpdateQuizRequest
+	(*DeleteQuizRequest)(nil),       // 23: quiz.v1.DeleteQuizRequest
+	(*DeleteQuizResponse)(nil),      // 24: quiz.v1.DeleteQuizResponse
+	(*PublishQuizRequest)(nil),      // 25: quiz.v1.PublishQuizRequest
+	(*ArchiveQuizRequest)(nil),      // 26: quiz.v1.ArchiveQuizRequest
+	(*QuizVersion)(nil),             // 27: quiz.v1.QuizVersion
+	(*QuizVersionQuestion)(nil),     // 28: quiz.v1.QuizVersionQuestion
+	(*QuizVersionOption)(nil),       // 29: quiz.v1.QuizVersionOption
+	(*GetQuizVersionRequest)(nil),   // 30: quiz.v1.GetQuizVersionRequest
+	nil,                             // 31: quiz.v1.QuestionDraw.PerTagEntry
+	(*timestamppb.Timestamp)(nil),   // 32: google.protobuf.Timestamp
 }
 var file_quiz_proto_depIdxs = []int32{
-	0, // 0: quiz.v1.BatchGetQuizzesResponse.quizzes:type_name -> quiz.v1.Quiz
-	1, // 1: quiz.v1.QuizService.CreateQuiz:input_type -> quiz.v1.CreateQuizRequest
-	2, // 2: quiz.v1.QuizService.GetQuiz:input_type -> quiz.v1.GetQuizRequest
-	3, // 3: quiz.v1.QuizService.BatchGetQuizzes:input_type -> quiz.v1.BatchGetQuizzesRequest
-	0, // 4: quiz.v1.QuizService.CreateQuiz:output_type -> quiz.v1.Quiz
-	0, // 5: quiz.v1.QuizService.GetQuiz:output_type -> quiz.v1.Quiz
-	4, // 6: quiz.v1.QuizService.BatchGetQuizzes:output_type -> quiz.v1.BatchGetQuizzesResponse
-	4, // [4:7] is the sub-list for method output_type
-	1, // [1:4] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	0,  // 0: quiz.v1.Quiz.status:type_name -> quiz.v1.QuizStatus
+	1,  // 1: quiz.v1.Quiz.tie_break_policy:type_name -> quiz.v1.TieBreakPolicy
+	2,  // 2: quiz.v1.Quiz.scoring_model:type_name -> quiz.v1.ScoringModel
+	9,  // 3: quiz.v1.Quiz.trait_axes:type_name -> quiz.v1.TraitAxis
+	8,  // 4: quiz.v1.Quiz.question_draw:type_name -> quiz.v1.QuestionDraw
+	7,  // 5: quiz.v1.Quiz.result_details:type_name -> quiz.v1.ResultDetail
+	32, // 6: quiz.v1.Quiz.created_at:type_name -> google.protobuf.Timestamp
+	31, // 7: quiz.v1.QuestionDraw.per_tag:type_name -> quiz.v1.QuestionDraw.PerTagEntry
+	1,  // 8: quiz.v1.CreateQuizRequest.tie_break_policy:type_name -> quiz.v1.TieBreakPolicy
+	2,  // 9: quiz.v1.CreateQuizRequest.scoring_model:type_name -> quiz.v1.ScoringModel
+	9,  // 10: quiz.v1.CreateQuizRequest.trait_axes:type_name -> quiz.v1.TraitAxis
+	8,  // 11: quiz.v1.CreateQuizRequest.question_draw:type_name -> quiz.v1.QuestionDraw
+	7,  // 12: quiz.v1.CreateQuizRequest.result_details:type_name -> quiz.v1.ResultDetail
+	0,  // 13: quiz.v1.BatchGetQuizzesRequest.status:type_name -> quiz.v1.QuizStatus
+	3,  // 14: quiz.v1.BatchGetQuizzesRequest.sort_order:type_name -> quiz.v1.QuizSortOrder
+	6,  // 15: quiz.v1.BatchGetQuizzesResponse.quizzes:type_name -> quiz.v1.Quiz
+	14, // 16: quiz.v1.BatchGetQuizzesResponse.tag_facets:type_name -> quiz.v1.TagFacet
+	17, // 17: quiz.v1.RecommendResponse.quizzes:type_name -> quiz.v1.RecommendedQuiz
+	6,  // 18: quiz.v1.RecommendedQuiz.quiz:type_name -> quiz.v1.Quiz
+	4,  // 19: quiz.v1.RecommendedQuiz.reason:type_name -> quiz.v1.RecommendationReason
+	32, // 20: quiz.v1.GetQuizStatsRequest.from:type_name -> google.protobuf.Timestamp
+	32, // 21: quiz.v1.GetQuizStatsRequest.to:type_name -> google.protobuf.Timestamp
+	5,  // 22: quiz.v1.GetQuizStatsRequest.interval:type_name -> quiz.v1.StatsInterval
+	20, // 23: quiz.v1.QuizStats.results:type_name -> quiz.v1.ResultShare
+	21, // 24: quiz.v1.QuizStats.buckets:type_name -> quiz.v1.CompletionBucket
+	5,  // 25: quiz.v1.QuizStats.interval:type_name -> quiz.v1.StatsInterval
+	32, // 26: quiz.v1.CompletionBucket.start:type_name -> google.protobuf.Timestamp
+	1,  // 27: quiz.v1.UpdateQuizRequest.tie_break_policy:type_name -> quiz.v1.TieBreakPolicy
+	2,  // 28: quiz.v1.UpdateQuizRequest.scoring_model:type_name -> quiz.v1.ScoringModel
+	9,  // 29: quiz.v1.UpdateQuizRequest.trait_axes:type_name -> quiz.v1.TraitAxis
+	8,  // 30: quiz.v1.UpdateQuizRequest.question_draw:type_name -> quiz.v1.QuestionDraw
+	7,  // 31: quiz.v1.UpdateQuizRequest.result_details:type_name -> quiz.v1.ResultDetail
+	28, // 32: quiz.v1.QuizVersion.questions:type_name -> quiz.v1.QuizVersionQuestion
+	32, // 33: quiz.v1.QuizVersion.created_at:type_name -> google.protobuf.Timestamp
+	29, // 34: quiz.v1.QuizVersionQuestion.choices:type_name -> quiz.v1.QuizVersionOption
+	10, // 35: quiz.v1.QuizService.CreateQuiz:input_type -> quiz.v1.CreateQuizRequest
+	11, // 36: quiz.v1.QuizService.GetQuiz:input_type -> quiz.v1.GetQuizRequest
+	15, // 37: quiz.v1.QuizService.Recommend:input_type -> quiz.v1.RecommendRequest
+	18, // 38: quiz.v1.QuizService.GetQuizStats:input_type -> quiz.v1.GetQuizStatsRequest
+	12, // 39: quiz.v1.QuizService.BatchGetQuizzes:input_type -> quiz.v1.BatchGetQuizzesRequest
+	22, // 40: quiz.v1.QuizService.UpdateQuiz:input_type -> quiz.v1.UpdateQuizRequest
+	23, // 41: quiz.v1.QuizService.DeleteQuiz:input_type -> quiz.v1.DeleteQuizRequest
+	25, // 42: quiz.v1.QuizService.PublishQuiz:input_type -> quiz.v1.PublishQuizRequest
+	26, // 43: quiz.v1.QuizService.ArchiveQuiz:input_type -> quiz.v1.ArchiveQuizRequest
+	30, // 44: quiz.v1.QuizService.GetQuizVersion:input_type -> quiz.v1.GetQuizVersionRequest
+	6,  // 45: quiz.v1.QuizService.CreateQuiz:output_type -> quiz.v1.Quiz
+	6,  // 46: quiz.v1.QuizService.GetQuiz:output_type -> quiz.v1.Quiz
+	16, // 47: quiz.v1.QuizService.Recommend:output_type -> quiz.v1.RecommendResponse
+	19, // 48: quiz.v1.QuizService.GetQuizStats:output_type -> quiz.v1.QuizStats
+	13, // 49: quiz.v1.QuizService.BatchGetQuizzes:output_type -> quiz.v1.BatchGetQuizzesResponse
+	6,  // 50: quiz.v1.QuizService.UpdateQuiz:output_type -> quiz.v1.Quiz
+	24, // 51: quiz.v1.QuizService.DeleteQuiz:output_type -> quiz.v1.DeleteQuizResponse
+	6,  // 52: quiz.v1.QuizService.PublishQuiz:output_type -> quiz.v1.Quiz
+	6,  // 53: quiz.v1.QuizService.ArchiveQuiz:output_type -> quiz.v1.Quiz
+	27, // 54: quiz.v1.QuizService.GetQuizVersion:output_type -> quiz.v1.QuizVersion
+	45, // [45:55] is the sub-list for method output_type
+	35, // [35:45] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_quiz_proto_init() }
@@ -360,18 +2613,20 @@ func file_quiz_proto_init() {
 	if File_quiz_proto != nil {
 		return
 	}
+	file_quiz_proto_msgTypes[16].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_quiz_proto_rawDesc), len(file_quiz_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   5,
+			NumEnums:      6,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_quiz_proto_goTypes,
 		DependencyIndexes: file_quiz_proto_depIdxs,
+		EnumInfos:         file_quiz_proto_enumTypes,
 		MessageInfos:      file_quiz_proto_msgTypes,
 	}.Build()
 	File_quiz_proto = out.File
@@ -21,7 +21,14 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	QuizService_CreateQuiz_FullMethodName      = "/quiz.v1.QuizService/CreateQuiz"
 	QuizService_GetQuiz_FullMethodName         = "/quiz.v1.QuizService/GetQuiz"
+	QuizService_Recommend_FullMethodName       = "/quiz.v1.QuizService/Recommend"
+	QuizService_GetQuizStats_FullMethodName    = "/quiz.v1.QuizService/GetQuizStats"
 	QuizService_BatchGetQuizzes_FullMethodName = "/quiz.v1.QuizService/BatchGetQuizzes"
+	QuizService_UpdateQuiz_FullMethodName      = "/quiz.v1.QuizService/UpdateQuiz"
+	QuizService_DeleteQuiz_FullMethodName      = "/quiz.v1.QuizService/DeleteQuiz"
+	QuizService_PublishQuiz_FullMethodName     = "/quiz.v1.QuizService/PublishQuiz"
+	QuizService_ArchiveQuiz_FullMethodName     = "/quiz.v1.QuizService/ArchiveQuiz"
+	QuizService_GetQuizVersion_FullMethodName  = "/quiz.v1.QuizService/GetQuizVersion"
 )
 
 // QuizServiceClient is the client API for QuizService service.
@@ -30,7 +37,14 @@ const (
 type QuizServiceClient interface {
 	CreateQuiz(ctx context.Context, in *CreateQuizRequest, opts ...grpc.CallOption) (*Quiz, error)
 	GetQuiz(ctx context.Context, in *GetQuizRequest, opts ...grpc.CallOption) (*Quiz, error)
+	Recommend(ctx context.Context, in *RecommendRequest, opts ...grpc.CallOption) (*RecommendResponse, error)
+	GetQuizStats(ctx context.Context, in *GetQuizStatsRequest, opts ...grpc.CallOption) (*QuizStats, error)
 	BatchGetQuizzes(ctx context.Context, in *BatchGetQuizzesRequest, opts ...grpc.CallOption) (*BatchGetQuizzesResponse, error)
+	UpdateQuiz(ctx context.Context, in *UpdateQuizRequest, opts ...grpc.CallOption) (*Quiz, error)
+	DeleteQuiz(ctx context.Context, in *DeleteQuizRequest, opts ...grpc.CallOption) (*DeleteQuizResponse, error)
+	PublishQuiz(ctx context.Context, in *PublishQuizRequest, opts ...grpc.CallOption) (*Quiz, error)
+	ArchiveQuiz(ctx context.Context, in *ArchiveQuizRequest, opts ...grpc.CallOption) (*Quiz, error)
+	GetQuizVersion(ctx context.Context, in *GetQuizVersionRequest, opts ...grpc.CallOption) (*QuizVersion, error)
 }
 
 type quizServiceClient struct {
@@ -61,6 +75,26 @@ func (c *quizServiceClient) GetQuiz(ctx context.Context, in *GetQuizRequest, opt
 	return out, nil
 }
 
+func (c *quizServiceClient) Recommend(ctx context.Context, in *RecommendRequest, opts ...grpc.CallOption) (*RecommendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecommendResponse)
+	err := c.cc.Invoke(ctx, QuizService_Recommend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) GetQuizStats(ctx context.Context, in *GetQuizStatsRequest, opts ...grpc.CallOption) (*QuizStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuizStats)
+	err := c.cc.Invoke(ctx, QuizService_GetQuizStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *quizServiceClient) BatchGetQuizzes(ctx context.Context, in *BatchGetQuizzesRequest, opts ...grpc.CallOption) (*BatchGetQuizzesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BatchGetQuizzesResponse)
@@ -71,13 +105,70 @@ func (c *quizServiceClient) BatchGetQuizzes(ctx context.Context, in *BatchGetQui
 	return out, nil
 }
 
+func (c *quizServiceClient) UpdateQuiz(ctx context.Context, in *UpdateQuizRequest, opts ...grpc.CallOption) (*Quiz, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Quiz)
+	err := c.cc.Invoke(ctx, QuizService_UpdateQuiz_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) DeleteQuiz(ctx context.Context, in *DeleteQuizRequest, opts ...grpc.CallOption) (*DeleteQuizResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteQuizResponse)
+	err := c.cc.Invoke(ctx, QuizService_DeleteQuiz_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) PublishQuiz(ctx context.Context, in *PublishQuizRequest, opts ...grpc.CallOption) (*Quiz, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Quiz)
+	err := c.cc.Invoke(ctx, QuizService_PublishQuiz_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) ArchiveQuiz(ctx context.Context, in *ArchiveQuizRequest, opts ...grpc.CallOption) (*Quiz, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Quiz)
+	err := c.cc.Invoke(ctx, QuizService_ArchiveQuiz_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) GetQuizVersion(ctx context.Context, in *GetQuizVersionRequest, opts ...grpc.CallOption) (*QuizVersion, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuizVersion)
+	err := c.cc.Invoke(ctx, QuizService_GetQuizVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QuizServiceServer is the server API for QuizService service.
 // All implementations must embed UnimplementedQuizServiceServer
 // for forward compatibility.
 type QuizServiceServer interface {
 	CreateQuiz(context.Context, *CreateQuizRequest) (*Quiz, error)
 	GetQuiz(context.Context, *GetQuizRequest) (*Quiz, error)
+	Recommend(context.Context, *RecommendRequest) (*RecommendResponse, error)
+	GetQuizStats(context.Context, *GetQuizStatsRequest) (*QuizStats, error)
 	BatchGetQuizzes(context.Context, *BatchGetQuizzesRequest) (*BatchGetQuizzesResponse, error)
+	UpdateQuiz(context.Context, *UpdateQuizRequest) (*Quiz, error)
+	DeleteQuiz(context.Context, *DeleteQuizRequest) (*DeleteQuizResponse, error)
+	PublishQuiz(context.Context, *PublishQuizRequest) (*Quiz, error)
+	ArchiveQuiz(context.Context, *ArchiveQuizRequest) (*Quiz, error)
+	GetQuizVersion(context.Context, *GetQuizVersionRequest) (*QuizVersion, error)
 	mustEmbedUnimplementedQuizServiceServer()
 }
 
@@ -94,9 +185,30 @@ func (UnimplementedQuizServiceServer) CreateQuiz(context.Context, *CreateQuizReq
 func (UnimplementedQuizServiceServer) GetQuiz(context.Context, *GetQuizRequest) (*Quiz, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetQuiz not implemented")
 }
+func (UnimplementedQuizServiceServer) Recommend(context.Context, *RecommendRequest) (*RecommendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Recommend not implemented")
+}
+func (UnimplementedQuizServiceServer) GetQuizStats(context.Context, *GetQuizStatsRequest) (*QuizStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuizStats not implemented")
+}
 func (UnimplementedQuizServiceServer) BatchGetQuizzes(context.Context, *BatchGetQuizzesRequest) (*BatchGetQuizzesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchGetQuizzes not implemented")
 }
+func (UnimplementedQuizServiceServer) UpdateQuiz(context.Context, *UpdateQuizRequest) (*Quiz, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateQuiz not implemented")
+}
+func (UnimplementedQuizServiceServer) DeleteQuiz(context.Context, *DeleteQuizRequest) (*DeleteQuizResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQuiz not implemented")
+}
+func (UnimplementedQuizServiceServer) PublishQuiz(context.Context, *PublishQuizRequest) (*Quiz, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishQuiz not implemented")
+}
+func (UnimplementedQuizServiceServer) ArchiveQuiz(context.Context, *ArchiveQuizRequest) (*Quiz, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveQuiz not implemented")
+}
+func (UnimplementedQuizServiceServer) GetQuizVersion(context.Context, *GetQuizVersionRequest) (*QuizVersion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuizVersion not implemented")
+}
 func (UnimplementedQuizServiceServer) mustEmbedUnimplementedQuizServiceServer() {}
 func (UnimplementedQuizServiceServer) testEmbeddedByValue()                     {}
 
@@ -154,6 +266,42 @@ func _QuizService_GetQuiz_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QuizService_Recommend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecommendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).Recommend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_Recommend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).Recommend(ctx, req.(*RecommendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_GetQuizStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuizStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).GetQuizStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_GetQuizStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).GetQuizStats(ctx, req.(*GetQuizStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QuizService_BatchGetQuizzes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BatchGetQuizzesRequest)
 	if err := dec(in); err != nil {
@@ -172,6 +320,96 @@ func _QuizService_BatchGetQuizzes_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QuizService_UpdateQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateQuizRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).UpdateQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_UpdateQuiz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).UpdateQuiz(ctx, req.(*UpdateQuizRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_DeleteQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteQuizRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).DeleteQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_DeleteQuiz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).DeleteQuiz(ctx, req.(*DeleteQuizRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_PublishQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishQuizRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).PublishQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_PublishQuiz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).PublishQuiz(ctx, req.(*PublishQuizRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_ArchiveQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveQuizRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).ArchiveQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_ArchiveQuiz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).ArchiveQuiz(ctx, req.(*ArchiveQuizRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_GetQuizVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuizVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).GetQuizVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_GetQuizVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).GetQuizVersion(ctx, req.(*GetQuizVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // QuizService_ServiceDesc is the grpc.ServiceDesc for QuizService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -187,10 +425,38 @@ var QuizService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetQuiz",
 			Handler:    _QuizService_GetQuiz_Handler,
 		},
+		{
+			MethodName: "Recommend",
+			Handler:    _QuizService_Recommend_Handler,
+		},
+		{
+			MethodName: "GetQuizStats",
+			Handler:    _QuizService_GetQuizStats_Handler,
+		},
 		{
 			MethodName: "BatchGetQuizzes",
 			Handler:    _QuizService_BatchGetQuizzes_Handler,
 		},
+		{
+			MethodName: "UpdateQuiz",
+			Handler:    _QuizService_UpdateQuiz_Handler,
+		},
+		{
+			MethodName: "DeleteQuiz",
+			Handler:    _QuizService_DeleteQuiz_Handler,
+		},
+		{
+			MethodName: "PublishQuiz",
+			Handler:    _QuizService_PublishQuiz_Handler,
+		},
+		{
+			MethodName: "ArchiveQuiz",
+			Handler:    _QuizService_ArchiveQuiz_Handler,
+		},
+		{
+			MethodName: "GetQuizVersion",
+			Handler:    _QuizService_GetQuizVersion_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "quiz.proto",
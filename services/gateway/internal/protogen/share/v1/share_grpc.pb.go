@@ -0,0 +1,160 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: share.proto
+
+package sharev1
+
+import (
+	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ShareService_GetSharedResult_FullMethodName      = "/share.v1.ShareService/GetSharedResult"
+	ShareService_GetSharedResultImage_FullMethodName = "/share.v1.ShareService/GetSharedResultImage"
+)
+
+// ShareServiceClient is the client API for ShareService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ShareServiceClient interface {
+	GetSharedResult(ctx context.Context, in *GetSharedResultRequest, opts ...grpc.CallOption) (*SharedResult, error)
+	GetSharedResultImage(ctx context.Context, in *GetSharedResultImageRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+}
+
+type shareServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewShareServiceClient(cc grpc.ClientConnInterface) ShareServiceClient {
+	return &shareServiceClient{cc}
+}
+
+func (c *shareServiceClient) GetSharedResult(ctx context.Context, in *GetSharedResultRequest, opts ...grpc.CallOption) (*SharedResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SharedResult)
+	err := c.cc.Invoke(ctx, ShareService_GetSharedResult_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shareServiceClient) GetSharedResultImage(ctx context.Context, in *GetSharedResultImageRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, ShareService_GetSharedResultImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ShareServiceServer is the server API for ShareService service.
+// All implementations must embed UnimplementedShareServiceServer
+// for forward compatibility.
+type ShareServiceServer interface {
+	GetSharedResult(context.Context, *GetSharedResultRequest) (*SharedResult, error)
+	GetSharedResultImage(context.Context, *GetSharedResultImageRequest) (*httpbody.HttpBody, error)
+	mustEmbedUnimplementedShareServiceServer()
+}
+
+// UnimplementedShareServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedShareServiceServer struct{}
+
+func (UnimplementedShareServiceServer) GetSharedResult(context.Context, *GetSharedResultRequest) (*SharedResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSharedResult not implemented")
+}
+func (UnimplementedShareServiceServer) GetSharedResultImage(context.Context, *GetSharedResultImageRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSharedResultImage not implemented")
+}
+func (UnimplementedShareServiceServer) mustEmbedUnimplementedShareServiceServer() {}
+func (UnimplementedShareServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeShareServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ShareServiceServer will
+// result in compilation errors.
+type UnsafeShareServiceServer interface {
+	mustEmbedUnimplementedShareServiceServer()
+}
+
+func RegisterShareServiceServer(s grpc.ServiceRegistrar, srv ShareServiceServer) {
+	// If the following call pancis, it indicates UnimplementedShareServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ShareService_ServiceDesc, srv)
+}
+
+func _ShareService_GetSharedResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShareServiceServer).GetSharedResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShareService_GetSharedResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShareServiceServer).GetSharedResult(ctx, req.(*GetSharedResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShareService_GetSharedResultImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedResultImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShareServiceServer).GetSharedResultImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShareService_GetSharedResultImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShareServiceServer).GetSharedResultImage(ctx, req.(*GetSharedResultImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ShareService_ServiceDesc is the grpc.ServiceDesc for ShareService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ShareService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "share.v1.ShareService",
+	HandlerType: (*ShareServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSharedResult",
+			Handler:    _ShareService_GetSharedResult_Handler,
+		},
+		{
+			MethodName: "GetSharedResultImage",
+			Handler:    _ShareService_GetSharedResultImage_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "share.proto",
+}
@@ -0,0 +1,582 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v5.29.3
+// source: translation.proto
+
+package translationv1
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type QuizTranslation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuizId        string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	Language      string                 `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Results       []string               `protobuf:"bytes,5,rep,name=results,proto3" json:"results,omitempty"`
+	Questions     []*QuestionTranslation `protobuf:"bytes,6,rep,name=questions,proto3" json:"questions,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizTranslation) Reset() {
+	*x = QuizTranslation{}
+	mi := &file_translation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizTranslation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizTranslation) ProtoMessage() {}
+
+func (x *QuizTranslation) ProtoReflect() protoreflect.Message {
+	mi := &file_translation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizTranslation.ProtoReflect.Descriptor instead.
+func (*QuizTranslation) Descriptor() ([]byte, []int) {
+	return file_translation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QuizTranslation) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *QuizTranslation) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *QuizTranslation) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *QuizTranslation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *QuizTranslation) GetResults() []string {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *QuizTranslation) GetQuestions() []*QuestionTranslation {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *QuizTranslation) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type QuestionTranslation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId    string                 `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	Options       map[string]string      `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuestionTranslation) Reset() {
+	*x = QuestionTranslation{}
+	mi := &file_translation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuestionTranslation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionTranslation) ProtoMessage() {}
+
+func (x *QuestionTranslation) ProtoReflect() protoreflect.Message {
+	mi := &file_translation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionTranslation.ProtoReflect.Descriptor instead.
+func (*QuestionTranslation) Descriptor() ([]byte, []int) {
+	return file_translation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QuestionTranslation) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *QuestionTranslation) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *QuestionTranslation) GetOptions() map[string]string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type PutQuizTranslationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuizId        string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	Language      string                 `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Results       []string               `protobuf:"bytes,5,rep,name=results,proto3" json:"results,omitempty"`
+	Questions     []*QuestionTranslation `protobuf:"bytes,6,rep,name=questions,proto3" json:"questions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutQuizTranslationRequest) Reset() {
+	*x = PutQuizTranslationRequest{}
+	mi := &file_translation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutQuizTranslationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutQuizTranslationRequest) ProtoMessage() {}
+
+func (x *PutQuizTranslationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_translation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutQuizTranslationRequest.ProtoReflect.Descriptor instead.
+func (*PutQuizTranslationRequest) Descriptor() ([]byte, []int) {
+	return file_translation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PutQuizTranslationRequest) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *PutQuizTranslationRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *PutQuizTranslationRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PutQuizTranslationRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PutQuizTranslationRequest) GetResults() []string {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *PutQuizTranslationRequest) GetQuestions() []*QuestionTranslation {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+type ListQuizTranslationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuizId        string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuizTranslationsRequest) Reset() {
+	*x = ListQuizTranslationsRequest{}
+	mi := &file_translation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuizTranslationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuizTranslationsRequest) ProtoMessage() {}
+
+func (x *ListQuizTranslationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_translation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuizTranslationsRequest.ProtoReflect.Descriptor instead.
+func (*ListQuizTranslationsRequest) Descriptor() ([]byte, []int) {
+	return file_translation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListQuizTranslationsRequest) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+type ListQuizTranslationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Translations  []*QuizTranslation     `protobuf:"bytes,1,rep,name=translations,proto3" json:"translations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuizTranslationsResponse) Reset() {
+	*x = ListQuizTranslationsResponse{}
+	mi := &file_translation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuizTranslationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuizTranslationsResponse) ProtoMessage() {}
+
+func (x *ListQuizTranslationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_translation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuizTranslationsResponse.ProtoReflect.Descriptor instead.
+func (*ListQuizTranslationsResponse) Descriptor() ([]byte, []int) {
+	return file_translation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListQuizTranslationsResponse) GetTranslations() []*QuizTranslation {
+	if x != nil {
+		return x.Translations
+	}
+	return nil
+}
+
+type DeleteQuizTranslationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuizId        string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	Language      string                 `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteQuizTranslationRequest) Reset() {
+	*x = DeleteQuizTranslationRequest{}
+	mi := &file_translation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteQuizTranslationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQuizTranslationRequest) ProtoMessage() {}
+
+func (x *DeleteQuizTranslationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_translation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQuizTranslationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteQuizTranslationRequest) Descriptor() ([]byte, []int) {
+	return file_translation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteQuizTranslationRequest) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *DeleteQuizTranslationRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type DeleteQuizTranslationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuizId        string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	Language      string                 `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteQuizTranslationResponse) Reset() {
+	*x = DeleteQuizTranslationResponse{}
+	mi := &file_translation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteQuizTranslationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQuizTranslationResponse) ProtoMessage() {}
+
+func (x *DeleteQuizTranslationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_translation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQuizTranslationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteQuizTranslationResponse) Descriptor() ([]byte, []int) {
+	return file_translation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteQuizTranslationResponse) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *DeleteQuizTranslationResponse) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *DeleteQuizTranslationResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_translation_proto protoreflect.FileDescriptor
+
+const file_translation_proto_rawDesc = "" +
+	"\n" +
+	"\x11translation.proto\x12\x0etranslation.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x96\x02\n" +
+	"\x0fQuizTranslation\x12\x17\n" +
+	"\aquiz_id\x18\x01 \x01(\tR\x06quizId\x12\x1a\n" +
+	"\blanguage\x18\x02 \x01(\tR\blanguage\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x18\n" +
+	"\aresults\x18\x05 \x03(\tR\aresults\x12A\n" +
+	"\tquestions\x18\x06 \x03(\v2#.translation.v1.QuestionTranslationR\tquestions\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xd2\x01\n" +
+	"\x13QuestionTranslation\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\tR\n" +
+	"questionId\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\x12J\n" +
+	"\aoptions\x18\x03 \x03(\v20.translation.v1.QuestionTranslation.OptionsEntryR\aoptions\x1a:\n" +
+	"\fOptionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe5\x01\n" +
+	"\x19PutQuizTranslationRequest\x12\x17\n" +
+	"\aquiz_id\x18\x01 \x01(\tR\x06quizId\x12\x1a\n" +
+	"\blanguage\x18\x02 \x01(\tR\blanguage\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x18\n" +
+	"\aresults\x18\x05 \x03(\tR\aresults\x12A\n" +
+	"\tquestions\x18\x06 \x03(\v2#.translation.v1.QuestionTranslationR\tquestions\"6\n" +
+	"\x1bListQuizTranslationsRequest\x12\x17\n" +
+	"\aquiz_id\x18\x01 \x01(\tR\x06quizId\"c\n" +
+	"\x1cListQuizTranslationsResponse\x12C\n" +
+	"\ftranslations\x18\x01 \x03(\v2\x1f.translation.v1.QuizTranslationR\ftranslations\"S\n" +
+	"\x1cDeleteQuizTranslationRequest\x12\x17\n" +
+	"\aquiz_id\x18\x01 \x01(\tR\x06quizId\x12\x1a\n" +
+	"\blanguage\x18\x02 \x01(\tR\blanguage\"n\n" +
+	"\x1dDeleteQuizTranslationResponse\x12\x17\n" +
+	"\aquiz_id\x18\x01 \x01(\tR\x06quizId\x12\x1a\n" +
+	"\blanguage\x18\x02 \x01(\tR\blanguage\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage2\xca\x04\n" +
+	"\x12TranslationService\x12\xb3\x01\n" +
+	"\x12PutQuizTranslation\x12).translation.v1.PutQuizTranslationRequest\x1a\x1f.translation.v1.QuizTranslation\"Q\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x026:\x01*\x1a1/api/v1/quizzes/{quiz_id}/translations/{language}\x12\xb6\x01\n" +
+	"\x14ListQuizTranslations\x12+.translation.v1.ListQuizTranslationsRequest\x1a,.translation.v1.ListQuizTranslationsResponse\"C\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02(\x12&/api/v1/quizzes/{quiz_id}/translations\x12\xc4\x01\n" +
+	"\x15DeleteQuizTranslation\x12,.translation.v1.DeleteQuizTranslationRequest\x1a-.translation.v1.DeleteQuizTranslationResponse\"N\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x023*1/api/v1/quizzes/{quiz_id}/translations/{language}BYZWgithub.com/mibrgmv/whoami-server/gateway/internal/protogen/translation/v1;translationv1b\x06proto3"
+
+var (
+	file_translation_proto_rawDescOnce sync.Once
+	file_translation_proto_rawDescData []byte
+)
+
+func file_translation_proto_rawDescGZIP() []byte {
+	file_translation_proto_rawDescOnce.Do(func() {
+		file_translation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_translation_proto_rawDesc), len(file_translation_proto_rawDesc)))
+	})
+	return file_translation_proto_rawDescData
+}
+
+var file_translation_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_translation_proto_goTypes = []any{
+	(*QuizTranslation)(nil),               // 0: translation.v1.QuizTranslation
+	(*QuestionTranslation)(nil),           // 1: translation.v1.QuestionTranslation
+	(*PutQuizTranslationRequest)(nil),     // 2: translation.v1.PutQuizTranslationRequest
+	(*ListQuizTranslationsRequest)(nil),   // 3: translation.v1.ListQuizTranslationsRequest
+	(*ListQuizTranslationsResponse)(nil),  // 4: translation.v1.ListQuizTranslationsResponse
+	(*DeleteQuizTranslationRequest)(nil),  // 5: translation.v1.DeleteQuizTranslationRequest
+	(*DeleteQuizTranslationResponse)(nil), // 6: translation.v1.DeleteQuizTranslationResponse
+	nil,                                   // 7: translation.v1.QuestionTranslation.OptionsEntry
+	(*timestamppb.Timestamp)(nil),         // 8: google.protobuf.Timestamp
+}
+var file_translation_proto_depIdxs = []int32{
+	1, // 0: translation.v1.QuizTranslation.questions:type_name -> translation.v1.QuestionTranslation
+	8, // 1: translation.v1.QuizTranslation.updated_at:type_name -> google.protobuf.Timestamp
+	7, // 2: translation.v1.QuestionTranslation.options:type_name -> translation.v1.QuestionTranslation.OptionsEntry
+	1, // 3: translation.v1.PutQuizTranslationRequest.questions:type_name -> translation.v1.QuestionTranslation
+	0, // 4: translation.v1.ListQuizTranslationsResponse.translations:type_name -> translation.v1.QuizTranslation
+	2, // 5: translation.v1.TranslationService.PutQuizTranslation:input_type -> translation.v1.PutQuizTranslationRequest
+	3, // 6: translation.v1.TranslationService.ListQuizTranslations:input_type -> translation.v1.ListQuizTranslationsRequest
+	5, // 7: translation.v1.TranslationService.DeleteQuizTranslation:input_type -> translation.v1.DeleteQuizTranslationRequest
+	0, // 8: translation.v1.TranslationService.PutQuizTranslation:output_type -> translation.v1.QuizTranslation
+	4, // 9: translation.v1.TranslationService.ListQuizTranslations:output_type -> translation.v1.ListQuizTranslationsResponse
+	6, // 10: translation.v1.TranslationService.DeleteQuizTranslation:output_type -> translation.v1.DeleteQuizTranslationResponse
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_translation_proto_init() }
+func file_translation_proto_init() {
+	if File_translation_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_translation_proto_rawDesc), len(file_translation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_translation_proto_goTypes,
+		DependencyIndexes: file_translation_proto_depIdxs,
+		MessageInfos:      file_translation_proto_msgTypes,
+	}.Build()
+	File_translation_proto = out.File
+	file_translation_proto_goTypes = nil
+	file_translation_proto_depIdxs = nil
+}
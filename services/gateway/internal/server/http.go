@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/gin-contrib/cors"
@@ -13,20 +15,52 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	appcfg "github.com/mibrgmv/whoami-server/gateway/internal/config"
 	"github.com/mibrgmv/whoami-server/gateway/internal/middleware"
+	attemptv1 "github.com/mibrgmv/whoami-server/gateway/internal/protogen/attempt/v1"
 	authv1 "github.com/mibrgmv/whoami-server/gateway/internal/protogen/auth/v1"
+	categoryv1 "github.com/mibrgmv/whoami-server/gateway/internal/protogen/category/v1"
+	feedbackv1 "github.com/mibrgmv/whoami-server/gateway/internal/protogen/feedback/v1"
 	historyv1 "github.com/mibrgmv/whoami-server/gateway/internal/protogen/history/v1"
+	mediav1 "github.com/mibrgmv/whoami-server/gateway/internal/protogen/media/v1"
 	questionv1 "github.com/mibrgmv/whoami-server/gateway/internal/protogen/question/v1"
 	quizv1 "github.com/mibrgmv/whoami-server/gateway/internal/protogen/quiz/v1"
+	sharev1 "github.com/mibrgmv/whoami-server/gateway/internal/protogen/share/v1"
+	transferv1 "github.com/mibrgmv/whoami-server/gateway/internal/protogen/transfer/v1"
+	translationv1 "github.com/mibrgmv/whoami-server/gateway/internal/protogen/translation/v1"
 	userv1 "github.com/mibrgmv/whoami-server/gateway/internal/protogen/user/v1"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
+	// error details have to be registered to be rendered in error responses
+	_ "google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 )
 
-func NewHttpServer(ctx context.Context, cfg appcfg.Config) (*http.Server, error) {
-	gwmux := runtime.NewServeMux(
+// moderatorRoles are the realm roles allowed to moderate user content.
+var moderatorRoles = []string{"quiz-moderator", "quiz-admin"}
+
+// guestQuizRoutes are the quiz routes open to guests: browsing quizzes and
+// taking them.
+var guestQuizRoutes = middleware.Routes{
+	"GET /api/v1/quizzes",
+	"GET /api/v1/quizzes/{id}",
+	"GET /api/v1/quizzes/{quiz_id}/questions",
+	"POST /api/v1/quizzes/{quiz_id}/evaluate",
+	"POST /api/v1/quizzes/{quiz_id}/attempts",
+	"GET /api/v1/quizzes/{quiz_id}/attempts/{id}",
+	"POST /api/v1/quizzes/{quiz_id}/attempts/{attempt_id}/answers",
+	"GET /api/v1/quizzes/{quiz_id}/attempts/{attempt_id}/next",
+	"POST /api/v1/quizzes/{quiz_id}/attempts/{id}/finish",
+}
+
+// reservedMetadataKeys are the metadata keys the gateway fills from the
+// verified token or guest session. Services trust them, so clients must not be
+// able to send them as Grpc-Metadata-* headers.
+var reservedMetadataKeys = []string{"user_id", "username", "email", "email_verified", "roles", "guest_id"}
+
+func newServeMux() *runtime.ServeMux {
+	return runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
 		runtime.WithMetadata(func(ctx context.Context, req *http.Request) metadata.MD {
 			md := metadata.New(map[string]string{
 				"authorization": req.Header.Get("Authorization"),
@@ -44,9 +78,35 @@ func NewHttpServer(ctx context.Context, cfg appcfg.Config) (*http.Server, error)
 				md.Set("email", email)
 			}
 
+			if guestId, ok := ctx.Value("guest_id").(string); ok && guestId != "" {
+				md.Set("guest_id", guestId)
+			}
+
+			if roles, ok := ctx.Value("roles").([]string); ok && len(roles) > 0 {
+				md.Set("roles", roles...)
+			}
+
+			if language := req.Header.Get("Accept-Language"); language != "" {
+				md.Set("accept-language", language)
+			}
+
 			return md
 		}),
 	)
+}
+
+// incomingHeaderMatcher forwards headers like runtime.DefaultHeaderMatcher
+// except for those that would set a reserved metadata key.
+func incomingHeaderMatcher(key string) (string, bool) {
+	name, ok := runtime.DefaultHeaderMatcher(key)
+	if !ok || slices.Contains(reservedMetadataKeys, strings.ToLower(name)) {
+		return "", false
+	}
+	return name, true
+}
+
+func NewHttpServer(ctx context.Context, cfg appcfg.Config) (*http.Server, error) {
+	gwmux := newServeMux()
 
 	dialOpts := []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
@@ -79,6 +139,69 @@ func NewHttpServer(ctx context.Context, cfg appcfg.Config) (*http.Server, error)
 		return nil, fmt.Errorf("failed to register question service: %w", err)
 	}
 
+	if err := attemptv1.RegisterAttemptServiceHandlerFromEndpoint(
+		ctx,
+		gwmux,
+		cfg.QuizService.GetAddr(),
+		dialOpts,
+	); err != nil {
+		return nil, fmt.Errorf("failed to register attempt service: %w", err)
+	}
+
+	if err := mediav1.RegisterMediaServiceHandlerFromEndpoint(
+		ctx,
+		gwmux,
+		cfg.QuizService.GetAddr(),
+		dialOpts,
+	); err != nil {
+		return nil, fmt.Errorf("failed to register media service: %w", err)
+	}
+
+	if err := transferv1.RegisterTransferServiceHandlerFromEndpoint(
+		ctx,
+		gwmux,
+		cfg.QuizService.GetAddr(),
+		dialOpts,
+	); err != nil {
+		return nil, fmt.Errorf("failed to register transfer service: %w", err)
+	}
+
+	if err := translationv1.RegisterTranslationServiceHandlerFromEndpoint(
+		ctx,
+		gwmux,
+		cfg.QuizService.GetAddr(),
+		dialOpts,
+	); err != nil {
+		return nil, fmt.Errorf("failed to register translation service: %w", err)
+	}
+
+	if err := categoryv1.RegisterCategoryServiceHandlerFromEndpoint(
+		ctx,
+		gwmux,
+		cfg.QuizService.GetAddr(),
+		dialOpts,
+	); err != nil {
+		return nil, fmt.Errorf("failed to register category service: %w", err)
+	}
+
+	if err := feedbackv1.RegisterFeedbackServiceHandlerFromEndpoint(
+		ctx,
+		gwmux,
+		cfg.QuizService.GetAddr(),
+		dialOpts,
+	); err != nil {
+		return nil, fmt.Errorf("failed to register feedback service: %w", err)
+	}
+
+	if err := sharev1.RegisterShareServiceHandlerFromEndpoint(
+		ctx,
+		gwmux,
+		cfg.QuizService.GetAddr(),
+		dialOpts,
+	); err != nil {
+		return nil, fmt.Errorf("failed to register share service: %w", err)
+	}
+
 	if err := userv1.RegisterUserServiceHandlerFromEndpoint(
 		ctx,
 		gwmux,
@@ -104,6 +227,14 @@ func NewHttpServer(ctx context.Context, cfg appcfg.Config) (*http.Server, error)
 		HTTPTimeout:     10 * time.Second,
 	})
 
+	guest, err := middleware.NewGuest(middleware.GuestConfig{
+		Secret: cfg.Guest.Secret,
+		TTL:    cfg.Guest.TTL,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to set up guest sessions: %w", err)
+	}
+
 	switch cfg.HTTP.Mode {
 	case "debug":
 		gin.SetMode(gin.DebugMode)
@@ -136,17 +267,40 @@ func NewHttpServer(ctx context.Context, cfg appcfg.Config) (*http.Server, error)
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler,
 		ginSwagger.URL("/api/v1/swagger.json")))
 
-	router.Any("/api/v1/auth/*path", gin.WrapH(gwmux))
+	router.Any("/api/v1/auth/*path", guest.Session, gin.WrapH(gwmux))
+	router.POST("/api/v1/guest/session", guest.Issue)
+	router.GET("/api/v1/media/*path", gin.WrapH(gwmux))
+	router.GET("/api/v1/shared/*path", gin.WrapH(gwmux))
+
+	// guests browse and take published quizzes, every other quiz route needs a
+	// signed in user
+	quizGroup := router.Group("/api/v1")
+	quizGroup.Use(
+		middleware.When(guestQuizRoutes, middleware.Optional(jwtMiddleware)),
+		middleware.Unless(guestQuizRoutes, jwtMiddleware),
+		guest.Session,
+	)
+	{
+		quizGroup.Any("/quizzes", gin.WrapH(gwmux))
+		quizGroup.Any("/quizzes/*path", gin.WrapH(gwmux))
+	}
 
 	gwmuxGroup := router.Group("/api/v1")
 	gwmuxGroup.Use(jwtMiddleware)
 	{
-		gwmuxGroup.Any("/quizzes", gin.WrapH(gwmux))
-		gwmuxGroup.Any("/quizzes/*path", gin.WrapH(gwmux))
-
 		gwmuxGroup.Any("/questions", gin.WrapH(gwmux))
 		gwmuxGroup.Any("/questions/*path", gin.WrapH(gwmux))
 
+		gwmuxGroup.POST("/media", gin.WrapH(gwmux))
+
+		gwmuxGroup.Any("/categories", gin.WrapH(gwmux))
+		gwmuxGroup.Any("/categories/*path", gin.WrapH(gwmux))
+
+		gwmuxGroup.Any("/tags", gin.WrapH(gwmux))
+		gwmuxGroup.Any("/tags/*path", gin.WrapH(gwmux))
+
+		gwmuxGroup.Any("/comments/*path", gin.WrapH(gwmux))
+
 		gwmuxGroup.Any("/users", gin.WrapH(gwmux))
 		gwmuxGroup.Any("/users/*path", gin.WrapH(gwmux))
 
@@ -154,6 +308,12 @@ func NewHttpServer(ctx context.Context, cfg appcfg.Config) (*http.Server, error)
 		gwmuxGroup.Any("/history/*path", gin.WrapH(gwmux))
 	}
 
+	moderationGroup := router.Group("/api/v1/moderation")
+	moderationGroup.Use(jwtMiddleware, middleware.RequireRole(moderatorRoles...))
+	{
+		moderationGroup.Any("/*path", gin.WrapH(gwmux))
+	}
+
 	router.NoRoute(func(c *gin.Context) {
 		c.JSON(http.StatusNotFound, gin.H{"error": "Not found"})
 	})
@@ -0,0 +1,163 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/mibrgmv/whoami-server/gateway/internal/middleware"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestServeMux_SpoofedMetadata(t *testing.T) {
+	gwmux := newServeMux()
+
+	var md metadata.MD
+	err := gwmux.HandlePath(http.MethodGet, "/api/v1/quizzes", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		ctx, err := runtime.AnnotateContext(r.Context(), gwmux, r, "/quiz.v1.QuizService/BatchGetQuizzes")
+		if err != nil {
+			t.Fatalf("failed to annotate context: %v", err)
+		}
+		md, _ = metadata.FromOutgoingContext(ctx)
+	})
+	if err != nil {
+		t.Fatalf("failed to register handler: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		userID []string
+	}{
+		{name: "Anonymous", ctx: context.Background()},
+		{name: "Signed in", ctx: context.WithValue(context.Background(), "user_id", "real-user"), userID: []string{"real-user"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/quizzes", nil).WithContext(tt.ctx)
+			req.Header.Set("Grpc-Metadata-User_id", "victim")
+			req.Header.Set("Grpc-Metadata-Username", "victim")
+			req.Header.Set("Grpc-Metadata-Email", "victim@example.com")
+			req.Header.Set("Grpc-Metadata-Email_verified", "true")
+			req.Header.Set("Grpc-Metadata-Roles", "quiz-admin")
+			req.Header.Set("Grpc-Metadata-Guest_id", "victim")
+			req.Header.Set("Grpc-Metadata-Request_id", "42")
+
+			md = nil
+			gwmux.ServeHTTP(httptest.NewRecorder(), req)
+
+			assert.Equal(t, tt.userID, md.Get("user_id"))
+			assert.Empty(t, md.Get("username"))
+			assert.Empty(t, md.Get("email"))
+			assert.Empty(t, md.Get("email_verified"))
+			assert.Empty(t, md.Get("roles"))
+			assert.Empty(t, md.Get("guest_id"))
+			assert.Equal(t, []string{"42"}, md.Get("request_id"))
+		})
+	}
+}
+
+func TestGuestQuizRoutes(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		open   bool
+	}{
+		{method: http.MethodGet, path: "/api/v1/quizzes", open: true},
+		{method: http.MethodGet, path: "/api/v1/quizzes/8f9a", open: true},
+		{method: http.MethodGet, path: "/api/v1/quizzes/8f9a/questions", open: true},
+		{method: http.MethodPost, path: "/api/v1/quizzes/8f9a/evaluate", open: true},
+		{method: http.MethodPost, path: "/api/v1/quizzes/8f9a/attempts", open: true},
+		{method: http.MethodGet, path: "/api/v1/quizzes/8f9a/attempts/77", open: true},
+		{method: http.MethodPost, path: "/api/v1/quizzes/8f9a/attempts/77/answers", open: true},
+		{method: http.MethodGet, path: "/api/v1/quizzes/8f9a/attempts/77/next", open: true},
+		{method: http.MethodPost, path: "/api/v1/quizzes/8f9a/attempts/77/finish", open: true},
+		{method: http.MethodPost, path: "/api/v1/quizzes"},
+		{method: http.MethodPut, path: "/api/v1/quizzes/8f9a"},
+		{method: http.MethodDelete, path: "/api/v1/quizzes/8f9a"},
+		{method: http.MethodPost, path: "/api/v1/quizzes/8f9a/publish"},
+		{method: http.MethodPost, path: "/api/v1/quizzes/8f9a/questions"},
+		{method: http.MethodPut, path: "/api/v1/quizzes/8f9a/questions/1"},
+		{method: http.MethodGet, path: "/api/v1/quizzes/8f9a/stats"},
+		{method: http.MethodGet, path: "/api/v1/quizzes/8f9a/versions/1"},
+		{method: http.MethodGet, path: "/api/v1/quizzes/8f9a/export"},
+		{method: http.MethodPost, path: "/api/v1/quizzes/import"},
+		{method: http.MethodPut, path: "/api/v1/quizzes/8f9a/like"},
+		{method: http.MethodPost, path: "/api/v1/quizzes/8f9a/comments"},
+		{method: http.MethodGet, path: "/api/v1/quizzes/8f9a/attempts"},
+		{method: http.MethodGet, path: "/api/v1/quizzes//questions"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.open, guestQuizRoutes.Match(tt.method, tt.path), "%s %s", tt.method, tt.path)
+	}
+}
+
+func TestServeMux_SpoofedGuestID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	guest, err := middleware.NewGuest(middleware.GuestConfig{Secret: "secret", TTL: time.Hour})
+	if err != nil {
+		t.Fatalf("failed to create guest sessions: %v", err)
+	}
+
+	gwmux := newServeMux()
+
+	var md metadata.MD
+	err = gwmux.HandlePath(http.MethodPost, "/api/v1/quizzes/{quiz_id}/attempts", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		ctx, err := runtime.AnnotateContext(r.Context(), gwmux, r, "/attempt.v1.AttemptService/StartAttempt")
+		if err != nil {
+			t.Fatalf("failed to annotate context: %v", err)
+		}
+		md, _ = metadata.FromOutgoingContext(ctx)
+	})
+	if err != nil {
+		t.Fatalf("failed to register handler: %v", err)
+	}
+
+	router := gin.New()
+	router.POST("/api/v1/guest/session", guest.Issue)
+	router.Any("/api/v1/quizzes/*path", guest.Session, gin.WrapH(gwmux))
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/v1/guest/session", nil))
+
+	var session struct {
+		Session string `json:"session"`
+		GuestID string `json:"guest_id"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &session); err != nil {
+		t.Fatalf("failed to decode guest session: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		session string
+		guestID []string
+	}{
+		{name: "Without session"},
+		{name: "With session", session: session.Session, guestID: []string{session.GuestID}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/quizzes/8f9a/attempts", nil)
+			req.Header.Set("Grpc-Metadata-Guest_id", "11111111-1111-1111-1111-111111111111")
+			if tt.session != "" {
+				req.Header.Set(middleware.GuestSessionHeader, tt.session)
+			}
+
+			md = nil
+			router.ServeHTTP(httptest.NewRecorder(), req)
+
+			assert.Equal(t, tt.guestID, md.Get("guest_id"))
+		})
+	}
+}
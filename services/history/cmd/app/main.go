@@ -9,7 +9,9 @@ import (
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	appcfg "github.com/mibrgmv/whoami-server/history/internal/config"
+	"github.com/mibrgmv/whoami-server/history/internal/repository/postgres"
 	"github.com/mibrgmv/whoami-server/history/internal/server"
+	"github.com/mibrgmv/whoami-server/history/internal/service"
 	"github.com/mibrgmv/whoami-server/shared/config"
 	"github.com/mibrgmv/whoami-server/shared/tools"
 )
@@ -44,7 +46,11 @@ func main() {
 		log.Fatalf("failed to migrate up: %v", err)
 	}
 
-	s := server.NewGrpcServer(pool)
+	recommendationRepo := postgres.NewRecommendationRepository(pool)
+	recommendationService := service.NewRecommendationService(recommendationRepo, *cfg.Recommendations)
+	go recommendationService.Run(ctx)
+
+	s := server.NewGrpcServer(pool, recommendationService)
 	go func() {
 		if err := s.Start(cfg.Grpc.GetAddr()); err != nil {
 			log.Fatalf("Failed to start gRPC server: %v", err)
@@ -2,6 +2,9 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/mibrgmv/whoami-server/history/internal/models"
@@ -15,13 +18,15 @@ import (
 )
 
 type historyServiceServer struct {
-	service service.HistoryService
+	service               service.HistoryService
+	recommendationService service.RecommendationService
 	historyv1.UnimplementedHistoryServiceServer
 }
 
-func NewHistoryServiceServer(service service.HistoryService) historyv1.HistoryServiceServer {
+func NewHistoryServiceServer(service service.HistoryService, recommendationService service.RecommendationService) historyv1.HistoryServiceServer {
 	return &historyServiceServer{
-		service: service,
+		service:               service,
+		recommendationService: recommendationService,
 	}
 }
 
@@ -31,6 +36,10 @@ func (s *historyServiceServer) CreateItem(ctx context.Context, req *historyv1.Cr
 		return nil, status.Errorf(codes.Internal, "failed to create history item: %v", err)
 	}
 
+	if req.IdempotencyKey != "" {
+		itemToCreate.IdempotencyKey = &req.IdempotencyKey
+	}
+
 	createdItems, err := s.service.CreateItem(ctx, itemToCreate)
 	if err != nil {
 		return nil, err
@@ -103,6 +112,148 @@ func (s *historyServiceServer) BatchGetItems(ctx context.Context, req *historyv1
 	}, nil
 }
 
+func (s *historyServiceServer) GetQuizStats(ctx context.Context, req *historyv1.GetQuizStatsRequest) (*historyv1.QuizStats, error) {
+	quizID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid quiz ID format: %v", err)
+	}
+
+	var from, to *time.Time
+	if req.From != nil {
+		t := req.From.AsTime()
+		from = &t
+	}
+	if req.To != nil {
+		t := req.To.AsTime()
+		to = &t
+	}
+
+	stats, err := s.service.GetQuizStats(ctx, quizID, from, to, models.StatsIntervalToModel(req.Interval))
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidTimeRange) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get quiz stats: %v", err)
+	}
+
+	return stats.ToProto(), nil
+}
+
+func (s *historyServiceServer) GetRecommendations(ctx context.Context, req *historyv1.GetRecommendationsRequest) (*historyv1.GetRecommendationsResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID format: %v", err)
+	}
+
+	recommendations, err := s.recommendationService.GetRecommendations(ctx, userID, req.Limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get recommendations: %v", err)
+	}
+
+	protoRecommendations := make([]*historyv1.Recommendation, len(recommendations))
+	for i, rec := range recommendations {
+		protoRecommendations[i] = rec.ToProto()
+	}
+
+	return &historyv1.GetRecommendationsResponse{
+		Recommendations: protoRecommendations,
+	}, nil
+}
+
+func (s *historyServiceServer) ShareItem(ctx context.Context, req *historyv1.ShareItemRequest) (*historyv1.SharedItem, error) {
+	userID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get user ID from context: %v", err)
+	}
+
+	itemID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid item ID format: %v", err)
+	}
+
+	displayName := strings.TrimSpace(req.DisplayName)
+	if displayName == "" {
+		displayName, _ = interceptor.GetUsernameFromContext(ctx)
+	}
+
+	shared, err := s.service.ShareItem(ctx, itemID, userID, displayName)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrItemNotFound):
+			return nil, status.Errorf(codes.NotFound, "%v", err)
+		case errors.Is(err, service.ErrInvalidDisplayName):
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to share history item: %v", err)
+	}
+
+	return shared.ToProto(), nil
+}
+
+func (s *historyServiceServer) UnshareItem(ctx context.Context, req *historyv1.UnshareItemRequest) (*historyv1.UnshareItemResponse, error) {
+	userID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get user ID from context: %v", err)
+	}
+
+	itemID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid item ID format: %v", err)
+	}
+
+	if err := s.service.UnshareItem(ctx, itemID, userID); err != nil {
+		if errors.Is(err, service.ErrItemNotFound) {
+			return nil, status.Errorf(codes.NotFound, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to unshare history item: %v", err)
+	}
+
+	return &historyv1.UnshareItemResponse{
+		Id:      req.Id,
+		Message: "History item unshared successfully",
+	}, nil
+}
+
+func (s *historyServiceServer) GetSharedItem(ctx context.Context, req *historyv1.GetSharedItemRequest) (*historyv1.SharedItem, error) {
+	if req.ShareToken == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "share token is required")
+	}
+
+	shared, err := s.service.GetSharedItem(ctx, req.ShareToken)
+	if err != nil {
+		if errors.Is(err, service.ErrSharedItemNotFound) {
+			return nil, status.Errorf(codes.NotFound, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get shared item: %v", err)
+	}
+
+	return shared.ToProto(), nil
+}
+
+func (s *historyServiceServer) MergeItems(ctx context.Context, req *historyv1.MergeItemsRequest) (*historyv1.MergeItemsResponse, error) {
+	fromUserID, err := uuid.Parse(req.FromUserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid source user ID format: %v", err)
+	}
+
+	toUserID, err := uuid.Parse(req.ToUserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid target user ID format: %v", err)
+	}
+
+	merged, err := s.service.MergeItems(ctx, fromUserID, toUserID)
+	if err != nil {
+		if errors.Is(err, service.ErrNotGuestSession) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to merge history: %v", err)
+	}
+
+	return &historyv1.MergeItemsResponse{
+		MergedCount: merged,
+	}, nil
+}
+
 func parseUUIDs(values []*wrapperspb.StringValue) ([]*uuid.UUID, error) {
 	var uuids []*uuid.UUID
 	for _, u := range values {
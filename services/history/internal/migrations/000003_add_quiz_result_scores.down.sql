@@ -0,0 +1,2 @@
+alter table quiz_completion_history
+    drop column if exists quiz_result_scores;
@@ -0,0 +1,2 @@
+alter table quiz_completion_history
+    add column quiz_result_scores jsonb;
@@ -0,0 +1,2 @@
+alter table quiz_completion_history
+    drop column if exists elapsed_time_ms;
@@ -0,0 +1,2 @@
+alter table quiz_completion_history
+    add column elapsed_time_ms bigint;
@@ -0,0 +1,4 @@
+drop index if exists quiz_completion_history_quiz_id_completed_at_idx;
+
+alter table quiz_completion_history
+    drop column completed_at;
@@ -0,0 +1,5 @@
+-- completions recorded before this migration get the time it ran
+alter table quiz_completion_history
+    add column completed_at timestamptz not null default now();
+
+create index quiz_completion_history_quiz_id_completed_at_idx on quiz_completion_history (quiz_id, completed_at);
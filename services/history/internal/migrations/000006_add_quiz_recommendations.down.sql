@@ -0,0 +1,3 @@
+drop index if exists quiz_completion_history_user_id_idx;
+
+drop table if exists quiz_recommendations;
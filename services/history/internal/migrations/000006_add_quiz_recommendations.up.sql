@@ -0,0 +1,14 @@
+create table quiz_recommendations
+(
+    user_id              uuid        not null,
+    quiz_id              uuid        not null,
+    recommendation_score real        not null,
+    recommendation_rank  int         not null,
+    computed_at          timestamptz not null default now(),
+
+    primary key (user_id, quiz_id)
+);
+
+create index quiz_recommendations_user_id_rank_idx on quiz_recommendations (user_id, recommendation_rank);
+
+create index quiz_completion_history_user_id_idx on quiz_completion_history (user_id, quiz_id);
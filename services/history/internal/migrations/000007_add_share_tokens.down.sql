@@ -0,0 +1,3 @@
+alter table quiz_completion_history
+    drop column if exists share_display_name,
+    drop column if exists share_token;
@@ -0,0 +1,3 @@
+alter table quiz_completion_history
+    add column share_token        text unique,
+    add column share_display_name text not null default '';
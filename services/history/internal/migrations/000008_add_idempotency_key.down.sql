@@ -0,0 +1,2 @@
+alter table quiz_completion_history
+    drop column if exists idempotency_key;
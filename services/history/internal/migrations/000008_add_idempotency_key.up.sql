@@ -0,0 +1,4 @@
+-- completions recorded before this migration have no key
+alter table quiz_completion_history
+    add column idempotency_key text,
+    add constraint quiz_completion_history_idempotency_key_key unique (idempotency_key);
@@ -0,0 +1,8 @@
+-- a guest completion delivered after its guest signed in is recorded for the
+-- user the guest was merged into
+create table guest_merges
+(
+    guest_id  uuid primary key,
+    user_id   uuid        not null,
+    merged_at timestamptz not null default now()
+);
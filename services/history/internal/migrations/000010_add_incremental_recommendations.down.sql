@@ -0,0 +1,6 @@
+drop table if exists recommendation_refreshes;
+
+drop index if exists quiz_completion_history_updated_at_idx;
+
+alter table quiz_completion_history
+    drop column if exists updated_at;
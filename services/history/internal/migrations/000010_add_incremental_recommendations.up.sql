@@ -0,0 +1,14 @@
+-- completions recorded before this migration get the time it ran
+alter table quiz_completion_history
+    add column updated_at timestamptz not null default now();
+
+create index quiz_completion_history_updated_at_idx on quiz_completion_history (updated_at);
+
+-- a single row with the time of the last refresh and of the last refresh of
+-- every user
+create table recommendation_refreshes
+(
+    singleton         boolean primary key default true check (singleton),
+    refreshed_at      timestamptz not null,
+    full_refreshed_at timestamptz not null
+);
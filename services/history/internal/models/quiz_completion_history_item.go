@@ -2,16 +2,31 @@ package models
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	historyv1 "github.com/mibrgmv/whoami-server/history/internal/protogen/history/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type QuizCompletionHistoryItem struct {
-	ID         uuid.UUID `json:"id"`
-	QuizID     uuid.UUID `json:"quiz_id"`
-	UserID     uuid.UUID `json:"user_id"`
-	QuizResult string    `json:"quiz_result"`
+	ID               uuid.UUID         `json:"id"`
+	QuizID           uuid.UUID         `json:"quiz_id"`
+	QuizVersionID    *uuid.UUID        `json:"quiz_version_id"`
+	UserID           uuid.UUID         `json:"user_id"`
+	QuizResult       string            `json:"quiz_result"`
+	QuizResultScores []QuizResultScore `json:"quiz_result_scores"`
+	ElapsedTime      *time.Duration    `json:"elapsed_time"`
+	CompletedAt      time.Time         `json:"completed_at"`
+	IdempotencyKey   *string           `json:"idempotency_key,omitempty"`
+}
+
+type QuizResultScore struct {
+	Result     string  `json:"result"`
+	Total      float32 `json:"total"`
+	Percentage float32 `json:"percentage"`
+	Rank       int32   `json:"rank"`
 }
 
 func ToModel(protoItem *historyv1.QuizCompletionHistoryItem) (*QuizCompletionHistoryItem, error) {
@@ -25,18 +40,77 @@ func ToModel(protoItem *historyv1.QuizCompletionHistoryItem) (*QuizCompletionHis
 		return nil, fmt.Errorf("failed to parse QuizID '%s': %w", protoItem.QuizId, err)
 	}
 
+	var quizVersionID *uuid.UUID
+	if protoItem.QuizVersionId != "" {
+		parsedID, err := uuid.Parse(protoItem.QuizVersionId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse QuizVersionID '%s': %w", protoItem.QuizVersionId, err)
+		}
+		quizVersionID = &parsedID
+	}
+
+	var quizResultScores []QuizResultScore
+	for _, score := range protoItem.QuizResultScores {
+		quizResultScores = append(quizResultScores, QuizResultScore{
+			Result:     score.Result,
+			Total:      score.Total,
+			Percentage: score.Percentage,
+			Rank:       score.Rank,
+		})
+	}
+
+	var elapsedTime *time.Duration
+	if protoItem.ElapsedTime != nil {
+		elapsed := protoItem.ElapsedTime.AsDuration()
+		elapsedTime = &elapsed
+	}
+
+	// completions delivered late keep the time they were made at
+	var completedAt time.Time
+	if protoItem.CompletedAt != nil {
+		completedAt = protoItem.CompletedAt.AsTime()
+	}
+
 	return &QuizCompletionHistoryItem{
-		UserID:     userID,
-		QuizID:     quizID,
-		QuizResult: protoItem.QuizResult,
+		UserID:           userID,
+		QuizID:           quizID,
+		QuizVersionID:    quizVersionID,
+		QuizResult:       protoItem.QuizResult,
+		QuizResultScores: quizResultScores,
+		ElapsedTime:      elapsedTime,
+		CompletedAt:      completedAt,
 	}, nil
 }
 
 func (item *QuizCompletionHistoryItem) ToProto() *historyv1.QuizCompletionHistoryItem {
-	return &historyv1.QuizCompletionHistoryItem{
-		Id:         item.ID.String(),
-		UserId:     item.UserID.String(),
-		QuizId:     item.QuizID.String(),
-		QuizResult: item.QuizResult,
+	var quizVersionID string
+	if item.QuizVersionID != nil {
+		quizVersionID = item.QuizVersionID.String()
+	}
+
+	quizResultScores := make([]*historyv1.QuizResultScore, len(item.QuizResultScores))
+	for i, score := range item.QuizResultScores {
+		quizResultScores[i] = &historyv1.QuizResultScore{
+			Result:     score.Result,
+			Total:      score.Total,
+			Percentage: score.Percentage,
+			Rank:       score.Rank,
+		}
+	}
+
+	protoItem := &historyv1.QuizCompletionHistoryItem{
+		Id:               item.ID.String(),
+		UserId:           item.UserID.String(),
+		QuizId:           item.QuizID.String(),
+		QuizResult:       item.QuizResult,
+		QuizVersionId:    quizVersionID,
+		QuizResultScores: quizResultScores,
+		CompletedAt:      timestamppb.New(item.CompletedAt),
+	}
+
+	if item.ElapsedTime != nil {
+		protoItem.ElapsedTime = durationpb.New(*item.ElapsedTime)
 	}
+
+	return protoItem
 }
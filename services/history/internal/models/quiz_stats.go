@@ -0,0 +1,95 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	historyv1 "github.com/mibrgmv/whoami-server/history/internal/protogen/history/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// StatsInterval is the length of the time buckets completions are counted in,
+// named as the date_trunc field it truncates to.
+type StatsInterval string
+
+const (
+	StatsIntervalDay   StatsInterval = "day"
+	StatsIntervalWeek  StatsInterval = "week"
+	StatsIntervalMonth StatsInterval = "month"
+)
+
+type QuizStats struct {
+	QuizID           uuid.UUID          `json:"quiz_id"`
+	TotalCompletions int64              `json:"total_completions"`
+	UniqueUsers      int64              `json:"unique_users"`
+	Results          []ResultShare      `json:"results"`
+	Buckets          []CompletionBucket `json:"buckets"`
+	Interval         StatsInterval      `json:"interval"`
+}
+
+// ResultShare is how many completions ended with the result.
+type ResultShare struct {
+	Result     string  `json:"result"`
+	Count      int64   `json:"count"`
+	Percentage float32 `json:"percentage"`
+}
+
+type CompletionBucket struct {
+	Start       time.Time `json:"start"`
+	Completions int64     `json:"completions"`
+	UniqueUsers int64     `json:"unique_users"`
+}
+
+// StatsIntervalToModel defaults to days for STATS_INTERVAL_UNSPECIFIED.
+func StatsIntervalToModel(i historyv1.StatsInterval) StatsInterval {
+	switch i {
+	case historyv1.StatsInterval_STATS_INTERVAL_WEEK:
+		return StatsIntervalWeek
+	case historyv1.StatsInterval_STATS_INTERVAL_MONTH:
+		return StatsIntervalMonth
+	default:
+		return StatsIntervalDay
+	}
+}
+
+func (i StatsInterval) ToProto() historyv1.StatsInterval {
+	switch i {
+	case StatsIntervalDay:
+		return historyv1.StatsInterval_STATS_INTERVAL_DAY
+	case StatsIntervalWeek:
+		return historyv1.StatsInterval_STATS_INTERVAL_WEEK
+	case StatsIntervalMonth:
+		return historyv1.StatsInterval_STATS_INTERVAL_MONTH
+	default:
+		return historyv1.StatsInterval_STATS_INTERVAL_UNSPECIFIED
+	}
+}
+
+func (s *QuizStats) ToProto() *historyv1.QuizStats {
+	results := make([]*historyv1.ResultShare, len(s.Results))
+	for i, result := range s.Results {
+		results[i] = &historyv1.ResultShare{
+			Result:     result.Result,
+			Count:      result.Count,
+			Percentage: result.Percentage,
+		}
+	}
+
+	buckets := make([]*historyv1.CompletionBucket, len(s.Buckets))
+	for i, bucket := range s.Buckets {
+		buckets[i] = &historyv1.CompletionBucket{
+			Start:       timestamppb.New(bucket.Start),
+			Completions: bucket.Completions,
+			UniqueUsers: bucket.UniqueUsers,
+		}
+	}
+
+	return &historyv1.QuizStats{
+		QuizId:           s.QuizID.String(),
+		TotalCompletions: s.TotalCompletions,
+		UniqueUsers:      s.UniqueUsers,
+		Results:          results,
+		Buckets:          buckets,
+		Interval:         s.Interval.ToProto(),
+	}
+}
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	historyv1 "github.com/mibrgmv/whoami-server/history/internal/protogen/history/v1"
+)
+
+type RecommendationReason string
+
+const (
+	RecommendationReasonSimilarUsers RecommendationReason = "similar_users"
+	RecommendationReasonPopular      RecommendationReason = "popular"
+)
+
+// Recommendation is a quiz the user has not taken yet. Score only orders
+// recommendations with the same reason.
+type Recommendation struct {
+	QuizID uuid.UUID            `json:"quiz_id"`
+	Score  float32              `json:"score"`
+	Reason RecommendationReason `json:"reason"`
+}
+
+func (r RecommendationReason) ToProto() historyv1.RecommendationReason {
+	switch r {
+	case RecommendationReasonSimilarUsers:
+		return historyv1.RecommendationReason_RECOMMENDATION_REASON_SIMILAR_USERS
+	case RecommendationReasonPopular:
+		return historyv1.RecommendationReason_RECOMMENDATION_REASON_POPULAR
+	default:
+		return historyv1.RecommendationReason_RECOMMENDATION_REASON_UNSPECIFIED
+	}
+}
+
+func (r *Recommendation) ToProto() *historyv1.Recommendation {
+	return &historyv1.Recommendation{
+		QuizId: r.QuizID.String(),
+		Score:  r.Score,
+		Reason: r.Reason.ToProto(),
+	}
+}
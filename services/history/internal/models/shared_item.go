@@ -0,0 +1,21 @@
+package models
+
+import (
+	historyv1 "github.com/mibrgmv/whoami-server/history/internal/protogen/history/v1"
+)
+
+// SharedItem is a history item its user has made public under a share token,
+// showing the display name the user chose when sharing it.
+type SharedItem struct {
+	Item        *QuizCompletionHistoryItem `json:"item"`
+	ShareToken  string                     `json:"share_token"`
+	DisplayName string                     `json:"display_name"`
+}
+
+func (s *SharedItem) ToProto() *historyv1.SharedItem {
+	return &historyv1.SharedItem{
+		Item:        s.Item.ToProto(),
+		ShareToken:  s.ShareToken,
+		DisplayName: s.DisplayName,
+	}
+}
@@ -3,10 +3,17 @@ package repository
 import (
 	"context"
 
+	"github.com/google/uuid"
+
 	"github.com/mibrgmv/whoami-server/history/internal/models"
 )
 
 type HistoryRepository interface {
 	Add(ctx context.Context, historyItems []*models.QuizCompletionHistoryItem) ([]*models.QuizCompletionHistoryItem, error)
 	Query(ctx context.Context, query Query) ([]*models.QuizCompletionHistoryItem, error)
+	Stats(ctx context.Context, query StatsQuery) (*models.QuizStats, error)
+	Share(ctx context.Context, itemID, userID uuid.UUID, token, displayName string) (*models.SharedItem, error)
+	Unshare(ctx context.Context, itemID, userID uuid.UUID) (bool, error)
+	GetShared(ctx context.Context, token string) (*models.SharedItem, error)
+	Merge(ctx context.Context, fromUserID, toUserID uuid.UUID) (int64, error)
 }
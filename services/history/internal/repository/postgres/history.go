@@ -2,12 +2,16 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/mibrgmv/whoami-server/history/internal/models"
 	"github.com/mibrgmv/whoami-server/history/internal/repository"
+	"github.com/mibrgmv/whoami-server/shared/guest"
 )
 
 type historyRepo struct {
@@ -37,13 +41,36 @@ func (r historyRepo) Add(ctx context.Context, historyItems []*models.QuizComplet
 
 	var createdItems []*models.QuizCompletionHistoryItem
 	for _, i := range historyItems {
+		if guest.IsID(i.UserID) {
+			if err = lockGuest(ctx, tx, i.UserID); err != nil {
+				return nil, err
+			}
+		}
+
+		// an item with a key that is already recorded is returned as it is
+		// instead of being added again, and an item a guest completed before
+		// being merged is added for the user the guest was merged into
 		query := `
-		insert into quiz_completion_history (quiz_completion_history_item_id, user_id, quiz_id, quiz_result)
-		values ($1, $2, $3, $4)
-		returning quiz_completion_history_item_id`
+		insert into quiz_completion_history (quiz_completion_history_item_id, user_id, quiz_id, quiz_result, quiz_version_id, quiz_result_scores,
+		                                     elapsed_time_ms, completed_at, idempotency_key)
+		values ($1, coalesce((select user_id from guest_merges where guest_id = $2 and merged_at >= coalesce($8, now())), $2), $3, $4, $5, $6, $7, coalesce($8, now()), $9)
+		on conflict (idempotency_key) do update set idempotency_key = excluded.idempotency_key
+		returning quiz_completion_history_item_id, user_id, completed_at`
+
+		var elapsedTimeMs *int64
+		if i.ElapsedTime != nil {
+			ms := i.ElapsedTime.Milliseconds()
+			elapsedTimeMs = &ms
+		}
+
+		var completedAt *time.Time
+		if !i.CompletedAt.IsZero() {
+			completedAt = &i.CompletedAt
+		}
 
 		var createdID string
-		err = tx.QueryRow(ctx, query, uuid.New(), i.UserID, i.QuizID, i.QuizResult).Scan(&createdID)
+		err = tx.QueryRow(ctx, query, uuid.New(), i.UserID, i.QuizID, i.QuizResult, i.QuizVersionID, i.QuizResultScores,
+			elapsedTimeMs, completedAt, i.IdempotencyKey).Scan(&createdID, &i.UserID, &i.CompletedAt)
 		if err != nil {
 			return nil, fmt.Errorf("failed to add user: %w", err)
 		}
@@ -63,7 +90,11 @@ func (r historyRepo) Query(ctx context.Context, query repository.Query) ([]*mode
 	select quiz_completion_history_item_id,
 		   user_id,
 		   quiz_id,
-		   quiz_result
+		   quiz_result,
+		   quiz_version_id,
+		   quiz_result_scores,
+		   elapsed_time_ms,
+		   completed_at
 	from quiz_completion_history
 	where (quiz_completion_history_item_id > $1)
 	  and ($2::uuid[] is null or cardinality($2) = 0 or user_id = any ($2))
@@ -103,10 +134,17 @@ func (r historyRepo) Query(ctx context.Context, query repository.Query) ([]*mode
 	var items []*models.QuizCompletionHistoryItem
 	for rows.Next() {
 		i := new(models.QuizCompletionHistoryItem)
-		if err := rows.Scan(&i.ID, &i.UserID, &i.QuizID, &i.QuizResult); err != nil {
+		var elapsedTimeMs *int64
+		if err := rows.Scan(&i.ID, &i.UserID, &i.QuizID, &i.QuizResult, &i.QuizVersionID, &i.QuizResultScores, &elapsedTimeMs,
+			&i.CompletedAt); err != nil {
 			return nil, fmt.Errorf("scan failed: %w", err)
 		}
 
+		if elapsedTimeMs != nil {
+			elapsed := time.Duration(*elapsedTimeMs) * time.Millisecond
+			i.ElapsedTime = &elapsed
+		}
+
 		items = append(items, i)
 	}
 
@@ -116,3 +154,213 @@ func (r historyRepo) Query(ctx context.Context, query repository.Query) ([]*mode
 
 	return items, nil
 }
+
+// statsFilterSQL selects the completions of the quiz within the time range of
+// the stats query, using the (quiz_id, completed_at) index.
+const statsFilterSQL = `
+	quiz_id = $1
+	  and ($2::timestamptz is null or completed_at >= $2)
+	  and ($3::timestamptz is null or completed_at < $3)
+	`
+
+func (r historyRepo) Stats(ctx context.Context, query repository.StatsQuery) (*models.QuizStats, error) {
+	stats := &models.QuizStats{QuizID: query.QuizID, Interval: query.Interval}
+	args := []any{query.QuizID, query.From, query.To}
+
+	totalsSQL := `
+	select count(*), count(distinct user_id)
+	from quiz_completion_history
+	where ` + statsFilterSQL
+
+	if err := r.pool.QueryRow(ctx, totalsSQL, args...).Scan(&stats.TotalCompletions, &stats.UniqueUsers); err != nil {
+		return nil, fmt.Errorf("failed to count completions: %w", err)
+	}
+
+	resultsSQL := `
+	select quiz_result, count(*)
+	from quiz_completion_history
+	where ` + statsFilterSQL + `
+	group by quiz_result
+	order by count(*) desc, quiz_result
+	`
+
+	rows, err := r.pool.Query(ctx, resultsSQL, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query failed: %w", err)
+	}
+
+	for rows.Next() {
+		var result models.ResultShare
+		if err := rows.Scan(&result.Result, &result.Count); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("scan failed: %w", err)
+		}
+		stats.Results = append(stats.Results, result)
+	}
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	bucketsSQL := `
+	select date_trunc($4::text, completed_at, 'UTC') as bucket, count(*), count(distinct user_id)
+	from quiz_completion_history
+	where ` + statsFilterSQL + `
+	group by bucket
+	order by bucket
+	`
+
+	rows, err = r.pool.Query(ctx, bucketsSQL, append(args, string(query.Interval))...)
+	if err != nil {
+		return nil, fmt.Errorf("query failed: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var bucket models.CompletionBucket
+		if err := rows.Scan(&bucket.Start, &bucket.Completions, &bucket.UniqueUsers); err != nil {
+			return nil, fmt.Errorf("scan failed: %w", err)
+		}
+		stats.Buckets = append(stats.Buckets, bucket)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return stats, nil
+}
+
+// sharedItemSQL selects the columns scanned by scanSharedItem.
+const sharedItemSQL = `
+	quiz_completion_history_item_id,
+	user_id,
+	quiz_id,
+	quiz_result,
+	quiz_version_id,
+	quiz_result_scores,
+	elapsed_time_ms,
+	completed_at,
+	share_token,
+	share_display_name
+	`
+
+func scanSharedItem(row pgx.Row) (*models.SharedItem, error) {
+	i := new(models.QuizCompletionHistoryItem)
+	shared := &models.SharedItem{Item: i}
+
+	var elapsedTimeMs *int64
+	err := row.Scan(&i.ID, &i.UserID, &i.QuizID, &i.QuizResult, &i.QuizVersionID, &i.QuizResultScores, &elapsedTimeMs,
+		&i.CompletedAt, &shared.ShareToken, &shared.DisplayName)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("scan failed: %w", err)
+	}
+
+	if elapsedTimeMs != nil {
+		elapsed := time.Duration(*elapsedTimeMs) * time.Millisecond
+		i.ElapsedTime = &elapsed
+	}
+
+	return shared, nil
+}
+
+// Share sets the display name of the item of the user and gives it a share
+// token unless it already has one. It returns nil if the user has no such
+// item.
+func (r historyRepo) Share(ctx context.Context, itemID, userID uuid.UUID, token, displayName string) (*models.SharedItem, error) {
+	sql := `
+	update quiz_completion_history
+	set share_token        = coalesce(share_token, $3),
+	    share_display_name = $4
+	where quiz_completion_history_item_id = $1
+	  and user_id = $2
+	returning ` + sharedItemSQL
+
+	return scanSharedItem(r.pool.QueryRow(ctx, sql, itemID, userID, token, displayName))
+}
+
+func (r historyRepo) Unshare(ctx context.Context, itemID, userID uuid.UUID) (bool, error) {
+	sql := `
+	update quiz_completion_history
+	set share_token        = null,
+	    share_display_name = ''
+	where quiz_completion_history_item_id = $1
+	  and user_id = $2
+	`
+
+	tag, err := r.pool.Exec(ctx, sql, itemID, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to unshare item: %w", err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// GetShared returns nil if no item is shared under the token.
+func (r historyRepo) GetShared(ctx context.Context, token string) (*models.SharedItem, error) {
+	sql := `
+	select ` + sharedItemSQL + `
+	from quiz_completion_history
+	where share_token = $1
+	`
+
+	return scanSharedItem(r.pool.QueryRow(ctx, sql, token))
+}
+
+// lockGuest serializes adding the items of a guest with merging the guest, so
+// that an item is never added for a guest that is being merged.
+func lockGuest(ctx context.Context, tx pgx.Tx, guestID uuid.UUID) error {
+	if _, err := tx.Exec(ctx, "select pg_advisory_xact_lock(hashtextextended($1::text, 0))", guestID); err != nil {
+		return fmt.Errorf("failed to lock guest: %w", err)
+	}
+	return nil
+}
+
+// Merge moves the items of a guest to a user and returns how many were moved.
+// The first user a guest is merged into also gets the items the guest
+// completed before the merge that are added afterwards.
+func (r historyRepo) Merge(ctx context.Context, fromUserID, toUserID uuid.UUID) (merged int64, err error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("begin transaction failed: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				fmt.Printf("transaction rollback failed: %v\n", rbErr)
+			}
+			return
+		}
+		if cErr := tx.Commit(ctx); cErr != nil {
+			err = fmt.Errorf("commit failed: %w", cErr)
+		}
+	}()
+
+	if err = lockGuest(ctx, tx, fromUserID); err != nil {
+		return 0, err
+	}
+
+	sql := `
+	with merge as (
+	    insert into guest_merges (guest_id, user_id)
+	    values ($1, $2)
+	    on conflict (guest_id) do update set guest_id = excluded.guest_id
+	    returning user_id
+	)
+	update quiz_completion_history
+	set user_id    = (select user_id from merge),
+	    updated_at = now()
+	where user_id = $1
+	`
+
+	tag, err := tx.Exec(ctx, sql, fromUserID, toUserID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to merge items: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,193 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/mibrgmv/whoami-server/history/internal/models"
+	"github.com/mibrgmv/whoami-server/history/internal/repository"
+)
+
+type recommendationRepo struct {
+	pool *pgxpool.Pool
+}
+
+func NewRecommendationRepository(pool *pgxpool.Pool) repository.RecommendationRepository {
+	return &recommendationRepo{pool: pool}
+}
+
+// Refresh recomputes the recommendations of the users whose history changed
+// since the last refresh, or of every user once in a while, and reports whether
+// it ran. Only one replica refreshes at a time, the others skip the refresh.
+// Two users are as similar as the number of quizzes they both completed plus
+// the number of quizzes in which they got the same result, and a quiz the user
+// has not taken scores the summed similarity of the users who took it.
+func (r recommendationRepo) Refresh(ctx context.Context, query repository.RefreshQuery) (refreshed bool, err error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("begin transaction failed: %w", err)
+	}
+	defer func() {
+		if err != nil || !refreshed {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				fmt.Printf("transaction rollback failed: %v\n", rbErr)
+			}
+			return
+		}
+		if cErr := tx.Commit(ctx); cErr != nil {
+			err = fmt.Errorf("commit failed: %w", cErr)
+		}
+	}()
+
+	var locked bool
+	err = tx.QueryRow(ctx, `select pg_try_advisory_xact_lock(hashtextextended('quiz_recommendations', 0))`).Scan(&locked)
+	if err != nil {
+		return false, fmt.Errorf("failed to lock recommendations: %w", err)
+	}
+	if !locked {
+		return false, nil
+	}
+
+	// since is nil for a refresh of every user
+	var since *time.Time
+	var fullRefreshedAt time.Time
+	err = tx.QueryRow(ctx, `select refreshed_at, full_refreshed_at from recommendation_refreshes`).Scan(&since, &fullRefreshedAt)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return false, fmt.Errorf("failed to get last refresh: %w", err)
+	}
+	if errors.Is(err, pgx.ErrNoRows) || fullRefreshedAt.Before(query.FullBefore) {
+		since = nil
+	}
+
+	sql := `
+	delete
+	from quiz_recommendations
+	where $1::timestamptz is null
+	   or user_id in (select user_id from quiz_completion_history where updated_at >= $1)
+	`
+
+	if _, err = tx.Exec(ctx, sql, since); err != nil {
+		return false, fmt.Errorf("failed to clear recommendations: %w", err)
+	}
+
+	sql = `
+	with targets as (select distinct user_id
+	                 from quiz_completion_history
+	                 where $1::timestamptz is null
+	                    or updated_at >= $1),
+	     taken as (select user_id, quiz_id, max(completed_at) as completed_at
+	               from quiz_completion_history
+	               group by user_id, quiz_id),
+	     results as (select distinct user_id, quiz_id, quiz_result
+	                 from quiz_completion_history),
+	     takers as (select user_id, quiz_id
+	                from (select user_id, quiz_id,
+	                             row_number() over (partition by quiz_id order by completed_at desc, user_id) as position
+	                      from taken) as recent
+	                where position <= $3),
+	     neighbours as (select user_id, neighbour_id, sum(weight) as similarity
+	                    from (select a.user_id, b.user_id as neighbour_id, 1 as weight
+	                          from taken a
+	                                   join targets using (user_id)
+	                                   join takers b on b.quiz_id = a.quiz_id and b.user_id <> a.user_id
+	                          union all
+	                          select a.user_id, b.user_id as neighbour_id, 1 as weight
+	                          from results a
+	                                   join targets using (user_id)
+	                                   join results b on b.quiz_id = a.quiz_id and b.quiz_result = a.quiz_result and
+	                                                     b.user_id <> a.user_id
+	                                   join takers s on s.user_id = b.user_id and s.quiz_id = b.quiz_id) as shared
+	                    group by user_id, neighbour_id),
+	     scored as (select n.user_id, t.quiz_id, sum(n.similarity)::real as score
+	                from neighbours n
+	                         join taken t on t.user_id = n.neighbour_id
+	                where not exists (select 1 from taken own where own.user_id = n.user_id and own.quiz_id = t.quiz_id)
+	                group by n.user_id, t.quiz_id),
+	     ranked as (select user_id, quiz_id, score,
+	                       row_number() over (partition by user_id order by score desc, quiz_id) as rank
+	                from scored)
+	insert
+	into quiz_recommendations (user_id, quiz_id, recommendation_score, recommendation_rank)
+	select user_id, quiz_id, score, rank
+	from ranked
+	where rank <= $2
+	`
+
+	if _, err = tx.Exec(ctx, sql, since, query.PerUser, query.MaxTakersPerQuiz); err != nil {
+		return false, fmt.Errorf("failed to compute recommendations: %w", err)
+	}
+
+	// completions recorded while this transaction runs have an earlier
+	// updated_at than its now() and are only picked up by the next refresh of
+	// every user
+	sql = `
+	insert into recommendation_refreshes (refreshed_at, full_refreshed_at)
+	values (now(), now())
+	on conflict (singleton) do update set refreshed_at      = excluded.refreshed_at,
+	                                      full_refreshed_at = case
+	                                                              when $1::timestamptz is null then excluded.full_refreshed_at
+	                                                              else recommendation_refreshes.full_refreshed_at end
+	`
+
+	if _, err = tx.Exec(ctx, sql, since); err != nil {
+		return false, fmt.Errorf("failed to save refresh: %w", err)
+	}
+
+	return true, nil
+}
+
+func (r recommendationRepo) Query(ctx context.Context, query repository.RecommendationQuery) ([]*models.Recommendation, error) {
+	sql := `
+	with own as (select distinct quiz_id
+	             from quiz_completion_history
+	             where user_id = $1),
+	     personal as (select quiz_id, recommendation_score, recommendation_rank
+	                  from quiz_recommendations
+	                  where user_id = $1
+	                    and quiz_id not in (select quiz_id from own)
+	                  order by recommendation_rank
+	                  limit $2),
+	     popular as (select quiz_id, count(distinct user_id)::real as score
+	                 from quiz_completion_history
+	                 where completed_at >= $3
+	                   and quiz_id not in (select quiz_id from own)
+	                   and quiz_id not in (select quiz_id from personal)
+	                 group by quiz_id
+	                 order by score desc, quiz_id
+	                 limit $2)
+	select quiz_id, score, reason
+	from (select quiz_id, recommendation_score as score, $4::text as reason, 0 as part, recommendation_rank as position
+	      from personal
+	      union all
+	      select quiz_id, score, $5::text as reason, 1 as part, row_number() over (order by score desc, quiz_id) as position
+	      from popular) as recommendations
+	order by part, position
+	limit $2
+	`
+
+	rows, err := r.pool.Query(ctx, sql, query.UserID, query.Limit, query.PopularSince,
+		string(models.RecommendationReasonSimilarUsers), string(models.RecommendationReasonPopular))
+	if err != nil {
+		return nil, fmt.Errorf("query failed: %w", err)
+	}
+	defer rows.Close()
+
+	var recommendations []*models.Recommendation
+	for rows.Next() {
+		rec := new(models.Recommendation)
+		if err := rows.Scan(&rec.QuizID, &rec.Score, &rec.Reason); err != nil {
+			return nil, fmt.Errorf("scan failed: %w", err)
+		}
+		recommendations = append(recommendations, rec)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return recommendations, nil
+}
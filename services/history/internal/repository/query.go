@@ -1,6 +1,11 @@
 package repository
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/history/internal/models"
+)
 
 type Query struct {
 	UserIDs   []*uuid.UUID
@@ -8,3 +13,31 @@ type Query struct {
 	PageSize  int32
 	PageToken string
 }
+
+// StatsQuery selects the completions of a quiz within [From, To), unbounded
+// where nil.
+type StatsQuery struct {
+	QuizID   uuid.UUID
+	From     *time.Time
+	To       *time.Time
+	Interval models.StatsInterval
+}
+
+// RecommendationQuery selects up to Limit recommendations for the user: the
+// precomputed ones first, then the quizzes completed by most users since
+// PopularSince.
+type RecommendationQuery struct {
+	UserID       uuid.UUID
+	Limit        int32
+	PopularSince time.Time
+}
+
+// RefreshQuery recomputes up to PerUser recommendations for the users whose
+// history changed since the last refresh, or for every user if the last
+// refresh of every user was before FullBefore. Only the MaxTakersPerQuiz users
+// who took a quiz most recently count as neighbours through it.
+type RefreshQuery struct {
+	PerUser          int32
+	MaxTakersPerQuiz int32
+	FullBefore       time.Time
+}
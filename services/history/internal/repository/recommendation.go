@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mibrgmv/whoami-server/history/internal/models"
+)
+
+type RecommendationRepository interface {
+	Refresh(ctx context.Context, query RefreshQuery) (bool, error)
+	Query(ctx context.Context, query RecommendationQuery) ([]*models.Recommendation, error)
+}
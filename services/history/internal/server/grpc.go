@@ -20,7 +20,7 @@ type GrpcServer struct {
 	grpcServer *grpc.Server
 }
 
-func NewGrpcServer(pool *pgxpool.Pool) GrpcServer {
+func NewGrpcServer(pool *pgxpool.Pool, recommendationService service.RecommendationService) GrpcServer {
 	logger := log.New(os.Stderr, "", log.Ldate|log.Ltime|log.Lshortfile)
 
 	s := grpc.NewServer(
@@ -40,7 +40,7 @@ func NewGrpcServer(pool *pgxpool.Pool) GrpcServer {
 
 	historyRepo := postgres.NewHistoryRepository(pool)
 	historyService := service.NewHistoryService(historyRepo)
-	historyGrpc := historygrpc.NewHistoryServiceServer(historyService)
+	historyGrpc := historygrpc.NewHistoryServiceServer(historyService, recommendationService)
 	historyv1.RegisterHistoryServiceServer(s, historyGrpc)
 
 	reflection.Register(s)
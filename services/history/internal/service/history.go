@@ -2,16 +2,38 @@ package service
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 	"github.com/mibrgmv/whoami-server/history/internal/models"
 	"github.com/mibrgmv/whoami-server/history/internal/repository"
+	"github.com/mibrgmv/whoami-server/shared/guest"
 	"github.com/mibrgmv/whoami-server/shared/tools"
 )
 
+var (
+	ErrInvalidTimeRange   = errors.New("invalid time range")
+	ErrItemNotFound       = errors.New("history item not found")
+	ErrInvalidDisplayName = errors.New("invalid display name")
+	ErrSharedItemNotFound = errors.New("shared item not found")
+	ErrNotGuestSession    = errors.New("source user is not a guest session")
+)
+
+const maxDisplayNameLength = 64
+
 type HistoryService interface {
 	CreateItem(ctx context.Context, item *models.QuizCompletionHistoryItem) ([]*models.QuizCompletionHistoryItem, error)
 	GetItems(ctx context.Context, userIDs []*uuid.UUID, quizIDs []*uuid.UUID, pageSize int32, pageToken string) ([]*models.QuizCompletionHistoryItem, string, error)
+	GetQuizStats(ctx context.Context, quizID uuid.UUID, from, to *time.Time, interval models.StatsInterval) (*models.QuizStats, error)
+	ShareItem(ctx context.Context, itemID, userID uuid.UUID, displayName string) (*models.SharedItem, error)
+	UnshareItem(ctx context.Context, itemID, userID uuid.UUID) error
+	GetSharedItem(ctx context.Context, token string) (*models.SharedItem, error)
+	MergeItems(ctx context.Context, fromUserID, toUserID uuid.UUID) (int64, error)
 }
 
 type historyService struct {
@@ -50,3 +72,101 @@ func (s *historyService) GetItems(ctx context.Context, userIDs []*uuid.UUID, qui
 
 	return items, nextPageToken, nil
 }
+
+// GetQuizStats counts the completions of the quiz within [from, to), per result
+// and per interval.
+func (s *historyService) GetQuizStats(ctx context.Context, quizID uuid.UUID, from, to *time.Time, interval models.StatsInterval) (*models.QuizStats, error) {
+	if from != nil && to != nil && !from.Before(*to) {
+		return nil, fmt.Errorf("%w: from must be before to", ErrInvalidTimeRange)
+	}
+
+	if interval == "" {
+		interval = models.StatsIntervalDay
+	}
+
+	stats, err := s.repo.Stats(ctx, repository.StatsQuery{
+		QuizID:   quizID,
+		From:     from,
+		To:       to,
+		Interval: interval,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range stats.Results {
+		stats.Results[i].Percentage = float32(stats.Results[i].Count) / float32(stats.TotalCompletions) * 100
+	}
+
+	return stats, nil
+}
+
+// ShareItem makes the item of the user public under a share token. Sharing an
+// item again keeps its token and only updates the display name.
+func (s *historyService) ShareItem(ctx context.Context, itemID, userID uuid.UUID, displayName string) (*models.SharedItem, error) {
+	if utf8.RuneCountInString(displayName) > maxDisplayNameLength {
+		return nil, fmt.Errorf("%w: must be at most %d characters", ErrInvalidDisplayName, maxDisplayNameLength)
+	}
+
+	token, err := newShareToken()
+	if err != nil {
+		return nil, err
+	}
+
+	shared, err := s.repo.Share(ctx, itemID, userID, token, displayName)
+	if err != nil {
+		return nil, err
+	}
+	if shared == nil {
+		return nil, ErrItemNotFound
+	}
+
+	return shared, nil
+}
+
+func (s *historyService) UnshareItem(ctx context.Context, itemID, userID uuid.UUID) error {
+	ok, err := s.repo.Unshare(ctx, itemID, userID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrItemNotFound
+	}
+
+	return nil
+}
+
+func (s *historyService) GetSharedItem(ctx context.Context, token string) (*models.SharedItem, error) {
+	shared, err := s.repo.GetShared(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if shared == nil {
+		return nil, ErrSharedItemNotFound
+	}
+
+	return shared, nil
+}
+
+// MergeItems moves the history of a guest session to the user the guest signed
+// in as. The history of a user is never moved, only that of a guest.
+func (s *historyService) MergeItems(ctx context.Context, fromUserID, toUserID uuid.UUID) (int64, error) {
+	if !guest.IsID(fromUserID) {
+		return 0, ErrNotGuestSession
+	}
+
+	if fromUserID == toUserID {
+		return 0, nil
+	}
+
+	return s.repo.Merge(ctx, fromUserID, toUserID)
+}
+
+func newShareToken() (string, error) {
+	b := make([]byte, 18)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate share token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
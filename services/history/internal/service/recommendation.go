@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/history/internal/models"
+	"github.com/mibrgmv/whoami-server/history/internal/repository"
+)
+
+const (
+	defaultRecommendationsLimit = 10
+	maxRecommendationsLimit     = 100
+)
+
+type RecommendationConfig struct {
+	Interval         time.Duration `mapstructure:"interval"`
+	FullInterval     time.Duration `mapstructure:"full_interval"`
+	PerUser          int32         `mapstructure:"per_user"`
+	MaxTakersPerQuiz int32         `mapstructure:"max_takers_per_quiz"`
+	PopularWindow    time.Duration `mapstructure:"popular_window"`
+}
+
+type RecommendationService interface {
+	GetRecommendations(ctx context.Context, userID uuid.UUID, limit int32) ([]*models.Recommendation, error)
+	Refresh(ctx context.Context) (bool, error)
+	Run(ctx context.Context)
+}
+
+type recommendationService struct {
+	repo   repository.RecommendationRepository
+	config RecommendationConfig
+}
+
+func NewRecommendationService(repo repository.RecommendationRepository, config RecommendationConfig) RecommendationService {
+	if config.Interval <= 0 {
+		config.Interval = time.Hour
+	}
+	if config.FullInterval <= 0 {
+		config.FullInterval = 24 * time.Hour
+	}
+	if config.PerUser <= 0 {
+		config.PerUser = maxRecommendationsLimit
+	}
+	if config.MaxTakersPerQuiz <= 0 {
+		config.MaxTakersPerQuiz = 1000
+	}
+	if config.PopularWindow <= 0 {
+		config.PopularWindow = 30 * 24 * time.Hour
+	}
+
+	return &recommendationService{
+		repo:   repo,
+		config: config,
+	}
+}
+
+// GetRecommendations returns the quizzes the user has not taken yet, the ones
+// completed by similar users first and then the popular ones, so that users
+// without history get recommendations too.
+func (s *recommendationService) GetRecommendations(ctx context.Context, userID uuid.UUID, limit int32) ([]*models.Recommendation, error) {
+	if limit <= 0 {
+		limit = defaultRecommendationsLimit
+	}
+	limit = min(limit, maxRecommendationsLimit)
+
+	return s.repo.Query(ctx, repository.RecommendationQuery{
+		UserID:       userID,
+		Limit:        limit,
+		PopularSince: time.Now().Add(-s.config.PopularWindow),
+	})
+}
+
+// Refresh recomputes the recommendations of the users whose history changed
+// since the last refresh, and of every user each full interval, so that they
+// follow the new completions of their neighbours too. It reports false if
+// another replica is refreshing already.
+func (s *recommendationService) Refresh(ctx context.Context) (bool, error) {
+	return s.repo.Refresh(ctx, repository.RefreshQuery{
+		PerUser:          s.config.PerUser,
+		MaxTakersPerQuiz: s.config.MaxTakersPerQuiz,
+		FullBefore:       time.Now().Add(-s.config.FullInterval),
+	})
+}
+
+// Run refreshes the recommendations right away and then every interval until
+// the context is done.
+func (s *recommendationService) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		start := time.Now()
+		if refreshed, err := s.Refresh(ctx); err != nil {
+			log.Printf("failed to refresh recommendations: %v", err)
+		} else if refreshed {
+			log.Printf("refreshed recommendations in %v", time.Since(start))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
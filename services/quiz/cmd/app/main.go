@@ -10,6 +10,7 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	appcfg "github.com/mibrgmv/whoami-server/quiz/internal/config"
 	"github.com/mibrgmv/whoami-server/quiz/internal/server"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/media/local"
 	"github.com/mibrgmv/whoami-server/shared/config"
 	"github.com/mibrgmv/whoami-server/shared/storage/redis"
 	"github.com/mibrgmv/whoami-server/shared/tools"
@@ -51,11 +52,18 @@ func main() {
 	}
 	log.Println("Connected to Redis successfully")
 
-	s, err := server.NewGrpcServer(pool, client, cfg.HistoryService.GetAddr())
+	blobStore, err := local.NewStore(*cfg.BlobStore)
+	if err != nil {
+		log.Fatalf("Failed to create blob store: %v", err)
+	}
+
+	s, err := server.NewGrpcServer(pool, client, blobStore, *cfg.Media, *cfg.Localization, *cfg.Outbox, cfg.HistoryService.GetAddr())
 	if err != nil {
 		log.Fatalf("Failed to create server: %v", err)
 	}
 
+	go s.RunOutbox(ctx)
+
 	go func() {
 		if err := s.Start(cfg.Grpc.GetAddr()); err != nil {
 			log.Fatalf("Failed to start gRPC server: %v", err)
@@ -0,0 +1,166 @@
+// Command quizctl moves quizzes between deployments as JSON or YAML documents.
+//
+//	quizctl -user <user-id> pull <quiz-id> <file>
+//	quizctl -user <user-id> push <file>
+//
+// It talks to the quiz service over gRPC and acts as the given user, so it is
+// meant to be pointed at the service directly, not at the gateway.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	transferv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/transfer/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	"gopkg.in/yaml.v3"
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:50051", "quiz service address")
+	userID := flag.String("user", "", "ID of the user to act as")
+	roles := flag.String("roles", "", "comma-separated roles of the user")
+	timeout := flag.Duration("timeout", 30*time.Second, "request timeout")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage:\n  quizctl [flags] pull <quiz-id> <file>\n  quizctl [flags] push <file>\n\nflags:\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if *userID == "" {
+		log.Fatal("-user is required")
+	}
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("failed to connect to quiz service: %v", err)
+	}
+	defer conn.Close()
+	client := transferv1.NewTransferServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	ctx = metadata.AppendToOutgoingContext(ctx, "user_id", *userID)
+	for _, role := range strings.Split(*roles, ",") {
+		if role != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, "roles", role)
+		}
+	}
+
+	args := flag.Args()
+	switch {
+	case len(args) == 3 && args[0] == "pull":
+		err = pull(ctx, client, args[1], args[2])
+	case len(args) == 2 && args[0] == "push":
+		err = push(ctx, client, args[1])
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func pull(ctx context.Context, client transferv1.TransferServiceClient, quizID, path string) error {
+	document, err := client.ExportQuiz(ctx, &transferv1.ExportQuizRequest{Id: quizID})
+	if err != nil {
+		return fmt.Errorf("failed to export quiz: %w", err)
+	}
+
+	data, err := marshal(document, path)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write document: %w", err)
+	}
+
+	log.Printf("exported quiz %s to %s", quizID, path)
+	return nil
+}
+
+func push(ctx context.Context, client transferv1.TransferServiceClient, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read document: %w", err)
+	}
+
+	document, err := unmarshal(data, path)
+	if err != nil {
+		return err
+	}
+
+	quiz, err := client.ImportQuiz(ctx, &transferv1.ImportQuizRequest{Document: document})
+	if err != nil {
+		return fmt.Errorf("failed to import quiz: %w", err)
+	}
+
+	log.Printf("imported %s as quiz %s", path, quiz.Id)
+	return nil
+}
+
+func isYAML(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	return ext == ".yaml" || ext == ".yml"
+}
+
+// marshal writes the document as protobuf JSON, which is converted to YAML
+// for .yaml and .yml files.
+func marshal(document *transferv1.QuizDocument, path string) ([]byte, error) {
+	data, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(document)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode document: %w", err)
+	}
+
+	if !isYAML(path) {
+		return data, nil
+	}
+
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, fmt.Errorf("failed to encode document: %w", err)
+	}
+
+	data, err = yaml.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode document: %w", err)
+	}
+
+	return data, nil
+}
+
+func unmarshal(data []byte, path string) (*transferv1.QuizDocument, error) {
+	if isYAML(path) {
+		var value any
+		if err := yaml.Unmarshal(data, &value); err != nil {
+			return nil, fmt.Errorf("failed to decode document: %w", err)
+		}
+
+		var err error
+		data, err = json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode document: %w", err)
+		}
+	}
+
+	document := new(transferv1.QuizDocument)
+	if err := protojson.Unmarshal(data, document); err != nil {
+		return nil, fmt.Errorf("failed to decode document: %w", err)
+	}
+
+	return document, nil
+}
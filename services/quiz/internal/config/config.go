@@ -1,14 +1,22 @@
 package config
 
 import (
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/media"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/media/local"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/outbox"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/translation"
 	"github.com/mibrgmv/whoami-server/shared/grpc"
 	"github.com/mibrgmv/whoami-server/shared/storage/postgres"
 	"github.com/mibrgmv/whoami-server/shared/storage/redis"
 )
 
 type Config struct {
-	Grpc           *grpc.Config     `mapstructure:"grpc"`
-	Postgres       *postgres.Config `mapstructure:"postgres"`
-	Redis          *redis.Config    `mapstructure:"redis"`
-	HistoryService *grpc.Config     `mapstructure:"history-service"`
+	Grpc           *grpc.Config        `mapstructure:"grpc"`
+	Postgres       *postgres.Config    `mapstructure:"postgres"`
+	Redis          *redis.Config       `mapstructure:"redis"`
+	HistoryService *grpc.Config        `mapstructure:"history-service"`
+	Media          *media.Config       `mapstructure:"media"`
+	BlobStore      *local.Config       `mapstructure:"blob-store"`
+	Localization   *translation.Config `mapstructure:"localization"`
+	Outbox         *outbox.Config      `mapstructure:"outbox"`
 }
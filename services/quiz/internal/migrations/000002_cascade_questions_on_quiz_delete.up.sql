@@ -0,0 +1,4 @@
+alter table questions
+    drop constraint questions_quiz_id_fkey,
+    add constraint questions_quiz_id_fkey
+        foreign key (quiz_id) references quizzes (quiz_id) on delete cascade;
@@ -0,0 +1,4 @@
+drop index if exists quizzes_author_id_idx;
+
+alter table quizzes
+    drop column if exists author_id;
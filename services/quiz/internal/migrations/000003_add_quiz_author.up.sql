@@ -0,0 +1,11 @@
+-- quizzes created before authors were recorded get the nil UUID as author, so
+-- only a user with the quiz-admin role can edit them. to hand them over, run
+--   update quizzes set author_id = '<user id>'
+--   where author_id = '00000000-0000-0000-0000-000000000000';
+alter table quizzes
+    add column author_id uuid not null default '00000000-0000-0000-0000-000000000000';
+
+alter table quizzes
+    alter column author_id drop default;
+
+create index quizzes_author_id_idx on quizzes (author_id);
@@ -0,0 +1,4 @@
+drop index if exists quizzes_quiz_status_idx;
+
+alter table quizzes
+    drop column if exists quiz_status;
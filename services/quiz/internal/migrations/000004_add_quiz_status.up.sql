@@ -0,0 +1,8 @@
+alter table quizzes
+    add column quiz_status text not null default 'published'
+        check (quiz_status in ('draft', 'published', 'archived'));
+
+alter table quizzes
+    alter column quiz_status set default 'draft';
+
+create index quizzes_quiz_status_idx on quizzes (quiz_status);
@@ -0,0 +1,4 @@
+alter table quizzes
+    drop column if exists current_version_id;
+
+drop table if exists quiz_versions;
@@ -0,0 +1,16 @@
+create table quiz_versions
+(
+    quiz_version_id     uuid primary key,
+
+    quiz_id             uuid        not null,
+    quiz_version_number int         not null,
+    quiz_title          text        not null,
+    quiz_results        text[]      not null,
+    quiz_questions      jsonb       not null,
+    created_at          timestamptz not null default now(),
+
+    unique (quiz_id, quiz_version_number)
+);
+
+alter table quizzes
+    add column current_version_id uuid references quiz_versions (quiz_version_id);
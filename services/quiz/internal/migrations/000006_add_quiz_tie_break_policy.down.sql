@@ -0,0 +1,4 @@
+alter table quizzes
+    drop column if exists tiebreaker_question_id,
+    drop column if exists tie_break_seed,
+    drop column if exists tie_break_policy;
@@ -0,0 +1,5 @@
+alter table quizzes
+    add column tie_break_policy       text   not null default 'first_declared'
+        check (tie_break_policy in ('first_declared', 'random', 'report_all', 'tiebreaker_question')),
+    add column tie_break_seed         bigint not null default 0,
+    add column tiebreaker_question_id uuid;
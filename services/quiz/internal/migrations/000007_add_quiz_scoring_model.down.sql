@@ -0,0 +1,4 @@
+alter table quizzes
+    drop column if exists score_thresholds,
+    drop column if exists trait_axes,
+    drop column if exists scoring_model;
@@ -0,0 +1,5 @@
+alter table quizzes
+    add column scoring_model    text   not null default 'weighted_sum'
+        check (scoring_model in ('weighted_sum', 'knowledge', 'traits', 'bands')),
+    add column trait_axes       jsonb  not null default '[]',
+    add column score_thresholds real[] not null default '{}';
@@ -0,0 +1,2 @@
+alter table questions
+    drop column if exists question_type;
@@ -0,0 +1,3 @@
+alter table questions
+    add column question_type text not null default 'single_choice'
+        check (question_type in ('single_choice', 'multi_select', 'likert', 'numeric', 'ranking'));
@@ -0,0 +1,21 @@
+alter table questions
+    add column question_options_weights jsonb not null default '{}';
+
+update questions
+set question_options_weights = coalesce((select jsonb_object_agg(option ->> 'text', option -> 'weights')
+                                         from jsonb_array_elements(case jsonb_typeof(question_options)
+                                                                       when 'array' then question_options
+                                                                       else '[]' end) as option), '{}');
+
+update quiz_versions
+set quiz_questions = (select coalesce(jsonb_agg((question - 'options') ||
+                                                jsonb_build_object('options_weights', coalesce((
+                                                    select jsonb_object_agg(option ->> 'text', option -> 'weights')
+                                                    from jsonb_array_elements(case jsonb_typeof(question -> 'options')
+                                                                                  when 'array' then question -> 'options'
+                                                                                  else '[]' end) as option), '{}'))
+                                                order by position), '[]')
+                      from jsonb_array_elements(quiz_questions) with ordinality as elements(question, position));
+
+alter table questions
+    drop column question_options;
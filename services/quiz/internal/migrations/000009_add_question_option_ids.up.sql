@@ -0,0 +1,35 @@
+alter table questions
+    add column question_options jsonb not null default '[]';
+
+update questions
+set question_options = coalesce((select jsonb_agg(jsonb_build_object('id', gen_random_uuid(),
+                                                                     'text', option.key,
+                                                                     'weights', option.value)
+                                                  order by option.key collate "C")
+                                 from jsonb_each(case jsonb_typeof(question_options_weights)
+                                                     when 'object' then question_options_weights
+                                                     else '{}' end) as option), '[]');
+
+update quiz_versions
+set quiz_questions = (select coalesce(jsonb_agg((question - 'options_weights') ||
+                                                jsonb_build_object('options', coalesce((
+                                                    select jsonb_agg(jsonb_build_object(
+                                                                             'id', coalesce(existing.id, gen_random_uuid()),
+                                                                             'text', option.key,
+                                                                             'weights', option.value)
+                                                                     order by option.key collate "C")
+                                                    from jsonb_each(case jsonb_typeof(question -> 'options_weights')
+                                                                        when 'object' then question -> 'options_weights'
+                                                                        else '{}' end) as option
+                                                             left join lateral (
+                                                        select (current_option ->> 'id')::uuid as id
+                                                        from questions,
+                                                             jsonb_array_elements(question_options) as current_option
+                                                        where question_id = (question ->> 'id')::uuid
+                                                          and current_option ->> 'text' = option.key
+                                                        ) as existing on true), '[]'))
+                                                order by position), '[]')
+                      from jsonb_array_elements(quiz_questions) with ordinality as elements(question, position));
+
+alter table questions
+    drop column question_options_weights;
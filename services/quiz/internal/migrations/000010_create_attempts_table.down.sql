@@ -0,0 +1,2 @@
+drop table if exists attempt_answers;
+drop table if exists attempts;
@@ -0,0 +1,25 @@
+create table attempts
+(
+    attempt_id         uuid primary key,
+
+    quiz_id            uuid        not null references quizzes (quiz_id) on delete cascade,
+    quiz_version_id    uuid        not null references quiz_versions (quiz_version_id),
+    user_id            uuid        not null,
+    attempt_status     text        not null default 'in_progress'
+        check (attempt_status in ('in_progress', 'finished')),
+    attempt_evaluation jsonb,
+    started_at         timestamptz not null default now(),
+    finished_at        timestamptz
+);
+
+create unique index attempts_in_progress_idx on attempts (user_id, quiz_id) where attempt_status = 'in_progress';
+
+create table attempt_answers
+(
+    attempt_id  uuid        not null references attempts (attempt_id) on delete cascade,
+    question_id uuid        not null,
+    answer      jsonb       not null,
+    answered_at timestamptz not null default now(),
+
+    primary key (attempt_id, question_id)
+);
@@ -0,0 +1,7 @@
+alter table attempts
+    drop column if exists time_limit_seconds,
+    drop column if exists question_time_limit_seconds;
+
+alter table quizzes
+    drop column if exists time_limit_seconds,
+    drop column if exists question_time_limit_seconds;
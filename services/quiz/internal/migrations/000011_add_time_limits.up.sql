@@ -0,0 +1,7 @@
+alter table quizzes
+    add column time_limit_seconds          int not null default 0 check (time_limit_seconds >= 0),
+    add column question_time_limit_seconds int not null default 0 check (question_time_limit_seconds >= 0);
+
+alter table attempts
+    add column time_limit_seconds          int not null default 0,
+    add column question_time_limit_seconds int not null default 0;
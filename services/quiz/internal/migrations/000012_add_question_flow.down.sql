@@ -0,0 +1,6 @@
+alter table quizzes
+    drop column if exists early_termination;
+
+alter table questions
+    drop column if exists question_position,
+    drop column if exists question_route;
@@ -0,0 +1,12 @@
+alter table questions
+    add column question_position int not null default 0,
+    add column question_route    jsonb;
+
+update questions
+set question_position = numbered.position
+from (select question_id, row_number() over (partition by quiz_id order by question_id) - 1 as position
+      from questions) numbered
+where questions.question_id = numbered.question_id;
+
+alter table quizzes
+    add column early_termination boolean not null default false;
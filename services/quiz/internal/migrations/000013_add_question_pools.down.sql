@@ -0,0 +1,9 @@
+alter table attempts
+    drop column if exists draw_seed,
+    drop column if exists drawn_question_ids;
+
+alter table quizzes
+    drop column if exists question_draw;
+
+alter table questions
+    drop column if exists question_tags;
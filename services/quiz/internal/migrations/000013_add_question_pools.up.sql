@@ -0,0 +1,9 @@
+alter table questions
+    add column question_tags text[] not null default '{}';
+
+alter table quizzes
+    add column question_draw jsonb;
+
+alter table attempts
+    add column draw_seed          bigint not null default 0,
+    add column drawn_question_ids uuid[];
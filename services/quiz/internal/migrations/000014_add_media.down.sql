@@ -0,0 +1,7 @@
+alter table quizzes
+    drop column if exists result_details;
+
+alter table questions
+    drop column if exists question_image_id;
+
+drop table if exists media;
@@ -0,0 +1,14 @@
+create table media
+(
+    media_id           uuid primary key,
+    owner_id           uuid        not null,
+    media_content_type text        not null,
+    media_size         bigint      not null,
+    created_at         timestamptz not null default now()
+);
+
+alter table questions
+    add column question_image_id uuid references media (media_id);
+
+alter table quizzes
+    add column result_details jsonb not null default '[]';
@@ -0,0 +1,13 @@
+drop index if exists quizzes_title_idx;
+drop index if exists quizzes_completion_count_idx;
+drop index if exists quizzes_created_at_idx;
+drop index if exists quizzes_search_vector_idx;
+
+drop table if exists quiz_tags;
+
+alter table quizzes
+    drop column if exists search_vector,
+    drop column if exists completion_count,
+    drop column if exists created_at,
+    drop column if exists quiz_language,
+    drop column if exists quiz_description;
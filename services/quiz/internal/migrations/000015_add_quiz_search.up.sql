@@ -0,0 +1,33 @@
+alter table quizzes
+    add column quiz_description text        not null default '',
+    add column quiz_language    text        not null default '',
+    add column created_at       timestamptz not null default now(),
+    add column completion_count bigint      not null default 0,
+    add column search_vector    tsvector generated always as
+        (to_tsvector('simple', quiz_title || ' ' || quiz_description)) stored;
+
+create table quiz_tags
+(
+    quiz_id  uuid not null references quizzes (quiz_id) on delete cascade,
+    tag_name text not null,
+
+    primary key (quiz_id, tag_name)
+);
+
+create index quiz_tags_tag_name_idx on quiz_tags (tag_name);
+
+-- only finished attempts are in this database. completions evaluated without
+-- an attempt before this migration are recorded by the history service alone,
+-- which keeps every completion, so the exact counts come from running
+--   select quiz_id, count(*) from quiz_completion_history group by quiz_id;
+-- against the history database and setting completion_count to them
+update quizzes
+set completion_count = (select count(*)
+                        from attempts
+                        where attempts.quiz_id = quizzes.quiz_id
+                          and attempt_status = 'finished');
+
+create index quizzes_search_vector_idx on quizzes using gin (search_vector);
+create index quizzes_created_at_idx on quizzes (created_at desc, quiz_id desc);
+create index quizzes_completion_count_idx on quizzes (completion_count desc, quiz_id desc);
+create index quizzes_title_idx on quizzes (quiz_title, quiz_id);
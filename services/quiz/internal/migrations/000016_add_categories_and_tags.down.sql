@@ -0,0 +1,6 @@
+alter table quiz_tags
+    drop constraint if exists quiz_tags_tag_name_fkey;
+
+drop table if exists quiz_categories;
+drop table if exists tags;
+drop table if exists categories;
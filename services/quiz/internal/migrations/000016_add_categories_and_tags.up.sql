@@ -0,0 +1,32 @@
+create table categories
+(
+    category_id          uuid primary key,
+    category_name        text        not null unique,
+    category_description text        not null default '',
+    created_at           timestamptz not null default now()
+);
+
+create table tags
+(
+    tag_name   text primary key,
+    created_at timestamptz not null default now()
+);
+
+create table quiz_categories
+(
+    quiz_id     uuid not null references quizzes (quiz_id) on delete cascade,
+    category_id uuid not null references categories (category_id) on delete cascade,
+
+    primary key (quiz_id, category_id)
+);
+
+create index quiz_categories_category_id_idx on quiz_categories (category_id);
+
+-- tags already given to quizzes become managed tags
+insert into tags (tag_name)
+select distinct tag_name
+from quiz_tags;
+
+alter table quiz_tags
+    add constraint quiz_tags_tag_name_fkey foreign key (tag_name)
+        references tags (tag_name) on update cascade on delete cascade;
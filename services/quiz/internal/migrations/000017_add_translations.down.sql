@@ -0,0 +1,2 @@
+drop table if exists question_translations;
+drop table if exists quiz_translations;
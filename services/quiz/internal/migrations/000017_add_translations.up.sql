@@ -0,0 +1,26 @@
+create table quiz_translations
+(
+    quiz_id                 uuid        not null references quizzes (quiz_id) on delete cascade,
+    translation_language    text        not null,
+    translation_title       text        not null default '',
+    translation_description text        not null default '',
+    translation_results     text[]      not null default '{}',
+    updated_at              timestamptz not null default now(),
+
+    primary key (quiz_id, translation_language)
+);
+
+create table question_translations
+(
+    quiz_id              uuid  not null,
+    translation_language text  not null,
+    question_id          uuid  not null references questions (question_id) on delete cascade,
+    translation_body     text  not null default '',
+    translation_options  jsonb not null default '{}',
+
+    primary key (question_id, translation_language),
+    foreign key (quiz_id, translation_language)
+        references quiz_translations (quiz_id, translation_language) on delete cascade
+);
+
+create index question_translations_quiz_id_idx on question_translations (quiz_id, translation_language);
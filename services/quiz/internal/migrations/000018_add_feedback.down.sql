@@ -0,0 +1,11 @@
+drop table if exists quiz_comments;
+drop table if exists quiz_likes;
+drop table if exists quiz_ratings;
+
+drop index if exists quizzes_like_count_idx;
+drop index if exists quizzes_rating_average_idx;
+
+alter table quizzes
+    drop column if exists like_count,
+    drop column if exists rating_count,
+    drop column if exists rating_average;
@@ -0,0 +1,45 @@
+alter table quizzes
+    add column rating_average real   not null default 0,
+    add column rating_count   bigint not null default 0,
+    add column like_count     bigint not null default 0;
+
+create index quizzes_rating_average_idx on quizzes (rating_average desc, quiz_id desc);
+create index quizzes_like_count_idx on quizzes (like_count desc, quiz_id desc);
+
+create table quiz_ratings
+(
+    quiz_id      uuid        not null references quizzes (quiz_id) on delete cascade,
+    user_id      uuid        not null,
+    rating_stars smallint    not null check (rating_stars between 1 and 5),
+    created_at   timestamptz not null default now(),
+    updated_at   timestamptz not null default now(),
+
+    primary key (quiz_id, user_id)
+);
+
+create table quiz_likes
+(
+    quiz_id    uuid        not null references quizzes (quiz_id) on delete cascade,
+    user_id    uuid        not null,
+    created_at timestamptz not null default now(),
+
+    primary key (quiz_id, user_id)
+);
+
+create table quiz_comments
+(
+    comment_id    uuid primary key,
+    quiz_id       uuid        not null references quizzes (quiz_id) on delete cascade,
+    parent_id     uuid references quiz_comments (comment_id) on delete cascade,
+    author_id     uuid        not null,
+    comment_body  text        not null,
+    created_at    timestamptz not null default now(),
+    updated_at    timestamptz not null default now(),
+    deleted_at    timestamptz,
+    hidden_at     timestamptz,
+    hidden_by     uuid,
+    hidden_reason text        not null default ''
+);
+
+create index quiz_comments_thread_idx on quiz_comments (quiz_id, parent_id, created_at, comment_id);
+create index quiz_comments_hidden_idx on quiz_comments (created_at, comment_id) where hidden_at is not null;
@@ -0,0 +1,11 @@
+create table history_outbox
+(
+    idempotency_key text primary key,
+    history_item    jsonb       not null,
+    attempt_count   integer     not null default 0,
+    last_error      text,
+    created_at      timestamptz not null default now(),
+    next_attempt_at timestamptz not null default now()
+);
+
+create index history_outbox_next_attempt_at_idx on history_outbox (next_attempt_at);
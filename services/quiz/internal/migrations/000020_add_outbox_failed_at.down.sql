@@ -0,0 +1,6 @@
+drop index if exists history_outbox_next_attempt_at_idx;
+
+alter table history_outbox
+    drop column if exists failed_at;
+
+create index history_outbox_next_attempt_at_idx on history_outbox (next_attempt_at);
@@ -0,0 +1,6 @@
+alter table history_outbox
+    add column failed_at timestamptz;
+
+drop index if exists history_outbox_next_attempt_at_idx;
+
+create index history_outbox_next_attempt_at_idx on history_outbox (next_attempt_at) where failed_at is null;
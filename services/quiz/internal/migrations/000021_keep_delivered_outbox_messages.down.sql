@@ -0,0 +1,10 @@
+drop index if exists history_outbox_delivered_at_idx;
+
+drop index if exists history_outbox_next_attempt_at_idx;
+
+delete from history_outbox where delivered_at is not null;
+
+alter table history_outbox
+    drop column if exists delivered_at;
+
+create index history_outbox_next_attempt_at_idx on history_outbox (next_attempt_at) where failed_at is null;
@@ -0,0 +1,8 @@
+alter table history_outbox
+    add column delivered_at timestamptz;
+
+drop index if exists history_outbox_next_attempt_at_idx;
+
+create index history_outbox_next_attempt_at_idx on history_outbox (next_attempt_at) where failed_at is null and delivered_at is null;
+
+create index history_outbox_delivered_at_idx on history_outbox (delivered_at) where delivered_at is not null;
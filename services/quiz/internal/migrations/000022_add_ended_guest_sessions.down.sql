@@ -0,0 +1 @@
+drop table if exists ended_guest_sessions;
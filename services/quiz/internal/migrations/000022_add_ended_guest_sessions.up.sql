@@ -0,0 +1,6 @@
+create table ended_guest_sessions
+(
+    guest_id uuid primary key,
+    user_id  uuid        not null,
+    ended_at timestamptz not null default now()
+);
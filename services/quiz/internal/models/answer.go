@@ -8,9 +8,13 @@ import (
 )
 
 type Answer struct {
-	QuizID     uuid.UUID `json:"quiz_id"`
-	QuestionID uuid.UUID `json:"question_id"`
-	Body       string    `json:"body"`
+	QuizID     uuid.UUID   `json:"quiz_id"`
+	QuestionID uuid.UUID   `json:"question_id"`
+	Body       string      `json:"body"`
+	Options    []string    `json:"options"`
+	Value      *float32    `json:"value"`
+	OptionID   uuid.UUID   `json:"option_id"`
+	OptionIDs  []uuid.UUID `json:"option_ids"`
 }
 
 func AnswerToModel(protoAnswer *questionv1.Answer) (*Answer, error) {
@@ -24,9 +28,51 @@ func AnswerToModel(protoAnswer *questionv1.Answer) (*Answer, error) {
 		return nil, fmt.Errorf("failed to parse question ID '%s': %w", protoAnswer.QuestionId, err)
 	}
 
+	var optionID uuid.UUID
+	if protoAnswer.OptionId != "" {
+		optionID, err = uuid.Parse(protoAnswer.OptionId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse option ID '%s': %w", protoAnswer.OptionId, err)
+		}
+	}
+
+	optionIDs := make([]uuid.UUID, len(protoAnswer.OptionIds))
+	for i, id := range protoAnswer.OptionIds {
+		optionIDs[i], err = uuid.Parse(id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse option ID '%s': %w", id, err)
+		}
+	}
+
 	return &Answer{
 		QuizID:     quizID,
 		QuestionID: questionID,
 		Body:       protoAnswer.Body,
+		Options:    protoAnswer.Options,
+		Value:      protoAnswer.Value,
+		OptionID:   optionID,
+		OptionIDs:  optionIDs,
 	}, nil
 }
+
+func (a *Answer) ToProto() *questionv1.Answer {
+	var optionID string
+	if a.OptionID != uuid.Nil {
+		optionID = a.OptionID.String()
+	}
+
+	optionIDs := make([]string, len(a.OptionIDs))
+	for i, id := range a.OptionIDs {
+		optionIDs[i] = id.String()
+	}
+
+	return &questionv1.Answer{
+		QuizId:     a.QuizID.String(),
+		QuestionId: a.QuestionID.String(),
+		Body:       a.Body,
+		Options:    a.Options,
+		Value:      a.Value,
+		OptionId:   optionID,
+		OptionIds:  optionIDs,
+	}
+}
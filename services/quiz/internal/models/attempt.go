@@ -0,0 +1,146 @@
+package models
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	attemptv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/attempt/v1"
+	questionv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/question/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AttemptStatus string
+
+const (
+	AttemptStatusInProgress AttemptStatus = "in_progress"
+	AttemptStatusFinished   AttemptStatus = "finished"
+)
+
+type Attempt struct {
+	ID                       uuid.UUID     `json:"id"`
+	QuizID                   uuid.UUID     `json:"quiz_id"`
+	QuizVersionID            uuid.UUID     `json:"quiz_version_id"`
+	UserID                   uuid.UUID     `json:"user_id"`
+	Status                   AttemptStatus `json:"status"`
+	Answers                  []Answer      `json:"answers"`
+	Evaluation               *Evaluation   `json:"evaluation"`
+	StartedAt                time.Time     `json:"started_at"`
+	FinishedAt               *time.Time    `json:"finished_at"`
+	LastAnsweredAt           *time.Time    `json:"last_answered_at"`
+	TimeLimitSeconds         int32         `json:"time_limit_seconds"`
+	QuestionTimeLimitSeconds int32         `json:"question_time_limit_seconds"`
+	DrawSeed                 int64         `json:"draw_seed"`
+	QuestionIDs              []uuid.UUID   `json:"question_ids"`
+}
+
+// SetAnswer records the answer, replacing an earlier answer to the same question.
+func (a *Attempt) SetAnswer(answer Answer) {
+	i := slices.IndexFunc(a.Answers, func(existing Answer) bool { return existing.QuestionID == answer.QuestionID })
+	if i < 0 {
+		a.Answers = append(a.Answers, answer)
+		return
+	}
+	a.Answers[i] = answer
+}
+
+// Deadline returns when the attempt runs out of time, or nil when it has no time limit.
+func (a *Attempt) Deadline() *time.Time {
+	if a.TimeLimitSeconds <= 0 {
+		return nil
+	}
+
+	deadline := a.StartedAt.Add(time.Duration(a.TimeLimitSeconds) * time.Second)
+	return &deadline
+}
+
+// AnswerDeadline returns when the next answer is due: the per-question limit
+// counts from the last answer, or from the start before the first one, and
+// never runs past the attempt deadline.
+func (a *Attempt) AnswerDeadline() *time.Time {
+	deadline := a.Deadline()
+	if a.QuestionTimeLimitSeconds <= 0 {
+		return deadline
+	}
+
+	from := a.StartedAt
+	if a.LastAnsweredAt != nil {
+		from = *a.LastAnsweredAt
+	}
+
+	questionDeadline := from.Add(time.Duration(a.QuestionTimeLimitSeconds) * time.Second)
+	if deadline == nil || questionDeadline.Before(*deadline) {
+		return &questionDeadline
+	}
+	return deadline
+}
+
+// TimedOut reports whether the attempt no longer accepts answers at the given time.
+func (a *Attempt) TimedOut(now time.Time) bool {
+	deadline := a.AnswerDeadline()
+	return deadline != nil && now.After(*deadline)
+}
+
+// ElapsedTime returns how long a finished attempt took, capped at its time limit.
+func (a *Attempt) ElapsedTime() time.Duration {
+	if a.FinishedAt == nil {
+		return 0
+	}
+
+	end := *a.FinishedAt
+	if deadline := a.Deadline(); deadline != nil && end.After(*deadline) {
+		end = *deadline
+	}
+	return end.Sub(a.StartedAt)
+}
+
+func (a *Attempt) ToProto() *attemptv1.Attempt {
+	answers := make([]*questionv1.Answer, len(a.Answers))
+	for i := range a.Answers {
+		answers[i] = a.Answers[i].ToProto()
+	}
+
+	questionIDs := make([]string, len(a.QuestionIDs))
+	for i, id := range a.QuestionIDs {
+		questionIDs[i] = id.String()
+	}
+
+	protoAttempt := &attemptv1.Attempt{
+		Id:            a.ID.String(),
+		QuizId:        a.QuizID.String(),
+		QuizVersionId: a.QuizVersionID.String(),
+		UserId:        a.UserID.String(),
+		Status:        a.Status.ToProto(),
+		Answers:       answers,
+		StartedAt:     timestamppb.New(a.StartedAt),
+		QuestionIds:   questionIDs,
+	}
+
+	if a.Evaluation != nil {
+		protoAttempt.Evaluation = a.Evaluation.ToProto()
+	}
+	if a.FinishedAt != nil {
+		protoAttempt.FinishedAt = timestamppb.New(*a.FinishedAt)
+		protoAttempt.ElapsedTime = durationpb.New(a.ElapsedTime())
+	}
+	if deadline := a.Deadline(); deadline != nil {
+		protoAttempt.Deadline = timestamppb.New(*deadline)
+	}
+	if deadline := a.AnswerDeadline(); deadline != nil && a.Status == AttemptStatusInProgress {
+		protoAttempt.AnswerDeadline = timestamppb.New(*deadline)
+	}
+
+	return protoAttempt
+}
+
+func (s AttemptStatus) ToProto() attemptv1.AttemptStatus {
+	switch s {
+	case AttemptStatusInProgress:
+		return attemptv1.AttemptStatus_ATTEMPT_STATUS_IN_PROGRESS
+	case AttemptStatusFinished:
+		return attemptv1.AttemptStatus_ATTEMPT_STATUS_FINISHED
+	default:
+		return attemptv1.AttemptStatus_ATTEMPT_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,81 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	categoryv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/category/v1"
+	quizv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/quiz/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Category groups quizzes for browsing. Categories and tags are managed by
+// admins, authors only assign them to their quizzes.
+type Category struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	QuizCount   int64     `json:"quiz_count"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type Tag struct {
+	Name      string `json:"name"`
+	QuizCount int64  `json:"quiz_count"`
+}
+
+// TagFacet is the number of quizzes with the tag among the listed ones.
+type TagFacet struct {
+	Tag   string `json:"tag"`
+	Count int64  `json:"count"`
+}
+
+func (c *Category) ToProto() *categoryv1.Category {
+	return &categoryv1.Category{
+		Id:          c.ID.String(),
+		Name:        c.Name,
+		Description: c.Description,
+		QuizCount:   c.QuizCount,
+		CreatedAt:   timestamppb.New(c.CreatedAt),
+	}
+}
+
+func (t *Tag) ToProto() *categoryv1.Tag {
+	return &categoryv1.Tag{
+		Name:      t.Name,
+		QuizCount: t.QuizCount,
+	}
+}
+
+func TagFacetsToProto(facets []TagFacet) []*quizv1.TagFacet {
+	protoFacets := make([]*quizv1.TagFacet, len(facets))
+	for i, facet := range facets {
+		protoFacets[i] = &quizv1.TagFacet{Tag: facet.Tag, Count: facet.Count}
+	}
+	return protoFacets
+}
+
+// CategoryIDsToModel parses the IDs, dropping repeated ones.
+func CategoryIDsToModel(ids []string) ([]uuid.UUID, error) {
+	categoryIDs := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		categoryID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse category ID '%s': %w", id, err)
+		}
+		if !slices.Contains(categoryIDs, categoryID) {
+			categoryIDs = append(categoryIDs, categoryID)
+		}
+	}
+	return categoryIDs, nil
+}
+
+func CategoryIDsToProto(ids []uuid.UUID) []string {
+	protoIDs := make([]string, len(ids))
+	for i, id := range ids {
+		protoIDs[i] = id.String()
+	}
+	return protoIDs
+}
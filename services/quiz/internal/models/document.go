@@ -0,0 +1,245 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	questionv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/question/v1"
+	transferv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/transfer/v1"
+)
+
+// QuizDocumentFormatVersion is the version of the quiz document format written
+// by ToProto. Documents of later versions are rejected.
+const QuizDocumentFormatVersion = 1
+
+// QuizDocument is a self-contained copy of a quiz, its questions and the media
+// they show, which can be moved between deployments.
+type QuizDocument struct {
+	Quiz      *Quiz
+	Questions []*Question
+	Media     []MediaContent
+}
+
+// MediaContent is media along with its content.
+type MediaContent struct {
+	Media   *Media
+	Content []byte
+}
+
+// ToProto uses the IDs of the questions and media as their document keys.
+// Option IDs are left out, an imported quiz gets new ones.
+func (d *QuizDocument) ToProto() *transferv1.QuizDocument {
+	var tiebreakerQuestion string
+	if d.Quiz.TiebreakerQuestionID != nil {
+		tiebreakerQuestion = d.Quiz.TiebreakerQuestionID.String()
+	}
+
+	questions := make([]*transferv1.QuestionDocument, len(d.Questions))
+	for i, q := range d.Questions {
+		protoQuestion := q.ToProto()
+		for _, option := range protoQuestion.Options {
+			option.Id = ""
+		}
+
+		questions[i] = &transferv1.QuestionDocument{
+			Key:     protoQuestion.Id,
+			Body:    protoQuestion.Body,
+			Type:    protoQuestion.Type,
+			Options: protoQuestion.Options,
+			Route:   protoQuestion.Route,
+			Tags:    protoQuestion.Tags,
+			Image:   protoQuestion.ImageId,
+		}
+	}
+
+	media := make([]*transferv1.MediaDocument, len(d.Media))
+	for i, m := range d.Media {
+		media[i] = &transferv1.MediaDocument{
+			Key:         m.Media.ID.String(),
+			ContentType: m.Media.ContentType,
+			Data:        m.Content,
+		}
+	}
+
+	return &transferv1.QuizDocument{
+		FormatVersion:            QuizDocumentFormatVersion,
+		Title:                    d.Quiz.Title,
+		Results:                  slices.Clone(d.Quiz.Results),
+		ResultDetails:            ResultDetailsToProto(d.Quiz.ResultDetails),
+		TieBreakPolicy:           d.Quiz.TieBreakPolicy.ToProto(),
+		TieBreakSeed:             d.Quiz.TieBreakSeed,
+		TiebreakerQuestion:       tiebreakerQuestion,
+		ScoringModel:             d.Quiz.ScoringModel.ToProto(),
+		TraitAxes:                TraitAxesToProto(d.Quiz.TraitAxes),
+		ScoreThresholds:          slices.Clone(d.Quiz.ScoreThresholds),
+		TimeLimitSeconds:         d.Quiz.TimeLimitSeconds,
+		QuestionTimeLimitSeconds: d.Quiz.QuestionTimeLimitSeconds,
+		EarlyTermination:         d.Quiz.EarlyTermination,
+		QuestionDraw:             d.Quiz.QuestionDraw.ToProto(),
+		Questions:                questions,
+		Media:                    media,
+		Description:              d.Quiz.Description,
+		Tags:                     slices.Clone(d.Quiz.Tags),
+		Language:                 d.Quiz.Language,
+	}
+}
+
+// QuizDocumentToModel gives the quiz, its questions, options and media new IDs
+// and resolves the document keys to them.
+func QuizDocumentToModel(protoDocument *transferv1.QuizDocument) (*QuizDocument, error) {
+	if protoDocument.FormatVersion > QuizDocumentFormatVersion {
+		return nil, fmt.Errorf("unsupported document format version %d", protoDocument.FormatVersion)
+	}
+
+	keys := documentKeys{
+		questions: make(map[string]uuid.UUID, len(protoDocument.Questions)),
+		media:     make(map[string]uuid.UUID, len(protoDocument.Media)),
+	}
+
+	media := make([]MediaContent, len(protoDocument.Media))
+	for i, protoMedia := range protoDocument.Media {
+		if _, exists := keys.media[protoMedia.Key]; exists || protoMedia.Key == "" {
+			return nil, fmt.Errorf("media key '%s' is empty or declared more than once", protoMedia.Key)
+		}
+		keys.media[protoMedia.Key] = uuid.New()
+
+		media[i] = MediaContent{
+			Media:   &Media{ID: keys.media[protoMedia.Key], ContentType: protoMedia.ContentType},
+			Content: protoMedia.Data,
+		}
+	}
+
+	for _, protoQuestion := range protoDocument.Questions {
+		if _, exists := keys.questions[protoQuestion.Key]; exists || protoQuestion.Key == "" {
+			return nil, fmt.Errorf("question key '%s' is empty or declared more than once", protoQuestion.Key)
+		}
+		keys.questions[protoQuestion.Key] = uuid.New()
+	}
+
+	results, resultDetails, err := resultsToModel(protoDocument.Results, protoDocument.ResultDetails, keys.mediaID)
+	if err != nil {
+		return nil, err
+	}
+
+	quiz := &Quiz{
+		ID:                       uuid.New(),
+		Title:                    protoDocument.Title,
+		Results:                  results,
+		TieBreakPolicy:           TieBreakPolicyToModel(protoDocument.TieBreakPolicy),
+		TieBreakSeed:             protoDocument.TieBreakSeed,
+		ScoringModel:             ScoringModelToModel(protoDocument.ScoringModel),
+		TraitAxes:                TraitAxesToModel(protoDocument.TraitAxes),
+		ScoreThresholds:          slices.Clone(protoDocument.ScoreThresholds),
+		TimeLimitSeconds:         protoDocument.TimeLimitSeconds,
+		QuestionTimeLimitSeconds: protoDocument.QuestionTimeLimitSeconds,
+		EarlyTermination:         protoDocument.EarlyTermination,
+		QuestionDraw:             QuestionDrawToModel(protoDocument.QuestionDraw),
+		ResultDetails:            resultDetails,
+		Description:              protoDocument.Description,
+		Tags:                     NormalizeTags(protoDocument.Tags),
+		Language:                 NormalizeLanguage(protoDocument.Language),
+	}
+
+	if protoDocument.TiebreakerQuestion != "" {
+		tiebreakerID, err := keys.questionID(protoDocument.TiebreakerQuestion)
+		if err != nil {
+			return nil, err
+		}
+		quiz.TiebreakerQuestionID = &tiebreakerID
+	}
+
+	questions := make([]*Question, len(protoDocument.Questions))
+	for i, protoQuestion := range protoDocument.Questions {
+		questions[i], err = keys.question(quiz.ID, int32(i), protoQuestion)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &QuizDocument{
+		Quiz:      quiz,
+		Questions: questions,
+		Media:     media,
+	}, nil
+}
+
+type documentKeys struct {
+	questions map[string]uuid.UUID
+	media     map[string]uuid.UUID
+}
+
+func (k documentKeys) questionID(key string) (uuid.UUID, error) {
+	id, exists := k.questions[key]
+	if !exists {
+		return uuid.Nil, fmt.Errorf("unknown question key '%s'", key)
+	}
+	return id, nil
+}
+
+// mediaID returns nil for an empty key, meaning there is no media.
+func (k documentKeys) mediaID(key string) (*uuid.UUID, error) {
+	if key == "" {
+		return nil, nil
+	}
+
+	id, exists := k.media[key]
+	if !exists {
+		return nil, fmt.Errorf("unknown media key '%s'", key)
+	}
+	return &id, nil
+}
+
+func (k documentKeys) question(quizID uuid.UUID, position int32, protoQuestion *transferv1.QuestionDocument) (*Question, error) {
+	route, err := routeToModel(protoQuestion.Route, k.questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	imageID, err := k.mediaID(protoQuestion.Image)
+	if err != nil {
+		return nil, err
+	}
+
+	options, err := k.options(protoQuestion.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Question{
+		ID:       k.questions[protoQuestion.Key],
+		QuizID:   quizID,
+		Body:     protoQuestion.Body,
+		Options:  options,
+		Type:     QuestionTypeToModel(protoQuestion.Type),
+		Position: position,
+		Route:    route,
+		Tags:     slices.Clone(protoQuestion.Tags),
+		ImageID:  imageID,
+	}, nil
+}
+
+func (k documentKeys) options(protoOptions []*questionv1.Option) ([]Option, error) {
+	options := make([]Option, len(protoOptions))
+	for i, protoOption := range protoOptions {
+		route, err := routeToModel(protoOption.Route, k.questionID)
+		if err != nil {
+			return nil, err
+		}
+
+		imageID, err := k.mediaID(protoOption.ImageId)
+		if err != nil {
+			return nil, err
+		}
+
+		options[i] = Option{
+			ID:      uuid.New(),
+			Text:    protoOption.Text,
+			Weights: slices.Clone(protoOption.Weights),
+			Route:   route,
+			ImageID: imageID,
+		}
+	}
+
+	return options, nil
+}
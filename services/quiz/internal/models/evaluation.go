@@ -0,0 +1,132 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	historyv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/history/v1"
+	questionv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/question/v1"
+)
+
+type ResultScore struct {
+	Result     string  `json:"result"`
+	Total      float32 `json:"total"`
+	Percentage float32 `json:"percentage"`
+	Rank       int32   `json:"rank"`
+}
+
+type TraitScore struct {
+	TraitAxis
+	Score float32 `json:"score"`
+	Pole  string  `json:"pole"`
+}
+
+type Evaluation struct {
+	Result         string         `json:"result"`
+	Scores         []ResultScore  `json:"scores"`
+	TiedResults    []string       `json:"tied_results"`
+	TieBreakPolicy TieBreakPolicy `json:"tie_break_policy"`
+	ScoringModel   ScoringModel   `json:"scoring_model"`
+	Score          float32        `json:"score"`
+	MaxScore       float32        `json:"max_score"`
+	CorrectAnswers int32          `json:"correct_answers"`
+	Traits         []TraitScore   `json:"traits"`
+	ResultDetail   *ResultDetail  `json:"result_detail,omitempty"`
+}
+
+// NewEvaluation builds the score breakdown for the given per-result totals.
+// Negative totals count as zero towards the percentages, and tied totals
+// share the same rank.
+func NewEvaluation(results []string, totals []float32, winner int) *Evaluation {
+	var positiveSum float32
+	for _, total := range totals {
+		if total > 0 {
+			positiveSum += total
+		}
+	}
+
+	scores := make([]ResultScore, len(results))
+	for i, result := range results {
+		var percentage float32
+		if positiveSum > 0 && totals[i] > 0 {
+			percentage = totals[i] / positiveSum * 100
+		}
+
+		rank := int32(1)
+		for _, other := range totals {
+			if other > totals[i] {
+				rank++
+			}
+		}
+
+		scores[i] = ResultScore{
+			Result:     result,
+			Total:      totals[i],
+			Percentage: percentage,
+			Rank:       rank,
+		}
+	}
+
+	return &Evaluation{
+		Result: results[winner],
+		Scores: scores,
+	}
+}
+
+func (e *Evaluation) ToProto() *questionv1.EvaluateAnswersResponse {
+	scores := make([]*questionv1.ResultScore, len(e.Scores))
+	for i, score := range e.Scores {
+		scores[i] = &questionv1.ResultScore{
+			Result:     score.Result,
+			Total:      score.Total,
+			Percentage: score.Percentage,
+			Rank:       score.Rank,
+		}
+	}
+
+	traits := make([]*questionv1.TraitScore, len(e.Traits))
+	for i, trait := range e.Traits {
+		traits[i] = &questionv1.TraitScore{
+			Positive: trait.Positive,
+			Negative: trait.Negative,
+			Score:    trait.Score,
+			Pole:     trait.Pole,
+		}
+	}
+
+	return &questionv1.EvaluateAnswersResponse{
+		Result:         e.Result,
+		Scores:         scores,
+		TiedResults:    e.TiedResults,
+		TieBreakPolicy: e.TieBreakPolicy.ToProto(),
+		ScoringModel:   e.ScoringModel.ToProto(),
+		Score:          e.Score,
+		MaxScore:       e.MaxScore,
+		CorrectAnswers: e.CorrectAnswers,
+		Traits:         traits,
+		ResultDetail:   e.ResultDetail.ToProto(),
+	}
+}
+
+// ToHistoryItem builds the quiz completion history item recorded for the evaluation.
+func (e *Evaluation) ToHistoryItem(userID, quizID, quizVersionID uuid.UUID) *historyv1.QuizCompletionHistoryItem {
+	return &historyv1.QuizCompletionHistoryItem{
+		UserId:           userID.String(),
+		QuizId:           quizID.String(),
+		QuizVersionId:    quizVersionID.String(),
+		QuizResult:       e.Result,
+		QuizResultScores: e.ToHistoryProto(),
+	}
+}
+
+func (e *Evaluation) ToHistoryProto() []*historyv1.QuizResultScore {
+	scores := make([]*historyv1.QuizResultScore, len(e.Scores))
+	for i, score := range e.Scores {
+		scores[i] = &historyv1.QuizResultScore{
+			Result:     score.Result,
+			Total:      score.Total,
+			Percentage: score.Percentage,
+			Rank:       score.Rank,
+		}
+	}
+
+	return scores
+}
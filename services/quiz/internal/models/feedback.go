@@ -0,0 +1,159 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	feedbackv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/feedback/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Rating is the score from 1 to 5 stars a user gives a quiz. A user rates a
+// quiz once and may change the rating later.
+type Rating struct {
+	QuizID    uuid.UUID `json:"quiz_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	Stars     int32     `json:"stars"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// QuizFeedback sums up the ratings, likes and visible comments of a quiz
+// along with the rating and like of the user asking.
+type QuizFeedback struct {
+	QuizID        uuid.UUID `json:"quiz_id"`
+	RatingAverage float32   `json:"rating_average"`
+	RatingCount   int64     `json:"rating_count"`
+	LikeCount     int64     `json:"like_count"`
+	CommentCount  int64     `json:"comment_count"`
+	MyRating      int32     `json:"my_rating"`
+	Liked         bool      `json:"liked"`
+}
+
+type CommentStatus string
+
+const (
+	CommentStatusVisible CommentStatus = "visible"
+	CommentStatusDeleted CommentStatus = "deleted"
+	CommentStatusHidden  CommentStatus = "hidden"
+)
+
+type ModerationAction string
+
+const (
+	ModerationActionHide    ModerationAction = "hide"
+	ModerationActionRestore ModerationAction = "restore"
+)
+
+// Comment is a comment on a quiz or a reply to another comment of the same
+// quiz. Deleted and hidden comments are kept so that their replies stay in
+// place, only their bodies are not shown.
+type Comment struct {
+	ID           uuid.UUID  `json:"id"`
+	QuizID       uuid.UUID  `json:"quiz_id"`
+	ParentID     *uuid.UUID `json:"parent_id"`
+	AuthorID     uuid.UUID  `json:"author_id"`
+	Body         string     `json:"body"`
+	ReplyCount   int64      `json:"reply_count"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+	DeletedAt    *time.Time `json:"deleted_at"`
+	HiddenAt     *time.Time `json:"hidden_at"`
+	HiddenBy     *uuid.UUID `json:"hidden_by"`
+	HiddenReason string     `json:"hidden_reason"`
+}
+
+// Status is hidden for comments hidden by a moderator, even if their authors
+// deleted them too.
+func (c *Comment) Status() CommentStatus {
+	switch {
+	case c.HiddenAt != nil:
+		return CommentStatusHidden
+	case c.DeletedAt != nil:
+		return CommentStatusDeleted
+	default:
+		return CommentStatusVisible
+	}
+}
+
+// Redacted returns the comment as shown to users other than moderators: the
+// body of a deleted or hidden comment and the reason it was hidden are left
+// out.
+func (c *Comment) Redacted() *Comment {
+	if c.Status() == CommentStatusVisible {
+		return c
+	}
+
+	redacted := *c
+	redacted.Body = ""
+	redacted.HiddenReason = ""
+	return &redacted
+}
+
+func (r *Rating) ToProto() *feedbackv1.Rating {
+	return &feedbackv1.Rating{
+		QuizId:    r.QuizID.String(),
+		UserId:    r.UserID.String(),
+		Stars:     r.Stars,
+		CreatedAt: timestamppb.New(r.CreatedAt),
+		UpdatedAt: timestamppb.New(r.UpdatedAt),
+	}
+}
+
+func (f *QuizFeedback) ToProto() *feedbackv1.QuizFeedback {
+	return &feedbackv1.QuizFeedback{
+		QuizId:        f.QuizID.String(),
+		RatingAverage: f.RatingAverage,
+		RatingCount:   f.RatingCount,
+		LikeCount:     f.LikeCount,
+		CommentCount:  f.CommentCount,
+		MyRating:      f.MyRating,
+		Liked:         f.Liked,
+	}
+}
+
+func (c *Comment) ToProto() *feedbackv1.Comment {
+	var parentID string
+	if c.ParentID != nil {
+		parentID = c.ParentID.String()
+	}
+
+	return &feedbackv1.Comment{
+		Id:           c.ID.String(),
+		QuizId:       c.QuizID.String(),
+		ParentId:     parentID,
+		AuthorId:     c.AuthorID.String(),
+		Body:         c.Body,
+		Status:       c.Status().ToProto(),
+		ReplyCount:   c.ReplyCount,
+		CreatedAt:    timestamppb.New(c.CreatedAt),
+		UpdatedAt:    timestamppb.New(c.UpdatedAt),
+		HiddenReason: c.HiddenReason,
+	}
+}
+
+func (s CommentStatus) ToProto() feedbackv1.CommentStatus {
+	switch s {
+	case CommentStatusVisible:
+		return feedbackv1.CommentStatus_COMMENT_STATUS_VISIBLE
+	case CommentStatusDeleted:
+		return feedbackv1.CommentStatus_COMMENT_STATUS_DELETED
+	case CommentStatusHidden:
+		return feedbackv1.CommentStatus_COMMENT_STATUS_HIDDEN
+	default:
+		return feedbackv1.CommentStatus_COMMENT_STATUS_UNSPECIFIED
+	}
+}
+
+// ModerationActionToModel returns an empty action for
+// MODERATION_ACTION_UNSPECIFIED.
+func ModerationActionToModel(a feedbackv1.ModerationAction) ModerationAction {
+	switch a {
+	case feedbackv1.ModerationAction_MODERATION_ACTION_HIDE:
+		return ModerationActionHide
+	case feedbackv1.ModerationAction_MODERATION_ACTION_RESTORE:
+		return ModerationActionRestore
+	default:
+		return ""
+	}
+}
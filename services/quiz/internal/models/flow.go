@@ -0,0 +1,200 @@
+package models
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// Flow is the graph of questions a quiz is taken along. A question leads to
+// the question its chosen option routes to, then to the question its own
+// route points at, and otherwise to the next question by position.
+type Flow struct {
+	questions []*Question
+	index     map[uuid.UUID]int
+}
+
+func NewFlow(questions []*Question) *Flow {
+	ordered := slices.Clone(questions)
+	slices.SortStableFunc(ordered, func(a, b *Question) int {
+		return cmp.Compare(a.Position, b.Position)
+	})
+
+	index := make(map[uuid.UUID]int, len(ordered))
+	for i, q := range ordered {
+		index[q.ID] = i
+	}
+
+	return &Flow{questions: ordered, index: index}
+}
+
+// Questions returns the questions of the flow by position.
+func (f *Flow) Questions() []*Question {
+	return f.questions
+}
+
+// Start returns the first question of the flow, or nil when there are no questions.
+func (f *Flow) Start() *Question {
+	if len(f.questions) == 0 {
+		return nil
+	}
+	return f.questions[0]
+}
+
+func (f *Flow) Question(id uuid.UUID) (*Question, bool) {
+	i, exists := f.index[id]
+	if !exists {
+		return nil, false
+	}
+	return f.questions[i], true
+}
+
+// Next returns the question that follows q when it is answered with the given
+// answer, or nil when the quiz ends there. Only single choice options route.
+func (f *Flow) Next(q *Question, answer *Answer) *Question {
+	if answer != nil && (q.Type == "" || q.Type == QuestionTypeSingleChoice) {
+		var option *Option
+		var exists bool
+		if answer.OptionID != uuid.Nil {
+			option, exists = q.OptionByID(answer.OptionID)
+		} else {
+			option, exists = q.OptionByText(answer.Body)
+		}
+		if exists {
+			if next, ok := f.follow(option.Route); ok {
+				return next
+			}
+		}
+	}
+
+	return f.defaultNext(q)
+}
+
+func (f *Flow) defaultNext(q *Question) *Question {
+	if next, ok := f.follow(q.Route); ok {
+		return next
+	}
+
+	i := f.index[q.ID] + 1
+	if i >= len(f.questions) {
+		return nil
+	}
+	return f.questions[i]
+}
+
+// follow resolves a route. Routes to questions outside of the flow are ignored
+// here and rejected by Validate.
+func (f *Flow) follow(route *Route) (*Question, bool) {
+	if route == nil {
+		return nil, false
+	}
+	if route.End {
+		return nil, true
+	}
+	if route.NextQuestionID == nil {
+		return nil, false
+	}
+	return f.Question(*route.NextQuestionID)
+}
+
+// successors returns every question that may follow q.
+func (f *Flow) successors(q *Question) []*Question {
+	var successors []*Question
+	add := func(next *Question) {
+		if next != nil && !slices.Contains(successors, next) {
+			successors = append(successors, next)
+		}
+	}
+
+	routed := 0
+	if q.Type == "" || q.Type == QuestionTypeSingleChoice {
+		for _, option := range q.Options {
+			if next, ok := f.follow(option.Route); ok {
+				add(next)
+				routed++
+			}
+		}
+	}
+
+	if routed < len(q.Options) || len(q.Options) == 0 {
+		add(f.defaultNext(q))
+	}
+
+	return successors
+}
+
+// Reachable returns the questions that may be asked starting from q, q included.
+func (f *Flow) Reachable(q *Question) []*Question {
+	reachable := []*Question{q}
+	for i := 0; i < len(reachable); i++ {
+		for _, next := range f.successors(reachable[i]) {
+			if !slices.Contains(reachable, next) {
+				reachable = append(reachable, next)
+			}
+		}
+	}
+	return reachable
+}
+
+// Validate checks that every route points at a question of the quiz, that the
+// flow has no cycles and that every question can be reached from the start.
+func (f *Flow) Validate() error {
+	for _, q := range f.questions {
+		routes := []*Route{q.Route}
+		for _, option := range q.Options {
+			routes = append(routes, option.Route)
+		}
+
+		for _, route := range routes {
+			if route == nil || route.NextQuestionID == nil {
+				continue
+			}
+			if _, exists := f.Question(*route.NextQuestionID); !exists {
+				return fmt.Errorf("question %s routes to unknown question %s", q.ID, *route.NextQuestionID)
+			}
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make(map[uuid.UUID]int, len(f.questions))
+	var visit func(q *Question) error
+	visit = func(q *Question) error {
+		state[q.ID] = visiting
+		for _, next := range f.successors(q) {
+			switch state[next.ID] {
+			case visiting:
+				return fmt.Errorf("question %s leads back to question %s", q.ID, next.ID)
+			case unvisited:
+				if err := visit(next); err != nil {
+					return err
+				}
+			}
+		}
+		state[q.ID] = visited
+		return nil
+	}
+
+	start := f.Start()
+	if start == nil {
+		return nil
+	}
+
+	if err := visit(start); err != nil {
+		return err
+	}
+
+	for _, q := range f.questions {
+		if state[q.ID] != visited {
+			return fmt.Errorf("question %s cannot be reached", q.ID)
+		}
+	}
+
+	return nil
+}
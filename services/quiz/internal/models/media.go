@@ -0,0 +1,57 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	mediav1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/media/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Media is an uploaded file that questions, options and results refer to by
+// ID. Its content lives in the blob store under the media ID.
+type Media struct {
+	ID          uuid.UUID `json:"id"`
+	OwnerID     uuid.UUID `json:"owner_id"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// URL returns the gateway path the content of the media is served at.
+func (m *Media) URL() string {
+	return fmt.Sprintf("/api/v1/media/%s/content", m.ID)
+}
+
+func (m *Media) ToProto() *mediav1.Media {
+	return &mediav1.Media{
+		Id:          m.ID.String(),
+		OwnerId:     m.OwnerID.String(),
+		ContentType: m.ContentType,
+		Size:        m.Size,
+		Url:         m.URL(),
+		CreatedAt:   timestamppb.New(m.CreatedAt),
+	}
+}
+
+// mediaIDToModel returns nil for an empty media ID, meaning there is no media.
+func mediaIDToModel(id string) (*uuid.UUID, error) {
+	if id == "" {
+		return nil, nil
+	}
+
+	mediaID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse media ID '%s': %w", id, err)
+	}
+
+	return &mediaID, nil
+}
+
+func mediaIDToProto(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
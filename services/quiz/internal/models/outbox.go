@@ -0,0 +1,64 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	historyv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/history/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// MaxIdempotencyKeyLength is the longest idempotency key a client can send.
+const MaxIdempotencyKeyLength = 128
+
+// OutboxMessage is a quiz completion waiting to be recorded in the history
+// service. IdempotencyKey identifies the completion, so delivering the message
+// more than once records it once.
+type OutboxMessage struct {
+	IdempotencyKey string
+	Item           *historyv1.QuizCompletionHistoryItem
+	AttemptCount   int32
+	LastError      string
+	CreatedAt      time.Time
+	NextAttemptAt  time.Time
+}
+
+// AttemptIdempotencyKey is the idempotency key of the completion of an attempt.
+func AttemptIdempotencyKey(attemptID uuid.UUID) string {
+	return "attempt:" + attemptID.String()
+}
+
+// EvaluationIdempotencyKey is the idempotency key of answers evaluated for the
+// taker with the key given by the client, so that retrying the evaluation
+// records it once. Old clients send no key, and every evaluation of theirs is
+// a completion of its own.
+func EvaluationIdempotencyKey(takerID uuid.UUID, key string) string {
+	if key == "" {
+		key = uuid.NewString()
+	}
+	return "evaluation:" + takerID.String() + ":" + key
+}
+
+func (m *OutboxMessage) MarshalItem() ([]byte, error) {
+	data, err := protojson.Marshal(m.Item)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal history item: %w", err)
+	}
+	return data, nil
+}
+
+func (m *OutboxMessage) UnmarshalItem(data []byte) error {
+	m.Item = new(historyv1.QuizCompletionHistoryItem)
+	if err := protojson.Unmarshal(data, m.Item); err != nil {
+		return fmt.Errorf("failed to unmarshal history item: %w", err)
+	}
+	return nil
+}
+
+func (m *OutboxMessage) ToProto() *historyv1.CreateItemRequest {
+	return &historyv1.CreateItemRequest{
+		Item:           m.Item,
+		IdempotencyKey: m.IdempotencyKey,
+	}
+}
@@ -1,26 +1,55 @@
 package models
 
 import (
+	"cmp"
 	"fmt"
+	"math/rand/v2"
+	"slices"
 
 	"github.com/google/uuid"
 	questionv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/question/v1"
 )
 
+type QuestionType string
+
+const (
+	QuestionTypeSingleChoice QuestionType = "single_choice"
+	QuestionTypeMultiSelect  QuestionType = "multi_select"
+	QuestionTypeLikert       QuestionType = "likert"
+	QuestionTypeNumeric      QuestionType = "numeric"
+	QuestionTypeRanking      QuestionType = "ranking"
+)
+
+// Route sends the flow of a quiz to another question of the quiz or ends it.
+type Route struct {
+	NextQuestionID *uuid.UUID `json:"next_question_id,omitempty"`
+	End            bool       `json:"end,omitempty"`
+}
+
+type Option struct {
+	ID      uuid.UUID  `json:"id"`
+	Text    string     `json:"text"`
+	Weights []float32  `json:"weights"`
+	Route   *Route     `json:"route,omitempty"`
+	ImageID *uuid.UUID `json:"image_id,omitempty"`
+}
+
 type Question struct {
-	ID             uuid.UUID            `json:"id"`
-	QuizID         uuid.UUID            `json:"quiz_id"`
-	Body           string               `json:"body"`
-	OptionsWeights map[string][]float32 `json:"options_weights"`
+	ID       uuid.UUID    `json:"id"`
+	QuizID   uuid.UUID    `json:"quiz_id"`
+	Body     string       `json:"body"`
+	Options  []Option     `json:"options"`
+	Type     QuestionType `json:"type"`
+	Position int32        `json:"position"`
+	Route    *Route       `json:"route,omitempty"`
+	Tags     []string     `json:"tags,omitempty"`
+	ImageID  *uuid.UUID   `json:"image_id,omitempty"`
 }
 
 func QuestionToModel(protoQuestion *questionv1.CreateQuestionRequest) (*Question, error) {
-	optionsWeights := make(map[string][]float32)
-
-	for option, protoWeights := range protoQuestion.OptionsWeights {
-		weights := make([]float32, len(protoWeights.Weights))
-		copy(weights, protoWeights.Weights)
-		optionsWeights[option] = weights
+	options, err := optionsToModel(protoQuestion.Options, protoQuestion.OptionsWeights)
+	if err != nil {
+		return nil, err
 	}
 
 	var quizID uuid.UUID
@@ -34,22 +63,232 @@ func QuestionToModel(protoQuestion *questionv1.CreateQuestionRequest) (*Question
 		quizID = parsedID
 	}
 
+	route, err := RouteToModel(protoQuestion.Route)
+	if err != nil {
+		return nil, err
+	}
+
+	imageID, err := mediaIDToModel(protoQuestion.ImageId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Question{
+		QuizID:  quizID,
+		Body:    protoQuestion.Body,
+		Options: options,
+		Type:    QuestionTypeToModel(protoQuestion.Type),
+		Route:   route,
+		Tags:    slices.Clone(protoQuestion.Tags),
+		ImageID: imageID,
+	}, nil
+}
+
+func UpdateQuestionToModel(protoQuestion *questionv1.UpdateQuestionRequest) (*Question, error) {
+	questionID, err := uuid.Parse(protoQuestion.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse question ID '%s': %w", protoQuestion.Id, err)
+	}
+
+	quizID, err := uuid.Parse(protoQuestion.QuizId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse quiz ID '%s': %w", protoQuestion.QuizId, err)
+	}
+
+	options, err := optionsToModel(protoQuestion.Options, protoQuestion.OptionsWeights)
+	if err != nil {
+		return nil, err
+	}
+
+	route, err := RouteToModel(protoQuestion.Route)
+	if err != nil {
+		return nil, err
+	}
+
+	imageID, err := mediaIDToModel(protoQuestion.ImageId)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Question{
-		QuizID:         quizID,
-		Body:           protoQuestion.Body,
-		OptionsWeights: optionsWeights,
+		ID:      questionID,
+		QuizID:  quizID,
+		Body:    protoQuestion.Body,
+		Options: options,
+		Type:    QuestionTypeToModel(protoQuestion.Type),
+		Route:   route,
+		Tags:    slices.Clone(protoQuestion.Tags),
+		ImageID: imageID,
 	}, nil
 }
 
+// optionsToModel keeps the order of the given options and falls back to the
+// deprecated options_weights map, ordered by option text, when there are none.
+func optionsToModel(protoOptions []*questionv1.Option, protoOptionsWeights map[string]*questionv1.OptionWeights) ([]Option, error) {
+	if len(protoOptions) == 0 {
+		optionsWeights := make(map[string][]float32, len(protoOptionsWeights))
+		for option, protoWeights := range protoOptionsWeights {
+			optionsWeights[option] = protoWeights.GetWeights()
+		}
+		return OptionsFromWeights(optionsWeights), nil
+	}
+
+	options := make([]Option, len(protoOptions))
+	for i, protoOption := range protoOptions {
+		var optionID uuid.UUID
+		if protoOption.Id != "" {
+			parsedID, err := uuid.Parse(protoOption.Id)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse option ID '%s': %w", protoOption.Id, err)
+			}
+			optionID = parsedID
+		}
+
+		route, err := RouteToModel(protoOption.Route)
+		if err != nil {
+			return nil, err
+		}
+
+		imageID, err := mediaIDToModel(protoOption.ImageId)
+		if err != nil {
+			return nil, err
+		}
+
+		options[i] = Option{
+			ID:      optionID,
+			Text:    protoOption.Text,
+			Weights: slices.Clone(protoOption.Weights),
+			Route:   route,
+			ImageID: imageID,
+		}
+	}
+
+	return options, nil
+}
+
+// RouteToModel returns nil for a missing or empty route, which means the flow
+// continues with the next question by position.
+func RouteToModel(protoRoute *questionv1.Route) (*Route, error) {
+	return routeToModel(protoRoute, func(id string) (uuid.UUID, error) {
+		nextQuestionID, err := uuid.Parse(id)
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("failed to parse next question ID '%s': %w", id, err)
+		}
+		return nextQuestionID, nil
+	})
+}
+
+// routeToModel resolves the next question of the route with questionID.
+func routeToModel(protoRoute *questionv1.Route, questionID func(string) (uuid.UUID, error)) (*Route, error) {
+	if protoRoute == nil || protoRoute.NextQuestionId == "" && !protoRoute.End {
+		return nil, nil
+	}
+
+	route := &Route{End: protoRoute.End}
+	if protoRoute.NextQuestionId != "" {
+		nextQuestionID, err := questionID(protoRoute.NextQuestionId)
+		if err != nil {
+			return nil, err
+		}
+		route.NextQuestionID = &nextQuestionID
+	}
+
+	return route, nil
+}
+
+func (r *Route) ToProto() *questionv1.Route {
+	if r == nil {
+		return nil
+	}
+
+	var nextQuestionID string
+	if r.NextQuestionID != nil {
+		nextQuestionID = r.NextQuestionID.String()
+	}
+
+	return &questionv1.Route{
+		NextQuestionId: nextQuestionID,
+		End:            r.End,
+	}
+}
+
+// OptionsFromWeights converts an option text to weights map into options ordered by text.
+func OptionsFromWeights(optionsWeights map[string][]float32) []Option {
+	options := make([]Option, 0, len(optionsWeights))
+	for text, weights := range optionsWeights {
+		options = append(options, Option{Text: text, Weights: slices.Clone(weights)})
+	}
+
+	slices.SortFunc(options, func(a, b Option) int {
+		return cmp.Compare(a.Text, b.Text)
+	})
+
+	return options
+}
+
+// MediaIDs returns the IDs of the media the question and its options show.
+func (q *Question) MediaIDs() []uuid.UUID {
+	var ids []uuid.UUID
+	if q.ImageID != nil {
+		ids = append(ids, *q.ImageID)
+	}
+	for _, option := range q.Options {
+		if option.ImageID != nil {
+			ids = append(ids, *option.ImageID)
+		}
+	}
+	return ids
+}
+
+func (q *Question) OptionByID(id uuid.UUID) (*Option, bool) {
+	for i := range q.Options {
+		if q.Options[i].ID == id {
+			return &q.Options[i], true
+		}
+	}
+	return nil, false
+}
+
+func (q *Question) OptionByText(text string) (*Option, bool) {
+	for i := range q.Options {
+		if q.Options[i].Text == text {
+			return &q.Options[i], true
+		}
+	}
+	return nil, false
+}
+
+// WithShuffledOptions returns a copy of the question with its options in random
+// order. Likert and numeric options describe a scale and keep their order.
+func (q *Question) WithShuffledOptions() *Question {
+	if q.Type == QuestionTypeLikert || q.Type == QuestionTypeNumeric {
+		return q
+	}
+
+	shuffled := *q
+	shuffled.Options = slices.Clone(q.Options)
+	rand.Shuffle(len(shuffled.Options), func(i, j int) {
+		shuffled.Options[i], shuffled.Options[j] = shuffled.Options[j], shuffled.Options[i]
+	})
+
+	return &shuffled
+}
+
 func (q *Question) ToProto() *questionv1.Question {
 	protoOptionsWeights := make(map[string]*questionv1.OptionWeights)
+	protoOptions := make([]*questionv1.Option, len(q.Options))
 
-	for option, weights := range q.OptionsWeights {
-		protoWeights := &questionv1.OptionWeights{
-			Weights: make([]float32, len(weights)),
+	for i, option := range q.Options {
+		protoOptionsWeights[option.Text] = &questionv1.OptionWeights{
+			Weights: slices.Clone(option.Weights),
+		}
+		protoOptions[i] = &questionv1.Option{
+			Id:      option.ID.String(),
+			Text:    option.Text,
+			Weights: slices.Clone(option.Weights),
+			Route:   option.Route.ToProto(),
+			ImageId: mediaIDToProto(option.ImageID),
 		}
-		copy(protoWeights.Weights, weights)
-		protoOptionsWeights[option] = protoWeights
 	}
 
 	return &questionv1.Question{
@@ -57,19 +296,66 @@ func (q *Question) ToProto() *questionv1.Question {
 		QuizId:         q.QuizID.String(),
 		Body:           q.Body,
 		OptionsWeights: protoOptionsWeights,
+		Type:           q.Type.ToProto(),
+		Options:        protoOptions,
+		Position:       q.Position,
+		Route:          q.Route.ToProto(),
+		Tags:           slices.Clone(q.Tags),
+		ImageId:        mediaIDToProto(q.ImageID),
 	}
 }
 
 func (q *Question) ToProtoWithoutWeights() *questionv1.QuestionResponse {
-	var options []string
-	for option := range q.OptionsWeights {
-		options = append(options, option)
+	options := make([]string, len(q.Options))
+	choices := make([]*questionv1.QuestionOption, len(q.Options))
+
+	for i, option := range q.Options {
+		options[i] = option.Text
+		choices[i] = &questionv1.QuestionOption{
+			Id:      option.ID.String(),
+			Text:    option.Text,
+			ImageId: mediaIDToProto(option.ImageID),
+		}
 	}
 
 	return &questionv1.QuestionResponse{
-		Id:      q.ID.String(),
-		QuizId:  q.QuizID.String(),
-		Body:    q.Body,
-		Options: options,
+		Id:       q.ID.String(),
+		QuizId:   q.QuizID.String(),
+		Body:     q.Body,
+		Options:  options,
+		Type:     q.Type.ToProto(),
+		Choices:  choices,
+		Position: q.Position,
+		ImageId:  mediaIDToProto(q.ImageID),
+	}
+}
+
+func (t QuestionType) ToProto() questionv1.QuestionType {
+	switch t {
+	case QuestionTypeMultiSelect:
+		return questionv1.QuestionType_QUESTION_TYPE_MULTI_SELECT
+	case QuestionTypeLikert:
+		return questionv1.QuestionType_QUESTION_TYPE_LIKERT
+	case QuestionTypeNumeric:
+		return questionv1.QuestionType_QUESTION_TYPE_NUMERIC
+	case QuestionTypeRanking:
+		return questionv1.QuestionType_QUESTION_TYPE_RANKING
+	default:
+		return questionv1.QuestionType_QUESTION_TYPE_SINGLE_CHOICE
+	}
+}
+
+func QuestionTypeToModel(t questionv1.QuestionType) QuestionType {
+	switch t {
+	case questionv1.QuestionType_QUESTION_TYPE_MULTI_SELECT:
+		return QuestionTypeMultiSelect
+	case questionv1.QuestionType_QUESTION_TYPE_LIKERT:
+		return QuestionTypeLikert
+	case questionv1.QuestionType_QUESTION_TYPE_NUMERIC:
+		return QuestionTypeNumeric
+	case questionv1.QuestionType_QUESTION_TYPE_RANKING:
+		return QuestionTypeRanking
+	default:
+		return QuestionTypeSingleChoice
 	}
 }
@@ -1,20 +1,398 @@
 package models
 
 import (
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
 	"github.com/google/uuid"
 	quizv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/quiz/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type QuizStatus string
+
+const (
+	QuizStatusDraft     QuizStatus = "draft"
+	QuizStatusPublished QuizStatus = "published"
+	QuizStatusArchived  QuizStatus = "archived"
+)
+
+// QuizSortOrder is the order quizzes are listed in.
+type QuizSortOrder string
+
+const (
+	QuizSortNewest        QuizSortOrder = "newest"
+	QuizSortMostCompleted QuizSortOrder = "most_completed"
+	QuizSortTitle         QuizSortOrder = "title"
+	QuizSortTopRated      QuizSortOrder = "top_rated"
+	QuizSortMostLiked     QuizSortOrder = "most_liked"
+)
+
+type TieBreakPolicy string
+
+const (
+	TieBreakFirstDeclared      TieBreakPolicy = "first_declared"
+	TieBreakRandom             TieBreakPolicy = "random"
+	TieBreakReportAll          TieBreakPolicy = "report_all"
+	TieBreakTiebreakerQuestion TieBreakPolicy = "tiebreaker_question"
+)
+
+type ScoringModel string
+
+const (
+	ScoringWeightedSum ScoringModel = "weighted_sum"
+	ScoringKnowledge   ScoringModel = "knowledge"
+	ScoringTraits      ScoringModel = "traits"
+	ScoringBands       ScoringModel = "bands"
 )
 
+type TraitAxis struct {
+	Positive string `json:"positive"`
+	Negative string `json:"negative"`
+}
+
 type Quiz struct {
-	ID      uuid.UUID `json:"id"`
-	Title   string    `json:"title"`
-	Results []string  `json:"results"`
+	ID                       uuid.UUID      `json:"id"`
+	Title                    string         `json:"title"`
+	Results                  []string       `json:"results"`
+	AuthorID                 uuid.UUID      `json:"author_id"`
+	Status                   QuizStatus     `json:"status"`
+	TieBreakPolicy           TieBreakPolicy `json:"tie_break_policy"`
+	TieBreakSeed             int64          `json:"tie_break_seed"`
+	TiebreakerQuestionID     *uuid.UUID     `json:"tiebreaker_question_id"`
+	ScoringModel             ScoringModel   `json:"scoring_model"`
+	TraitAxes                []TraitAxis    `json:"trait_axes"`
+	ScoreThresholds          []float32      `json:"score_thresholds"`
+	TimeLimitSeconds         int32          `json:"time_limit_seconds"`
+	QuestionTimeLimitSeconds int32          `json:"question_time_limit_seconds"`
+	EarlyTermination         bool           `json:"early_termination"`
+	QuestionDraw             *QuestionDraw  `json:"question_draw"`
+	ResultDetails            []ResultDetail `json:"result_details"`
+	Description              string         `json:"description"`
+	Tags                     []string       `json:"tags"`
+	Language                 string         `json:"language"`
+	CreatedAt                time.Time      `json:"created_at"`
+	CompletionCount          int64          `json:"completion_count"`
+	CategoryIDs              []uuid.UUID    `json:"category_ids"`
+	DisplayLanguage          string         `json:"display_language,omitempty"`
+	AvailableLanguages       []string       `json:"available_languages,omitempty"`
+	RatingAverage            float32        `json:"rating_average"`
+	RatingCount              int64          `json:"rating_count"`
+	LikeCount                int64          `json:"like_count"`
+}
+
+// ResultDetail describes a result of the quiz to the user who gets it. Title
+// is the result as listed in the results of the quiz.
+type ResultDetail struct {
+	Title       string     `json:"title"`
+	Description string     `json:"description,omitempty"`
+	ImageID     *uuid.UUID `json:"image_id,omitempty"`
+}
+
+// QuestionDraw makes every attempt draw a random subset of the questions:
+// PerTag[tag] questions with each tag and then more questions of any tag up to
+// Count in total.
+type QuestionDraw struct {
+	Count  int32            `json:"count"`
+	PerTag map[string]int32 `json:"per_tag,omitempty"`
 }
 
 func (q *Quiz) ToProto() *quizv1.Quiz {
+	var tiebreakerQuestionID string
+	if q.TiebreakerQuestionID != nil {
+		tiebreakerQuestionID = q.TiebreakerQuestionID.String()
+	}
+
 	return &quizv1.Quiz{
-		Id:      q.ID.String(),
-		Title:   q.Title,
-		Results: q.Results,
+		Id:                       q.ID.String(),
+		Title:                    q.Title,
+		Results:                  q.Results,
+		AuthorId:                 q.AuthorID.String(),
+		Status:                   q.Status.ToProto(),
+		TieBreakPolicy:           q.TieBreakPolicy.ToProto(),
+		TieBreakSeed:             q.TieBreakSeed,
+		TiebreakerQuestionId:     tiebreakerQuestionID,
+		ScoringModel:             q.ScoringModel.ToProto(),
+		TraitAxes:                TraitAxesToProto(q.TraitAxes),
+		ScoreThresholds:          q.ScoreThresholds,
+		TimeLimitSeconds:         q.TimeLimitSeconds,
+		QuestionTimeLimitSeconds: q.QuestionTimeLimitSeconds,
+		EarlyTermination:         q.EarlyTermination,
+		QuestionDraw:             q.QuestionDraw.ToProto(),
+		ResultDetails:            ResultDetailsToProto(q.ResultDetails),
+		Description:              q.Description,
+		Tags:                     q.Tags,
+		Language:                 q.Language,
+		CreatedAt:                timestamppb.New(q.CreatedAt),
+		CompletionCount:          q.CompletionCount,
+		CategoryIds:              CategoryIDsToProto(q.CategoryIDs),
+		DisplayLanguage:          q.DisplayLanguage,
+		AvailableLanguages:       q.AvailableLanguages,
+		RatingAverage:            q.RatingAverage,
+		RatingCount:              q.RatingCount,
+		LikeCount:                q.LikeCount,
+	}
+}
+
+// ResultDetail returns the detail of the given result, or a detail with the
+// result as its title when the quiz does not describe it.
+func (q *Quiz) ResultDetail(result string) *ResultDetail {
+	if i := slices.IndexFunc(q.ResultDetails, func(d ResultDetail) bool { return d.Title == result }); i >= 0 {
+		detail := q.ResultDetails[i]
+		return &detail
+	}
+	return &ResultDetail{Title: result}
+}
+
+// MediaIDs returns the IDs of the media the result details show.
+func (q *Quiz) MediaIDs() []uuid.UUID {
+	var ids []uuid.UUID
+	for _, detail := range q.ResultDetails {
+		if detail.ImageID != nil {
+			ids = append(ids, *detail.ImageID)
+		}
+	}
+	return ids
+}
+
+// WeightsLen returns how many weights every option of the quiz must carry
+// under its scoring model.
+func (q *Quiz) WeightsLen() int {
+	switch q.ScoringModel {
+	case ScoringKnowledge, ScoringBands:
+		return 1
+	case ScoringTraits:
+		return len(q.TraitAxes)
+	default:
+		return len(q.Results)
+	}
+}
+
+func (q *Quiz) IsTiebreaker(questionID uuid.UUID) bool {
+	return q.TieBreakPolicy == TieBreakTiebreakerQuestion && q.TiebreakerQuestionID != nil && *q.TiebreakerQuestionID == questionID
+}
+
+func (s QuizStatus) ToProto() quizv1.QuizStatus {
+	switch s {
+	case QuizStatusDraft:
+		return quizv1.QuizStatus_QUIZ_STATUS_DRAFT
+	case QuizStatusPublished:
+		return quizv1.QuizStatus_QUIZ_STATUS_PUBLISHED
+	case QuizStatusArchived:
+		return quizv1.QuizStatus_QUIZ_STATUS_ARCHIVED
+	default:
+		return quizv1.QuizStatus_QUIZ_STATUS_UNSPECIFIED
+	}
+}
+
+func (p TieBreakPolicy) ToProto() quizv1.TieBreakPolicy {
+	switch p {
+	case TieBreakFirstDeclared:
+		return quizv1.TieBreakPolicy_TIE_BREAK_POLICY_FIRST_DECLARED
+	case TieBreakRandom:
+		return quizv1.TieBreakPolicy_TIE_BREAK_POLICY_RANDOM
+	case TieBreakReportAll:
+		return quizv1.TieBreakPolicy_TIE_BREAK_POLICY_REPORT_ALL
+	case TieBreakTiebreakerQuestion:
+		return quizv1.TieBreakPolicy_TIE_BREAK_POLICY_TIEBREAKER_QUESTION
+	default:
+		return quizv1.TieBreakPolicy_TIE_BREAK_POLICY_UNSPECIFIED
+	}
+}
+
+// QuizStatusToModel returns an empty status for QUIZ_STATUS_UNSPECIFIED.
+func QuizStatusToModel(s quizv1.QuizStatus) QuizStatus {
+	switch s {
+	case quizv1.QuizStatus_QUIZ_STATUS_DRAFT:
+		return QuizStatusDraft
+	case quizv1.QuizStatus_QUIZ_STATUS_PUBLISHED:
+		return QuizStatusPublished
+	case quizv1.QuizStatus_QUIZ_STATUS_ARCHIVED:
+		return QuizStatusArchived
+	default:
+		return ""
+	}
+}
+
+// QuizSortOrderToModel defaults to the newest quizzes first.
+func QuizSortOrderToModel(o quizv1.QuizSortOrder) QuizSortOrder {
+	switch o {
+	case quizv1.QuizSortOrder_QUIZ_SORT_ORDER_MOST_COMPLETED:
+		return QuizSortMostCompleted
+	case quizv1.QuizSortOrder_QUIZ_SORT_ORDER_TITLE:
+		return QuizSortTitle
+	case quizv1.QuizSortOrder_QUIZ_SORT_ORDER_TOP_RATED:
+		return QuizSortTopRated
+	case quizv1.QuizSortOrder_QUIZ_SORT_ORDER_MOST_LIKED:
+		return QuizSortMostLiked
+	default:
+		return QuizSortNewest
+	}
+}
+
+// NormalizeTags lowercases and trims the tags, dropping empty and repeated ones.
+func NormalizeTags(tags []string) []string {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = NormalizeTag(tag)
+		if tag != "" && !slices.Contains(normalized, tag) {
+			normalized = append(normalized, tag)
+		}
+	}
+	return normalized
+}
+
+func NormalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
+
+// NormalizeLanguage lowercases and trims a language code such as "ru" or "en-us".
+func NormalizeLanguage(language string) string {
+	return strings.ToLower(strings.TrimSpace(language))
+}
+
+// TieBreakPolicyToModel returns an empty policy for TIE_BREAK_POLICY_UNSPECIFIED.
+func TieBreakPolicyToModel(p quizv1.TieBreakPolicy) TieBreakPolicy {
+	switch p {
+	case quizv1.TieBreakPolicy_TIE_BREAK_POLICY_FIRST_DECLARED:
+		return TieBreakFirstDeclared
+	case quizv1.TieBreakPolicy_TIE_BREAK_POLICY_RANDOM:
+		return TieBreakRandom
+	case quizv1.TieBreakPolicy_TIE_BREAK_POLICY_REPORT_ALL:
+		return TieBreakReportAll
+	case quizv1.TieBreakPolicy_TIE_BREAK_POLICY_TIEBREAKER_QUESTION:
+		return TieBreakTiebreakerQuestion
+	default:
+		return ""
+	}
+}
+
+func (m ScoringModel) ToProto() quizv1.ScoringModel {
+	switch m {
+	case ScoringWeightedSum:
+		return quizv1.ScoringModel_SCORING_MODEL_WEIGHTED_SUM
+	case ScoringKnowledge:
+		return quizv1.ScoringModel_SCORING_MODEL_KNOWLEDGE
+	case ScoringTraits:
+		return quizv1.ScoringModel_SCORING_MODEL_TRAITS
+	case ScoringBands:
+		return quizv1.ScoringModel_SCORING_MODEL_BANDS
+	default:
+		return quizv1.ScoringModel_SCORING_MODEL_UNSPECIFIED
+	}
+}
+
+// ScoringModelToModel returns an empty model for SCORING_MODEL_UNSPECIFIED.
+func ScoringModelToModel(m quizv1.ScoringModel) ScoringModel {
+	switch m {
+	case quizv1.ScoringModel_SCORING_MODEL_WEIGHTED_SUM:
+		return ScoringWeightedSum
+	case quizv1.ScoringModel_SCORING_MODEL_KNOWLEDGE:
+		return ScoringKnowledge
+	case quizv1.ScoringModel_SCORING_MODEL_TRAITS:
+		return ScoringTraits
+	case quizv1.ScoringModel_SCORING_MODEL_BANDS:
+		return ScoringBands
+	default:
+		return ""
+	}
+}
+
+func TraitAxesToModel(protoAxes []*quizv1.TraitAxis) []TraitAxis {
+	axes := make([]TraitAxis, len(protoAxes))
+	for i, axis := range protoAxes {
+		axes[i] = TraitAxis{Positive: axis.Positive, Negative: axis.Negative}
+	}
+	return axes
+}
+
+func TraitAxesToProto(axes []TraitAxis) []*quizv1.TraitAxis {
+	protoAxes := make([]*quizv1.TraitAxis, len(axes))
+	for i, axis := range axes {
+		protoAxes[i] = &quizv1.TraitAxis{Positive: axis.Positive, Negative: axis.Negative}
+	}
+	return protoAxes
+}
+
+// QuestionDrawToModel returns nil, meaning every question is asked, for a
+// missing or empty draw.
+func QuestionDrawToModel(protoDraw *quizv1.QuestionDraw) *QuestionDraw {
+	if protoDraw == nil || protoDraw.Count == 0 && len(protoDraw.PerTag) == 0 {
+		return nil
+	}
+
+	return &QuestionDraw{
+		Count:  protoDraw.Count,
+		PerTag: maps.Clone(protoDraw.PerTag),
+	}
+}
+
+func (d *QuestionDraw) ToProto() *quizv1.QuestionDraw {
+	if d == nil {
+		return nil
+	}
+
+	return &quizv1.QuestionDraw{
+		Count:  d.Count,
+		PerTag: maps.Clone(d.PerTag),
+	}
+}
+
+// ResultsToModel returns the results of a quiz and their details. Results can
+// be given as plain titles or as details, in which case the titles of the
+// details are the results. When both are given they have to match.
+func ResultsToModel(results []string, protoDetails []*quizv1.ResultDetail) ([]string, []ResultDetail, error) {
+	return resultsToModel(results, protoDetails, mediaIDToModel)
+}
+
+// resultsToModel resolves the images of the result details with mediaID.
+func resultsToModel(results []string, protoDetails []*quizv1.ResultDetail, mediaID func(string) (*uuid.UUID, error)) ([]string, []ResultDetail, error) {
+	if len(protoDetails) == 0 {
+		return results, nil, nil
+	}
+
+	details := make([]ResultDetail, len(protoDetails))
+	titles := make([]string, len(protoDetails))
+	for i, protoDetail := range protoDetails {
+		imageID, err := mediaID(protoDetail.ImageId)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		details[i] = ResultDetail{
+			Title:       protoDetail.Title,
+			Description: protoDetail.Description,
+			ImageID:     imageID,
+		}
+		titles[i] = protoDetail.Title
+	}
+
+	if len(results) > 0 && !slices.Equal(results, titles) {
+		return nil, nil, errors.New("results do not match the titles of the result details")
+	}
+
+	return titles, details, nil
+}
+
+func ResultDetailsToProto(details []ResultDetail) []*quizv1.ResultDetail {
+	protoDetails := make([]*quizv1.ResultDetail, len(details))
+	for i, detail := range details {
+		protoDetails[i] = detail.ToProto()
+	}
+	return protoDetails
+}
+
+func (d *ResultDetail) ToProto() *quizv1.ResultDetail {
+	if d == nil {
+		return nil
+	}
+
+	return &quizv1.ResultDetail{
+		Title:       d.Title,
+		Description: d.Description,
+		ImageId:     mediaIDToProto(d.ImageID),
 	}
 }
@@ -0,0 +1,55 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	quizv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/quiz/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type QuizVersion struct {
+	ID        uuid.UUID   `json:"id"`
+	QuizID    uuid.UUID   `json:"quiz_id"`
+	Version   int32       `json:"version"`
+	Title     string      `json:"title"`
+	Results   []string    `json:"results"`
+	Questions []*Question `json:"questions"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+// Apply returns a copy of the quiz with the title and results of the version.
+func (v *QuizVersion) Apply(quiz *Quiz) *Quiz {
+	applied := *quiz
+	applied.Title = v.Title
+	applied.Results = v.Results
+	return &applied
+}
+
+func (v *QuizVersion) ToProto() *quizv1.QuizVersion {
+	questions := make([]*quizv1.QuizVersionQuestion, len(v.Questions))
+	for i, q := range v.Questions {
+		protoQuestion := q.ToProtoWithoutWeights()
+		choices := make([]*quizv1.QuizVersionOption, len(protoQuestion.Choices))
+		for j, choice := range protoQuestion.Choices {
+			choices[j] = &quizv1.QuizVersionOption{Id: choice.Id, Text: choice.Text}
+		}
+
+		questions[i] = &quizv1.QuizVersionQuestion{
+			Id:      protoQuestion.Id,
+			Body:    protoQuestion.Body,
+			Options: protoQuestion.Options,
+			Choices: choices,
+		}
+	}
+
+	return &quizv1.QuizVersion{
+		Id:        v.ID.String(),
+		QuizId:    v.QuizID.String(),
+		Version:   v.Version,
+		Title:     v.Title,
+		Results:   v.Results,
+		Questions: questions,
+		CreatedAt: timestamppb.New(v.CreatedAt),
+	}
+}
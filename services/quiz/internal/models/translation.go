@@ -0,0 +1,191 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	translationv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/translation/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// QuizTranslation holds the texts of a quiz in another language than the one
+// it is written in. Questions and options are matched by their IDs and results
+// by their position, so that answers and evaluations do not depend on the
+// language. Empty texts fall back to the original ones.
+type QuizTranslation struct {
+	QuizID      uuid.UUID             `json:"quiz_id"`
+	Language    string                `json:"language"`
+	Title       string                `json:"title"`
+	Description string                `json:"description"`
+	Results     []string              `json:"results"`
+	Questions   []QuestionTranslation `json:"questions"`
+	UpdatedAt   time.Time             `json:"updated_at"`
+}
+
+type QuestionTranslation struct {
+	QuestionID uuid.UUID            `json:"question_id"`
+	Body       string               `json:"body"`
+	Options    map[uuid.UUID]string `json:"options"`
+}
+
+func (t *QuizTranslation) ToProto() *translationv1.QuizTranslation {
+	questions := make([]*translationv1.QuestionTranslation, len(t.Questions))
+	for i, q := range t.Questions {
+		options := make(map[string]string, len(q.Options))
+		for id, text := range q.Options {
+			options[id.String()] = text
+		}
+
+		questions[i] = &translationv1.QuestionTranslation{
+			QuestionId: q.QuestionID.String(),
+			Body:       q.Body,
+			Options:    options,
+		}
+	}
+
+	return &translationv1.QuizTranslation{
+		QuizId:      t.QuizID.String(),
+		Language:    t.Language,
+		Title:       t.Title,
+		Description: t.Description,
+		Results:     t.Results,
+		Questions:   questions,
+		UpdatedAt:   timestamppb.New(t.UpdatedAt),
+	}
+}
+
+func QuestionTranslationsToModel(protoQuestions []*translationv1.QuestionTranslation) ([]QuestionTranslation, error) {
+	questions := make([]QuestionTranslation, len(protoQuestions))
+	for i, protoQuestion := range protoQuestions {
+		questionID, err := uuid.Parse(protoQuestion.QuestionId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse question ID '%s': %w", protoQuestion.QuestionId, err)
+		}
+
+		options := make(map[uuid.UUID]string, len(protoQuestion.Options))
+		for id, text := range protoQuestion.Options {
+			optionID, err := uuid.Parse(id)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse option ID '%s': %w", id, err)
+			}
+			options[optionID] = text
+		}
+
+		questions[i] = QuestionTranslation{
+			QuestionID: questionID,
+			Body:       protoQuestion.Body,
+			Options:    options,
+		}
+	}
+	return questions, nil
+}
+
+// Localization is the language a quiz is shown in, negotiated from the
+// languages the user accepts. Without a translation the quiz is shown as
+// written.
+type Localization struct {
+	Language    string
+	Languages   []string
+	Translation *QuizTranslation
+}
+
+// Quiz returns a copy of the quiz with its title, description and results
+// translated.
+func (l *Localization) Quiz(q *Quiz) *Quiz {
+	localized := *q
+	localized.DisplayLanguage = l.Language
+	localized.AvailableLanguages = l.Languages
+
+	t := l.Translation
+	if t == nil {
+		return &localized
+	}
+
+	if t.Title != "" {
+		localized.Title = t.Title
+	}
+	if t.Description != "" {
+		localized.Description = t.Description
+	}
+
+	localized.Results = make([]string, len(q.Results))
+	for i, result := range q.Results {
+		localized.Results[i] = l.result(q.Results, result)
+	}
+
+	localized.ResultDetails = make([]ResultDetail, len(q.ResultDetails))
+	for i, detail := range q.ResultDetails {
+		detail.Title = l.result(q.Results, detail.Title)
+		localized.ResultDetails[i] = detail
+	}
+
+	return &localized
+}
+
+// Question returns a copy of the question with its body and options
+// translated.
+func (l *Localization) Question(q *Question) *Question {
+	if l.Translation == nil {
+		return q
+	}
+
+	i := slices.IndexFunc(l.Translation.Questions, func(t QuestionTranslation) bool { return t.QuestionID == q.ID })
+	if i < 0 {
+		return q
+	}
+	t := l.Translation.Questions[i]
+
+	localized := *q
+	if t.Body != "" {
+		localized.Body = t.Body
+	}
+
+	localized.Options = slices.Clone(q.Options)
+	for j, option := range localized.Options {
+		if text := t.Options[option.ID]; text != "" {
+			localized.Options[j].Text = text
+		}
+	}
+
+	return &localized
+}
+
+// Evaluation returns a copy of the evaluation with its results translated.
+// Evaluations are always made and stored with the results of the quiz as
+// written, which are given to match them.
+func (l *Localization) Evaluation(results []string, e *Evaluation) *Evaluation {
+	if e == nil || l.Translation == nil {
+		return e
+	}
+
+	localized := *e
+	localized.Result = l.result(results, e.Result)
+
+	localized.Scores = slices.Clone(e.Scores)
+	for i := range localized.Scores {
+		localized.Scores[i].Result = l.result(results, localized.Scores[i].Result)
+	}
+
+	localized.TiedResults = make([]string, len(e.TiedResults))
+	for i, result := range e.TiedResults {
+		localized.TiedResults[i] = l.result(results, result)
+	}
+
+	if e.ResultDetail != nil {
+		detail := *e.ResultDetail
+		detail.Title = l.result(results, detail.Title)
+		localized.ResultDetail = &detail
+	}
+
+	return &localized
+}
+
+func (l *Localization) result(results []string, result string) string {
+	i := slices.Index(results, result)
+	if i < 0 || i >= len(l.Translation.Results) || l.Translation.Results[i] == "" {
+		return result
+	}
+	return l.Translation.Results[i]
+}
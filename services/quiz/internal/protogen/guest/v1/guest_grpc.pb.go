@@ -0,0 +1,121 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: guest.proto
+
+package guestv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GuestService_MergeGuest_FullMethodName = "/guest.v1.GuestService/MergeGuest"
+)
+
+// GuestServiceClient is the client API for GuestService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GuestServiceClient interface {
+	MergeGuest(ctx context.Context, in *MergeGuestRequest, opts ...grpc.CallOption) (*MergeGuestResponse, error)
+}
+
+type guestServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGuestServiceClient(cc grpc.ClientConnInterface) GuestServiceClient {
+	return &guestServiceClient{cc}
+}
+
+func (c *guestServiceClient) MergeGuest(ctx context.Context, in *MergeGuestRequest, opts ...grpc.CallOption) (*MergeGuestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeGuestResponse)
+	err := c.cc.Invoke(ctx, GuestService_MergeGuest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GuestServiceServer is the server API for GuestService service.
+// All implementations must embed UnimplementedGuestServiceServer
+// for forward compatibility.
+type GuestServiceServer interface {
+	MergeGuest(context.Context, *MergeGuestRequest) (*MergeGuestResponse, error)
+	mustEmbedUnimplementedGuestServiceServer()
+}
+
+// UnimplementedGuestServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGuestServiceServer struct{}
+
+func (UnimplementedGuestServiceServer) MergeGuest(context.Context, *MergeGuestRequest) (*MergeGuestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeGuest not implemented")
+}
+func (UnimplementedGuestServiceServer) mustEmbedUnimplementedGuestServiceServer() {}
+func (UnimplementedGuestServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeGuestServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GuestServiceServer will
+// result in compilation errors.
+type UnsafeGuestServiceServer interface {
+	mustEmbedUnimplementedGuestServiceServer()
+}
+
+func RegisterGuestServiceServer(s grpc.ServiceRegistrar, srv GuestServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGuestServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GuestService_ServiceDesc, srv)
+}
+
+func _GuestService_MergeGuest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeGuestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuestServiceServer).MergeGuest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuestService_MergeGuest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuestServiceServer).MergeGuest(ctx, req.(*MergeGuestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GuestService_ServiceDesc is the grpc.ServiceDesc for GuestService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GuestService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "guest.v1.GuestService",
+	HandlerType: (*GuestServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MergeGuest",
+			Handler:    _GuestService_MergeGuest_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "guest.proto",
+}
@@ -22,6 +22,8 @@ const (
 	QuestionService_BatchCreateQuestions_FullMethodName = "/question.v1.QuestionService/BatchCreateQuestions"
 	QuestionService_BatchGetQuestions_FullMethodName    = "/question.v1.QuestionService/BatchGetQuestions"
 	QuestionService_EvaluateAnswers_FullMethodName      = "/question.v1.QuestionService/EvaluateAnswers"
+	QuestionService_UpdateQuestion_FullMethodName       = "/question.v1.QuestionService/UpdateQuestion"
+	QuestionService_DeleteQuestion_FullMethodName       = "/question.v1.QuestionService/DeleteQuestion"
 )
 
 // QuestionServiceClient is the client API for QuestionService service.
@@ -31,6 +33,8 @@ type QuestionServiceClient interface {
 	BatchCreateQuestions(ctx context.Context, in *BatchCreateQuestionsRequest, opts ...grpc.CallOption) (*BatchCreateQuestionsResponse, error)
 	BatchGetQuestions(ctx context.Context, in *BatchGetQuestionsRequest, opts ...grpc.CallOption) (*BatchGetQuestionsResponse, error)
 	EvaluateAnswers(ctx context.Context, in *EvaluateAnswersRequest, opts ...grpc.CallOption) (*EvaluateAnswersResponse, error)
+	UpdateQuestion(ctx context.Context, in *UpdateQuestionRequest, opts ...grpc.CallOption) (*Question, error)
+	DeleteQuestion(ctx context.Context, in *DeleteQuestionRequest, opts ...grpc.CallOption) (*DeleteQuestionResponse, error)
 }
 
 type questionServiceClient struct {
@@ -71,6 +75,26 @@ func (c *questionServiceClient) EvaluateAnswers(ctx context.Context, in *Evaluat
 	return out, nil
 }
 
+func (c *questionServiceClient) UpdateQuestion(ctx context.Context, in *UpdateQuestionRequest, opts ...grpc.CallOption) (*Question, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Question)
+	err := c.cc.Invoke(ctx, QuestionService_UpdateQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionServiceClient) DeleteQuestion(ctx context.Context, in *DeleteQuestionRequest, opts ...grpc.CallOption) (*DeleteQuestionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteQuestionResponse)
+	err := c.cc.Invoke(ctx, QuestionService_DeleteQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QuestionServiceServer is the server API for QuestionService service.
 // All implementations must embed UnimplementedQuestionServiceServer
 // for forward compatibility.
@@ -78,6 +102,8 @@ type QuestionServiceServer interface {
 	BatchCreateQuestions(context.Context, *BatchCreateQuestionsRequest) (*BatchCreateQuestionsResponse, error)
 	BatchGetQuestions(context.Context, *BatchGetQuestionsRequest) (*BatchGetQuestionsResponse, error)
 	EvaluateAnswers(context.Context, *EvaluateAnswersRequest) (*EvaluateAnswersResponse, error)
+	UpdateQuestion(context.Context, *UpdateQuestionRequest) (*Question, error)
+	DeleteQuestion(context.Context, *DeleteQuestionRequest) (*DeleteQuestionResponse, error)
 	mustEmbedUnimplementedQuestionServiceServer()
 }
 
@@ -97,6 +123,12 @@ func (UnimplementedQuestionServiceServer) BatchGetQuestions(context.Context, *Ba
 func (UnimplementedQuestionServiceServer) EvaluateAnswers(context.Context, *EvaluateAnswersRequest) (*EvaluateAnswersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EvaluateAnswers not implemented")
 }
+func (UnimplementedQuestionServiceServer) UpdateQuestion(context.Context, *UpdateQuestionRequest) (*Question, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateQuestion not implemented")
+}
+func (UnimplementedQuestionServiceServer) DeleteQuestion(context.Context, *DeleteQuestionRequest) (*DeleteQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQuestion not implemented")
+}
 func (UnimplementedQuestionServiceServer) mustEmbedUnimplementedQuestionServiceServer() {}
 func (UnimplementedQuestionServiceServer) testEmbeddedByValue()                         {}
 
@@ -172,6 +204,42 @@ func _QuestionService_EvaluateAnswers_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QuestionService_UpdateQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServiceServer).UpdateQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionService_UpdateQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServiceServer).UpdateQuestion(ctx, req.(*UpdateQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionService_DeleteQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServiceServer).DeleteQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionService_DeleteQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServiceServer).DeleteQuestion(ctx, req.(*DeleteQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // QuestionService_ServiceDesc is the grpc.ServiceDesc for QuestionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,6 +259,14 @@ var QuestionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EvaluateAnswers",
 			Handler:    _QuestionService_EvaluateAnswers_Handler,
 		},
+		{
+			MethodName: "UpdateQuestion",
+			Handler:    _QuestionService_UpdateQuestion_Handler,
+		},
+		{
+			MethodName: "DeleteQuestion",
+			Handler:    _QuestionService_DeleteQuestion_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "question.proto",
@@ -0,0 +1,300 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v5.29.3
+// source: share.proto
+
+package sharev1
+
+import (
+	v1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/quiz/v1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SharedResult struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ShareToken      string                 `protobuf:"bytes,1,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
+	QuizId          string                 `protobuf:"bytes,2,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	QuizTitle       string                 `protobuf:"bytes,3,opt,name=quiz_title,json=quizTitle,proto3" json:"quiz_title,omitempty"`
+	Result          *v1.ResultDetail       `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
+	DisplayName     string                 `protobuf:"bytes,5,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	CompletedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	ImageUrl        string                 `protobuf:"bytes,7,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	DisplayLanguage string                 `protobuf:"bytes,8,opt,name=display_language,json=displayLanguage,proto3" json:"display_language,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SharedResult) Reset() {
+	*x = SharedResult{}
+	mi := &file_share_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharedResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedResult) ProtoMessage() {}
+
+func (x *SharedResult) ProtoReflect() protoreflect.Message {
+	mi := &file_share_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedResult.ProtoReflect.Descriptor instead.
+func (*SharedResult) Descriptor() ([]byte, []int) {
+	return file_share_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SharedResult) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+func (x *SharedResult) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *SharedResult) GetQuizTitle() string {
+	if x != nil {
+		return x.QuizTitle
+	}
+	return ""
+}
+
+func (x *SharedResult) GetResult() *v1.ResultDetail {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *SharedResult) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *SharedResult) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *SharedResult) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *SharedResult) GetDisplayLanguage() string {
+	if x != nil {
+		return x.DisplayLanguage
+	}
+	return ""
+}
+
+type GetSharedResultRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShareToken    string                 `protobuf:"bytes,1,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharedResultRequest) Reset() {
+	*x = GetSharedResultRequest{}
+	mi := &file_share_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedResultRequest) ProtoMessage() {}
+
+func (x *GetSharedResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_share_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedResultRequest.ProtoReflect.Descriptor instead.
+func (*GetSharedResultRequest) Descriptor() ([]byte, []int) {
+	return file_share_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetSharedResultRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+type GetSharedResultImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShareToken    string                 `protobuf:"bytes,1,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharedResultImageRequest) Reset() {
+	*x = GetSharedResultImageRequest{}
+	mi := &file_share_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedResultImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedResultImageRequest) ProtoMessage() {}
+
+func (x *GetSharedResultImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_share_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedResultImageRequest.ProtoReflect.Descriptor instead.
+func (*GetSharedResultImageRequest) Descriptor() ([]byte, []int) {
+	return file_share_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetSharedResultImageRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+var File_share_proto protoreflect.FileDescriptor
+
+const file_share_proto_rawDesc = "" +
+	"\n" +
+	"\vshare.proto\x12\bshare.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x19google/api/httpbody.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\n" +
+	"quiz.proto\"\xc0\x02\n" +
+	"\fSharedResult\x12\x1f\n" +
+	"\vshare_token\x18\x01 \x01(\tR\n" +
+	"shareToken\x12\x17\n" +
+	"\aquiz_id\x18\x02 \x01(\tR\x06quizId\x12\x1d\n" +
+	"\n" +
+	"quiz_title\x18\x03 \x01(\tR\tquizTitle\x12-\n" +
+	"\x06result\x18\x04 \x01(\v2\x15.quiz.v1.ResultDetailR\x06result\x12!\n" +
+	"\fdisplay_name\x18\x05 \x01(\tR\vdisplayName\x12=\n" +
+	"\fcompleted_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12\x1b\n" +
+	"\timage_url\x18\a \x01(\tR\bimageUrl\x12)\n" +
+	"\x10display_language\x18\b \x01(\tR\x0fdisplayLanguage\"9\n" +
+	"\x16GetSharedResultRequest\x12\x1f\n" +
+	"\vshare_token\x18\x01 \x01(\tR\n" +
+	"shareToken\">\n" +
+	"\x1bGetSharedResultImageRequest\x12\x1f\n" +
+	"\vshare_token\x18\x01 \x01(\tR\n" +
+	"shareToken2\x82\x02\n" +
+	"\fShareService\x12q\n" +
+	"\x0fGetSharedResult\x12 .share.v1.GetSharedResultRequest\x1a\x16.share.v1.SharedResult\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/shared/{share_token}\x12\x7f\n" +
+	"\x14GetSharedResultImage\x12%.share.v1.GetSharedResultImageRequest\x1a\x14.google.api.HttpBody\"*\x82\xd3\xe4\x93\x02$\x12\"/api/v1/shared/{share_token}/imageBJZHgithub.com/mibrgmv/whoami-server/quiz/internal/protogen/share/v1;sharev1b\x06proto3"
+
+var (
+	file_share_proto_rawDescOnce sync.Once
+	file_share_proto_rawDescData []byte
+)
+
+func file_share_proto_rawDescGZIP() []byte {
+	file_share_proto_rawDescOnce.Do(func() {
+		file_share_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_share_proto_rawDesc), len(file_share_proto_rawDesc)))
+	})
+	return file_share_proto_rawDescData
+}
+
+var file_share_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_share_proto_goTypes = []any{
+	(*SharedResult)(nil),                // 0: share.v1.SharedResult
+	(*GetSharedResultRequest)(nil),      // 1: share.v1.GetSharedResultRequest
+	(*GetSharedResultImageRequest)(nil), // 2: share.v1.GetSharedResultImageRequest
+	(*v1.ResultDetail)(nil),             // 3: quiz.v1.ResultDetail
+	(*timestamppb.Timestamp)(nil),       // 4: google.protobuf.Timestamp
+	(*httpbody.HttpBody)(nil),           // 5: google.api.HttpBody
+}
+var file_share_proto_depIdxs = []int32{
+	3, // 0: share.v1.SharedResult.result:type_name -> quiz.v1.ResultDetail
+	4, // 1: share.v1.SharedResult.completed_at:type_name -> google.protobuf.Timestamp
+	1, // 2: share.v1.ShareService.GetSharedResult:input_type -> share.v1.GetSharedResultRequest
+	2, // 3: share.v1.ShareService.GetSharedResultImage:input_type -> share.v1.GetSharedResultImageRequest
+	0, // 4: share.v1.ShareService.GetSharedResult:output_type -> share.v1.SharedResult
+	5, // 5: share.v1.ShareService.GetSharedResultImage:output_type -> google.api.HttpBody
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_share_proto_init() }
+func file_share_proto_init() {
+	if File_share_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_share_proto_rawDesc), len(file_share_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_share_proto_goTypes,
+		DependencyIndexes: file_share_proto_depIdxs,
+		MessageInfos:      file_share_proto_msgTypes,
+	}.Build()
+	File_share_proto = out.File
+	file_share_proto_goTypes = nil
+	file_share_proto_depIdxs = nil
+}
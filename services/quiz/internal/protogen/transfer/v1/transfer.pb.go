@@ -0,0 +1,589 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v5.29.3
+// source: transfer.proto
+
+package transferv1
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	v11 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/question/v1"
+	v1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/quiz/v1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QuizDocument is a self-contained copy of a quiz. Questions and media are
+// referred to by their keys within the document: tiebreaker_question and
+// next_question_id of routes hold question keys, image fields hold media keys.
+type QuizDocument struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	FormatVersion            int32                  `protobuf:"varint,1,opt,name=format_version,json=formatVersion,proto3" json:"format_version,omitempty"`
+	Title                    string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Results                  []string               `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	ResultDetails            []*v1.ResultDetail     `protobuf:"bytes,4,rep,name=result_details,json=resultDetails,proto3" json:"result_details,omitempty"`
+	TieBreakPolicy           v1.TieBreakPolicy      `protobuf:"varint,5,opt,name=tie_break_policy,json=tieBreakPolicy,proto3,enum=quiz.v1.TieBreakPolicy" json:"tie_break_policy,omitempty"`
+	TieBreakSeed             int64                  `protobuf:"varint,6,opt,name=tie_break_seed,json=tieBreakSeed,proto3" json:"tie_break_seed,omitempty"`
+	TiebreakerQuestion       string                 `protobuf:"bytes,7,opt,name=tiebreaker_question,json=tiebreakerQuestion,proto3" json:"tiebreaker_question,omitempty"`
+	ScoringModel             v1.ScoringModel        `protobuf:"varint,8,opt,name=scoring_model,json=scoringModel,proto3,enum=quiz.v1.ScoringModel" json:"scoring_model,omitempty"`
+	TraitAxes                []*v1.TraitAxis        `protobuf:"bytes,9,rep,name=trait_axes,json=traitAxes,proto3" json:"trait_axes,omitempty"`
+	ScoreThresholds          []float32              `protobuf:"fixed32,10,rep,packed,name=score_thresholds,json=scoreThresholds,proto3" json:"score_thresholds,omitempty"`
+	TimeLimitSeconds         int32                  `protobuf:"varint,11,opt,name=time_limit_seconds,json=timeLimitSeconds,proto3" json:"time_limit_seconds,omitempty"`
+	QuestionTimeLimitSeconds int32                  `protobuf:"varint,12,opt,name=question_time_limit_seconds,json=questionTimeLimitSeconds,proto3" json:"question_time_limit_seconds,omitempty"`
+	EarlyTermination         bool                   `protobuf:"varint,13,opt,name=early_termination,json=earlyTermination,proto3" json:"early_termination,omitempty"`
+	QuestionDraw             *v1.QuestionDraw       `protobuf:"bytes,14,opt,name=question_draw,json=questionDraw,proto3" json:"question_draw,omitempty"`
+	Questions                []*QuestionDocument    `protobuf:"bytes,15,rep,name=questions,proto3" json:"questions,omitempty"`
+	Media                    []*MediaDocument       `protobuf:"bytes,16,rep,name=media,proto3" json:"media,omitempty"`
+	Description              string                 `protobuf:"bytes,17,opt,name=description,proto3" json:"description,omitempty"`
+	Tags                     []string               `protobuf:"bytes,18,rep,name=tags,proto3" json:"tags,omitempty"`
+	Language                 string                 `protobuf:"bytes,19,opt,name=language,proto3" json:"language,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *QuizDocument) Reset() {
+	*x = QuizDocument{}
+	mi := &file_transfer_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizDocument) ProtoMessage() {}
+
+func (x *QuizDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_transfer_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizDocument.ProtoReflect.Descriptor instead.
+func (*QuizDocument) Descriptor() ([]byte, []int) {
+	return file_transfer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QuizDocument) GetFormatVersion() int32 {
+	if x != nil {
+		return x.FormatVersion
+	}
+	return 0
+}
+
+func (x *QuizDocument) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *QuizDocument) GetResults() []string {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *QuizDocument) GetResultDetails() []*v1.ResultDetail {
+	if x != nil {
+		return x.ResultDetails
+	}
+	return nil
+}
+
+func (x *QuizDocument) GetTieBreakPolicy() v1.TieBreakPolicy {
+	if x != nil {
+		return x.TieBreakPolicy
+	}
+	return v1.TieBreakPolicy(0)
+}
+
+func (x *QuizDocument) GetTieBreakSeed() int64 {
+	if x != nil {
+		return x.TieBreakSeed
+	}
+	return 0
+}
+
+func (x *QuizDocument) GetTiebreakerQuestion() string {
+	if x != nil {
+		return x.TiebreakerQuestion
+	}
+	return ""
+}
+
+func (x *QuizDocument) GetScoringModel() v1.ScoringModel {
+	if x != nil {
+		return x.ScoringModel
+	}
+	return v1.ScoringModel(0)
+}
+
+func (x *QuizDocument) GetTraitAxes() []*v1.TraitAxis {
+	if x != nil {
+		return x.TraitAxes
+	}
+	return nil
+}
+
+func (x *QuizDocument) GetScoreThresholds() []float32 {
+	if x != nil {
+		return x.ScoreThresholds
+	}
+	return nil
+}
+
+func (x *QuizDocument) GetTimeLimitSeconds() int32 {
+	if x != nil {
+		return x.TimeLimitSeconds
+	}
+	return 0
+}
+
+func (x *QuizDocument) GetQuestionTimeLimitSeconds() int32 {
+	if x != nil {
+		return x.QuestionTimeLimitSeconds
+	}
+	return 0
+}
+
+func (x *QuizDocument) GetEarlyTermination() bool {
+	if x != nil {
+		return x.EarlyTermination
+	}
+	return false
+}
+
+func (x *QuizDocument) GetQuestionDraw() *v1.QuestionDraw {
+	if x != nil {
+		return x.QuestionDraw
+	}
+	return nil
+}
+
+func (x *QuizDocument) GetQuestions() []*QuestionDocument {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *QuizDocument) GetMedia() []*MediaDocument {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+func (x *QuizDocument) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *QuizDocument) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *QuizDocument) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type QuestionDocument struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	Type          v11.QuestionType       `protobuf:"varint,3,opt,name=type,proto3,enum=question.v1.QuestionType" json:"type,omitempty"`
+	Options       []*v11.Option          `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"`
+	Route         *v11.Route             `protobuf:"bytes,5,opt,name=route,proto3" json:"route,omitempty"`
+	Tags          []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	Image         string                 `protobuf:"bytes,7,opt,name=image,proto3" json:"image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuestionDocument) Reset() {
+	*x = QuestionDocument{}
+	mi := &file_transfer_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuestionDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionDocument) ProtoMessage() {}
+
+func (x *QuestionDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_transfer_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionDocument.ProtoReflect.Descriptor instead.
+func (*QuestionDocument) Descriptor() ([]byte, []int) {
+	return file_transfer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QuestionDocument) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *QuestionDocument) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *QuestionDocument) GetType() v11.QuestionType {
+	if x != nil {
+		return x.Type
+	}
+	return v11.QuestionType(0)
+}
+
+func (x *QuestionDocument) GetOptions() []*v11.Option {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *QuestionDocument) GetRoute() *v11.Route {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+func (x *QuestionDocument) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *QuestionDocument) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+type MediaDocument struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MediaDocument) Reset() {
+	*x = MediaDocument{}
+	mi := &file_transfer_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MediaDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaDocument) ProtoMessage() {}
+
+func (x *MediaDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_transfer_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaDocument.ProtoReflect.Descriptor instead.
+func (*MediaDocument) Descriptor() ([]byte, []int) {
+	return file_transfer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MediaDocument) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *MediaDocument) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *MediaDocument) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ExportQuizRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportQuizRequest) Reset() {
+	*x = ExportQuizRequest{}
+	mi := &file_transfer_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportQuizRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportQuizRequest) ProtoMessage() {}
+
+func (x *ExportQuizRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transfer_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportQuizRequest.ProtoReflect.Descriptor instead.
+func (*ExportQuizRequest) Descriptor() ([]byte, []int) {
+	return file_transfer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExportQuizRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ImportQuizRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Document      *QuizDocument          `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportQuizRequest) Reset() {
+	*x = ImportQuizRequest{}
+	mi := &file_transfer_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportQuizRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportQuizRequest) ProtoMessage() {}
+
+func (x *ImportQuizRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transfer_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportQuizRequest.ProtoReflect.Descriptor instead.
+func (*ImportQuizRequest) Descriptor() ([]byte, []int) {
+	return file_transfer_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ImportQuizRequest) GetDocument() *QuizDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+var File_transfer_proto protoreflect.FileDescriptor
+
+const file_transfer_proto_rawDesc = "" +
+	"\n" +
+	"\x0etransfer.proto\x12\vtransfer.v1\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x0equestion.proto\x1a\n" +
+	"quiz.proto\"\xee\x06\n" +
+	"\fQuizDocument\x12%\n" +
+	"\x0eformat_version\x18\x01 \x01(\x05R\rformatVersion\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\aresults\x18\x03 \x03(\tR\aresults\x12<\n" +
+	"\x0eresult_details\x18\x04 \x03(\v2\x15.quiz.v1.ResultDetailR\rresultDetails\x12A\n" +
+	"\x10tie_break_policy\x18\x05 \x01(\x0e2\x17.quiz.v1.TieBreakPolicyR\x0etieBreakPolicy\x12$\n" +
+	"\x0etie_break_seed\x18\x06 \x01(\x03R\ftieBreakSeed\x12/\n" +
+	"\x13tiebreaker_question\x18\a \x01(\tR\x12tiebreakerQuestion\x12:\n" +
+	"\rscoring_model\x18\b \x01(\x0e2\x15.quiz.v1.ScoringModelR\fscoringModel\x121\n" +
+	"\n" +
+	"trait_axes\x18\t \x03(\v2\x12.quiz.v1.TraitAxisR\ttraitAxes\x12)\n" +
+	"\x10score_thresholds\x18\n" +
+	" \x03(\x02R\x0fscoreThresholds\x12,\n" +
+	"\x12time_limit_seconds\x18\v \x01(\x05R\x10timeLimitSeconds\x12=\n" +
+	"\x1bquestion_time_limit_seconds\x18\f \x01(\x05R\x18questionTimeLimitSeconds\x12+\n" +
+	"\x11early_termination\x18\r \x01(\bR\x10earlyTermination\x12:\n" +
+	"\rquestion_draw\x18\x0e \x01(\v2\x15.quiz.v1.QuestionDrawR\fquestionDraw\x12;\n" +
+	"\tquestions\x18\x0f \x03(\v2\x1d.transfer.v1.QuestionDocumentR\tquestions\x120\n" +
+	"\x05media\x18\x10 \x03(\v2\x1a.transfer.v1.MediaDocumentR\x05media\x12 \n" +
+	"\vdescription\x18\x11 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04tags\x18\x12 \x03(\tR\x04tags\x12\x1a\n" +
+	"\blanguage\x18\x13 \x01(\tR\blanguage\"\xea\x01\n" +
+	"\x10QuestionDocument\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\x12-\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x19.question.v1.QuestionTypeR\x04type\x12-\n" +
+	"\aoptions\x18\x04 \x03(\v2\x13.question.v1.OptionR\aoptions\x12(\n" +
+	"\x05route\x18\x05 \x01(\v2\x12.question.v1.RouteR\x05route\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12\x14\n" +
+	"\x05image\x18\a \x01(\tR\x05image\"X\n" +
+	"\rMediaDocument\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\"#\n" +
+	"\x11ExportQuizRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"J\n" +
+	"\x11ImportQuizRequest\x125\n" +
+	"\bdocument\x18\x01 \x01(\v2\x19.transfer.v1.QuizDocumentR\bdocument2\x91\x02\n" +
+	"\x0fTransferService\x12\x81\x01\n" +
+	"\n" +
+	"ExportQuiz\x12\x1e.transfer.v1.ExportQuizRequest\x1a\x19.transfer.v1.QuizDocument\"8\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/quizzes/{id}/export\x12z\n" +
+	"\n" +
+	"ImportQuiz\x12\x1e.transfer.v1.ImportQuizRequest\x1a\r.quiz.v1.Quiz\"=\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\":\bdocument\"\x16/api/v1/quizzes/importBPZNgithub.com/mibrgmv/whoami-server/quiz/internal/protogen/transfer/v1;transferv1b\x06proto3"
+
+var (
+	file_transfer_proto_rawDescOnce sync.Once
+	file_transfer_proto_rawDescData []byte
+)
+
+func file_transfer_proto_rawDescGZIP() []byte {
+	file_transfer_proto_rawDescOnce.Do(func() {
+		file_transfer_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_transfer_proto_rawDesc), len(file_transfer_proto_rawDesc)))
+	})
+	return file_transfer_proto_rawDescData
+}
+
+var file_transfer_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_transfer_proto_goTypes = []any{
+	(*QuizDocument)(nil),      // 0: transfer.v1.QuizDocument
+	(*QuestionDocument)(nil),  // 1: transfer.v1.QuestionDocument
+	(*MediaDocument)(nil),     // 2: transfer.v1.MediaDocument
+	(*ExportQuizRequest)(nil), // 3: transfer.v1.ExportQuizRequest
+	(*ImportQuizRequest)(nil), // 4: transfer.v1.ImportQuizRequest
+	(*v1.ResultDetail)(nil),   // 5: quiz.v1.ResultDetail
+	(v1.TieBreakPolicy)(0),    // 6: quiz.v1.TieBreakPolicy
+	(v1.ScoringModel)(0),      // 7: quiz.v1.ScoringModel
+	(*v1.TraitAxis)(nil),      // 8: quiz.v1.TraitAxis
+	(*v1.QuestionDraw)(nil),   // 9: quiz.v1.QuestionDraw
+	(v11.QuestionType)(0),     // 10: question.v1.QuestionType
+	(*v11.Option)(nil),        // 11: question.v1.Option
+	(*v11.Route)(nil),         // 12: question.v1.Route
+	(*v1.Quiz)(nil),           // 13: quiz.v1.Quiz
+}
+var file_transfer_proto_depIdxs = []int32{
+	5,  // 0: transfer.v1.QuizDocument.result_details:type_name -> quiz.v1.ResultDetail
+	6,  // 1: transfer.v1.QuizDocument.tie_break_policy:type_name -> quiz.v1.TieBreakPolicy
+	7,  // 2: transfer.v1.QuizDocument.scoring_model:type_name -> quiz.v1.ScoringModel
+	8,  // 3: transfer.v1.QuizDocument.trait_axes:type_name -> quiz.v1.TraitAxis
+	9,  // 4: transfer.v1.QuizDocument.question_draw:type_name -> quiz.v1.QuestionDraw
+	1,  // 5: transfer.v1.QuizDocument.questions:type_name -> transfer.v1.QuestionDocument
+	2,  // 6: transfer.v1.QuizDocument.media:type_name -> transfer.v1.MediaDocument
+	10, // 7: transfer.v1.QuestionDocument.type:type_name -> question.v1.QuestionType
+	11, // 8: transfer.v1.QuestionDocument.options:type_name -> question.v1.Option
+	12, // 9: transfer.v1.QuestionDocument.route:type_name -> question.v1.Route
+	0,  // 10: transfer.v1.ImportQuizRequest.document:type_name -> transfer.v1.QuizDocument
+	3,  // 11: transfer.v1.TransferService.ExportQuiz:input_type -> transfer.v1.ExportQuizRequest
+	4,  // 12: transfer.v1.TransferService.ImportQuiz:input_type -> transfer.v1.ImportQuizRequest
+	0,  // 13: transfer.v1.TransferService.ExportQuiz:output_type -> transfer.v1.QuizDocument
+	13, // 14: transfer.v1.TransferService.ImportQuiz:output_type -> quiz.v1.Quiz
+	13, // [13:15] is the sub-list for method output_type
+	11, // [11:13] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_transfer_proto_init() }
+func file_transfer_proto_init() {
+	if File_transfer_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_transfer_proto_rawDesc), len(file_transfer_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_transfer_proto_goTypes,
+		DependencyIndexes: file_transfer_proto_depIdxs,
+		MessageInfos:      file_transfer_proto_msgTypes,
+	}.Build()
+	File_transfer_proto = out.File
+	file_transfer_proto_goTypes = nil
+	file_transfer_proto_depIdxs = nil
+}
@@ -0,0 +1,197 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: translation.proto
+
+package translationv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TranslationService_PutQuizTranslation_FullMethodName    = "/translation.v1.TranslationService/PutQuizTranslation"
+	TranslationService_ListQuizTranslations_FullMethodName  = "/translation.v1.TranslationService/ListQuizTranslations"
+	TranslationService_DeleteQuizTranslation_FullMethodName = "/translation.v1.TranslationService/DeleteQuizTranslation"
+)
+
+// TranslationServiceClient is the client API for TranslationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TranslationServiceClient interface {
+	PutQuizTranslation(ctx context.Context, in *PutQuizTranslationRequest, opts ...grpc.CallOption) (*QuizTranslation, error)
+	ListQuizTranslations(ctx context.Context, in *ListQuizTranslationsRequest, opts ...grpc.CallOption) (*ListQuizTranslationsResponse, error)
+	DeleteQuizTranslation(ctx context.Context, in *DeleteQuizTranslationRequest, opts ...grpc.CallOption) (*DeleteQuizTranslationResponse, error)
+}
+
+type translationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTranslationServiceClient(cc grpc.ClientConnInterface) TranslationServiceClient {
+	return &translationServiceClient{cc}
+}
+
+func (c *translationServiceClient) PutQuizTranslation(ctx context.Context, in *PutQuizTranslationRequest, opts ...grpc.CallOption) (*QuizTranslation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuizTranslation)
+	err := c.cc.Invoke(ctx, TranslationService_PutQuizTranslation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *translationServiceClient) ListQuizTranslations(ctx context.Context, in *ListQuizTranslationsRequest, opts ...grpc.CallOption) (*ListQuizTranslationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQuizTranslationsResponse)
+	err := c.cc.Invoke(ctx, TranslationService_ListQuizTranslations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *translationServiceClient) DeleteQuizTranslation(ctx context.Context, in *DeleteQuizTranslationRequest, opts ...grpc.CallOption) (*DeleteQuizTranslationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteQuizTranslationResponse)
+	err := c.cc.Invoke(ctx, TranslationService_DeleteQuizTranslation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TranslationServiceServer is the server API for TranslationService service.
+// All implementations must embed UnimplementedTranslationServiceServer
+// for forward compatibility.
+type TranslationServiceServer interface {
+	PutQuizTranslation(context.Context, *PutQuizTranslationRequest) (*QuizTranslation, error)
+	ListQuizTranslations(context.Context, *ListQuizTranslationsRequest) (*ListQuizTranslationsResponse, error)
+	DeleteQuizTranslation(context.Context, *DeleteQuizTranslationRequest) (*DeleteQuizTranslationResponse, error)
+	mustEmbedUnimplementedTranslationServiceServer()
+}
+
+// UnimplementedTranslationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTranslationServiceServer struct{}
+
+func (UnimplementedTranslationServiceServer) PutQuizTranslation(context.Context, *PutQuizTranslationRequest) (*QuizTranslation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutQuizTranslation not implemented")
+}
+func (UnimplementedTranslationServiceServer) ListQuizTranslations(context.Context, *ListQuizTranslationsRequest) (*ListQuizTranslationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuizTranslations not implemented")
+}
+func (UnimplementedTranslationServiceServer) DeleteQuizTranslation(context.Context, *DeleteQuizTranslationRequest) (*DeleteQuizTranslationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQuizTranslation not implemented")
+}
+func (UnimplementedTranslationServiceServer) mustEmbedUnimplementedTranslationServiceServer() {}
+func (UnimplementedTranslationServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeTranslationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TranslationServiceServer will
+// result in compilation errors.
+type UnsafeTranslationServiceServer interface {
+	mustEmbedUnimplementedTranslationServiceServer()
+}
+
+func RegisterTranslationServiceServer(s grpc.ServiceRegistrar, srv TranslationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTranslationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TranslationService_ServiceDesc, srv)
+}
+
+func _TranslationService_PutQuizTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutQuizTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TranslationServiceServer).PutQuizTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TranslationService_PutQuizTranslation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TranslationServiceServer).PutQuizTranslation(ctx, req.(*PutQuizTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TranslationService_ListQuizTranslations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuizTranslationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TranslationServiceServer).ListQuizTranslations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TranslationService_ListQuizTranslations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TranslationServiceServer).ListQuizTranslations(ctx, req.(*ListQuizTranslationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TranslationService_DeleteQuizTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteQuizTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TranslationServiceServer).DeleteQuizTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TranslationService_DeleteQuizTranslation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TranslationServiceServer).DeleteQuizTranslation(ctx, req.(*DeleteQuizTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TranslationService_ServiceDesc is the grpc.ServiceDesc for TranslationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TranslationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "translation.v1.TranslationService",
+	HandlerType: (*TranslationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PutQuizTranslation",
+			Handler:    _TranslationService_PutQuizTranslation_Handler,
+		},
+		{
+			MethodName: "ListQuizTranslations",
+			Handler:    _TranslationService_ListQuizTranslations_Handler,
+		},
+		{
+			MethodName: "DeleteQuizTranslation",
+			Handler:    _TranslationService_DeleteQuizTranslation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "translation.proto",
+}
@@ -1,56 +1,158 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
 	"os"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	attemptv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/attempt/v1"
+	categoryv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/category/v1"
+	feedbackv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/feedback/v1"
+	guestv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/guest/v1"
+	historyv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/history/v1"
+	mediav1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/media/v1"
 	questionv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/question/v1"
 	quizv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/quiz/v1"
+	sharev1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/share/v1"
+	transferv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/transfer/v1"
+	translationv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/translation/v1"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/attempt"
+	attemptgrpc "github.com/mibrgmv/whoami-server/quiz/internal/service/attempt/grpc"
+	attemptpg "github.com/mibrgmv/whoami-server/quiz/internal/service/attempt/postgresql"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/category"
+	categorygrpc "github.com/mibrgmv/whoami-server/quiz/internal/service/category/grpc"
+	categorypg "github.com/mibrgmv/whoami-server/quiz/internal/service/category/postgresql"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/feedback"
+	feedbackgrpc "github.com/mibrgmv/whoami-server/quiz/internal/service/feedback/grpc"
+	feedbackpg "github.com/mibrgmv/whoami-server/quiz/internal/service/feedback/postgresql"
+	guestgrpc "github.com/mibrgmv/whoami-server/quiz/internal/service/guest/grpc"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/media"
+	mediagrpc "github.com/mibrgmv/whoami-server/quiz/internal/service/media/grpc"
+	mediapg "github.com/mibrgmv/whoami-server/quiz/internal/service/media/postgresql"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/outbox"
+	outboxpg "github.com/mibrgmv/whoami-server/quiz/internal/service/outbox/postgresql"
 	"github.com/mibrgmv/whoami-server/quiz/internal/service/question"
 	questiongrpc "github.com/mibrgmv/whoami-server/quiz/internal/service/question/grpc"
 	questionpg "github.com/mibrgmv/whoami-server/quiz/internal/service/question/postgresql"
 	"github.com/mibrgmv/whoami-server/quiz/internal/service/quiz"
 	quizgrpc "github.com/mibrgmv/whoami-server/quiz/internal/service/quiz/grpc"
 	quizpg "github.com/mibrgmv/whoami-server/quiz/internal/service/quiz/postgresql"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/share"
+	sharegrpc "github.com/mibrgmv/whoami-server/quiz/internal/service/share/grpc"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/transfer"
+	transfergrpc "github.com/mibrgmv/whoami-server/quiz/internal/service/transfer/grpc"
+	transferpg "github.com/mibrgmv/whoami-server/quiz/internal/service/transfer/postgresql"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/translation"
+	translationgrpc "github.com/mibrgmv/whoami-server/quiz/internal/service/translation/grpc"
+	translationpg "github.com/mibrgmv/whoami-server/quiz/internal/service/translation/postgresql"
 	"github.com/mibrgmv/whoami-server/shared/grpc/interceptor"
 	"github.com/mibrgmv/whoami-server/shared/storage/redis"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 )
 
 type GrpcServer struct {
-	grpcServer     *grpc.Server
-	questionServer *questiongrpc.QuestionService
+	grpcServer  *grpc.Server
+	historyConn *grpc.ClientConn
+	outbox      *outbox.Service
 }
 
-func NewGrpcServer(pool *pgxpool.Pool, redisClient *redis.Client, historyServiceAddr string) (*GrpcServer, error) {
+func NewGrpcServer(pool *pgxpool.Pool, redisClient *redis.Client, blobStore media.BlobStore, mediaConfig media.Config,
+	translationConfig translation.Config, outboxConfig outbox.Config, historyServiceAddr string) (*GrpcServer, error) {
 	logger := log.New(os.Stderr, "", log.Ldate|log.Ltime|log.Lshortfile)
 
 	s := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(interceptor.DefaultUnaryInterceptors(logger)...),
-		grpc.ChainStreamInterceptor(interceptor.DefaultStreamInterceptors(logger)...),
+		grpc.ChainUnaryInterceptor(
+			append(
+				interceptor.DefaultUnaryInterceptors(logger),
+				interceptor.UnaryMetadataInterceptor(),
+			)...,
+		),
+		grpc.ChainStreamInterceptor(
+			append(
+				interceptor.DefaultStreamInterceptors(logger),
+				interceptor.StreamMetadataInterceptor(),
+			)...,
+		),
 	)
 
+	historyConn, err := grpc.NewClient(historyServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to history service: %w", err)
+	}
+	historyClient := historyv1.NewHistoryServiceClient(historyConn)
+
+	outboxRepo := outboxpg.NewRepository(pool)
+	outboxService := outbox.NewService(outboxRepo, historyClient, outboxConfig)
+
+	mediaRepo := mediapg.NewRepository(pool)
+	mediaService := media.NewService(mediaRepo, blobStore, mediaConfig)
+
+	mediaServer := mediagrpc.NewService(mediaService)
+	mediav1.RegisterMediaServiceServer(s, mediaServer)
+
+	categoryRepo := categorypg.NewRepository(pool)
+	categoryService := category.NewService(categoryRepo)
+
+	categoryServer := categorygrpc.NewService(categoryService)
+	categoryv1.RegisterCategoryServiceServer(s, categoryServer)
+
 	quizRepo := quizpg.NewRepository(pool)
 	quizService := quiz.NewService(quizRepo)
-	quizServer := quizgrpc.NewService(quizService)
-	quizv1.RegisterQuizServiceServer(s, quizServer)
 
 	questionRepo := questionpg.NewRepository(pool)
 	questionService := question.NewService(questionRepo, redisClient)
-	questionServer, err := questiongrpc.NewService(questionService, quizService, historyServiceAddr)
+
+	translationRepo := translationpg.NewRepository(pool)
+	translationService := translation.NewService(translationRepo, translationConfig)
+
+	translationServer := translationgrpc.NewService(translationService, quizService, questionService)
+	translationv1.RegisterTranslationServiceServer(s, translationServer)
+
+	quizServer := quizgrpc.NewService(quizService, questionService, mediaService, categoryService, translationService, historyClient)
+	quizv1.RegisterQuizServiceServer(s, quizServer)
+
+	questionServer := questiongrpc.NewService(questionService, quizService, mediaService, translationService, outboxService)
+	questionv1.RegisterQuestionServiceServer(s, questionServer)
+
+	transferRepo := transferpg.NewRepository(pool)
+	transferService := transfer.NewService(transferRepo, questionService, mediaService)
+
+	transferServer := transfergrpc.NewService(transferService, quizService, categoryService)
+	transferv1.RegisterTransferServiceServer(s, transferServer)
+
+	attemptRepo := attemptpg.NewRepository(pool)
+	attemptService := attempt.NewService(attemptRepo, questionService)
+
+	attemptServer := attemptgrpc.NewService(attemptService, quizService, translationService)
+	attemptv1.RegisterAttemptServiceServer(s, attemptServer)
+
+	guestServer := guestgrpc.NewService(attemptService)
+	guestv1.RegisterGuestServiceServer(s, guestServer)
+
+	feedbackRepo := feedbackpg.NewRepository(pool)
+	feedbackService := feedback.NewService(feedbackRepo)
+
+	feedbackServer := feedbackgrpc.NewService(feedbackService, quizService)
+	feedbackv1.RegisterFeedbackServiceServer(s, feedbackServer)
+
+	shareService, err := share.NewService()
 	if err != nil {
-		return nil, fmt.Errorf("failed to create question service: %w", err)
+		return nil, fmt.Errorf("failed to create share service: %w", err)
 	}
-	questionv1.RegisterQuestionServiceServer(s, questionServer)
+
+	shareServer := sharegrpc.NewService(shareService, quizService, translationService, historyClient)
+	sharev1.RegisterShareServiceServer(s, shareServer)
 
 	reflection.Register(s)
 	return &GrpcServer{
-		grpcServer:     s,
-		questionServer: questionServer,
+		grpcServer:  s,
+		historyConn: historyConn,
+		outbox:      outboxService,
 	}, nil
 }
 
@@ -68,11 +170,17 @@ func (s *GrpcServer) Start(addr string) error {
 	return nil
 }
 
+// RunOutbox relays quiz completions to the history service until the context
+// is done.
+func (s *GrpcServer) RunOutbox(ctx context.Context) {
+	s.outbox.Run(ctx)
+}
+
 func (s *GrpcServer) Stop() {
 	s.grpcServer.GracefulStop()
 
-	if s.questionServer != nil {
-		if err := s.questionServer.Close(); err != nil {
+	if s.historyConn != nil {
+		if err := s.historyConn.Close(); err != nil {
 			log.Printf("Error closing history service connection: %v", err)
 		}
 	}
@@ -0,0 +1,279 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+	attemptv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/attempt/v1"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/attempt"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/question"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/quiz"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/translation"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type AttemptService struct {
+	service            *attempt.Service
+	quizService        *quiz.Service
+	translationService *translation.Service
+	attemptv1.UnimplementedAttemptServiceServer
+}
+
+func NewService(service *attempt.Service, quizService *quiz.Service, translationService *translation.Service) *AttemptService {
+	return &AttemptService{
+		service:            service,
+		quizService:        quizService,
+		translationService: translationService,
+	}
+}
+
+func (s *AttemptService) StartAttempt(ctx context.Context, request *attemptv1.StartAttemptRequest) (*attemptv1.Attempt, error) {
+	takerID, err := s.quizService.TakerID(ctx)
+	if err != nil {
+		if errors.Is(err, quiz.ErrNoQuizTaker) {
+			return nil, status.Errorf(codes.Unauthenticated, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to check guest session: %v", err)
+	}
+
+	q, err := s.getQuiz(ctx, request.QuizId)
+	if err != nil {
+		return nil, err
+	}
+
+	if q.Status == models.QuizStatusArchived {
+		return nil, status.Error(codes.FailedPrecondition, "quiz is archived")
+	}
+
+	version, err := s.quizService.EnsureVersion(ctx, q.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get quiz version: %v", err)
+	}
+
+	a, err := s.service.Start(ctx, q, version, takerID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to start attempt: %v", err)
+	}
+
+	return a.ToProto(), nil
+}
+
+func (s *AttemptService) GetAttempt(ctx context.Context, request *attemptv1.GetAttemptRequest) (*attemptv1.Attempt, error) {
+	q, err := s.getQuiz(ctx, request.QuizId)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := s.getAttempt(ctx, q, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := s.getVersion(ctx, q, a)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toProto(ctx, q, version, a)
+}
+
+func (s *AttemptService) SubmitAnswer(ctx context.Context, request *attemptv1.SubmitAnswerRequest) (*attemptv1.Attempt, error) {
+	if request.Answer == nil {
+		return nil, status.Error(codes.InvalidArgument, "answer is required")
+	}
+
+	q, err := s.getQuiz(ctx, request.QuizId)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := s.getAttempt(ctx, q, request.AttemptId)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Answer.QuizId == "" {
+		request.Answer.QuizId = request.QuizId
+	}
+
+	answer, err := models.AnswerToModel(request.Answer)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid answer format: %v", err)
+	}
+
+	version, err := s.getVersion(ctx, q, a)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err = s.service.SubmitAnswer(ctx, q, version, a, *answer)
+	if err != nil {
+		switch {
+		case errors.Is(err, attempt.ErrAttemptFinished):
+			return nil, status.Errorf(codes.FailedPrecondition, "failed to submit answer: %v", err)
+		case errors.Is(err, attempt.ErrTimeLimitExceeded):
+			return nil, status.Errorf(codes.FailedPrecondition, "late answer: %v", err)
+		case errors.Is(err, question.ErrAnswerQuizIdMismatch),
+			errors.Is(err, question.ErrInvalidAnswer):
+			return nil, status.Errorf(codes.InvalidArgument, "invalid answer: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to submit answer: %v", err)
+	}
+
+	return s.toProto(ctx, q, version, a)
+}
+
+func (s *AttemptService) GetNextQuestion(ctx context.Context, request *attemptv1.GetNextQuestionRequest) (*attemptv1.GetNextQuestionResponse, error) {
+	q, err := s.getQuiz(ctx, request.QuizId)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := s.getAttempt(ctx, q, request.AttemptId)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := s.getVersion(ctx, q, a)
+	if err != nil {
+		return nil, err
+	}
+
+	next, err := s.service.NextQuestion(ctx, q, version, a)
+	if err != nil {
+		switch {
+		case errors.Is(err, attempt.ErrAttemptFinished):
+			return nil, status.Errorf(codes.FailedPrecondition, "failed to get next question: %v", err)
+		case errors.Is(err, question.ErrInvalidQuestion):
+			return nil, status.Errorf(codes.FailedPrecondition, "invalid question flow: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get next question: %v", err)
+	}
+
+	if next == nil {
+		return &attemptv1.GetNextQuestionResponse{Completed: true}, nil
+	}
+
+	localization, err := s.translationService.Localize(ctx, q)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to localize quiz: %v", err)
+	}
+
+	next = localization.Question(next)
+	if request.ShuffleOptions {
+		next = next.WithShuffledOptions()
+	}
+
+	return &attemptv1.GetNextQuestionResponse{Question: next.ToProtoWithoutWeights()}, nil
+}
+
+func (s *AttemptService) FinishAttempt(ctx context.Context, request *attemptv1.FinishAttemptRequest) (*attemptv1.Attempt, error) {
+	q, err := s.getQuiz(ctx, request.QuizId)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := s.getAttempt(ctx, q, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := s.getVersion(ctx, q, a)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err = s.service.Finish(ctx, q, version, a)
+	if err != nil {
+		switch {
+		case errors.Is(err, attempt.ErrAttemptFinished):
+			return nil, status.Errorf(codes.FailedPrecondition, "failed to finish attempt: %v", err)
+		case errors.Is(err, question.ErrNoAnswers),
+			errors.Is(err, question.ErrIncompleteAnswers),
+			errors.Is(err, question.ErrDuplicateAnswer),
+			errors.Is(err, question.ErrInvalidAnswer):
+			return nil, status.Errorf(codes.FailedPrecondition, "invalid answers: %v", err)
+		case errors.Is(err, question.ErrTiebreakerQuestionNotSet),
+			errors.Is(err, question.ErrTiebreakerAnswerRequired):
+			return nil, status.Errorf(codes.FailedPrecondition, "failed to break tie: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to finish attempt: %v", err)
+	}
+
+	return s.toProto(ctx, q, version, a)
+}
+
+// toProto shows the results of a finished attempt in the language negotiated
+// for the quiz.
+func (s *AttemptService) toProto(ctx context.Context, q *models.Quiz, version *models.QuizVersion, a *models.Attempt) (*attemptv1.Attempt, error) {
+	if a.Evaluation == nil {
+		return a.ToProto(), nil
+	}
+
+	localization, err := s.translationService.Localize(ctx, q)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to localize quiz: %v", err)
+	}
+
+	localized := *a
+	localized.Evaluation = localization.Evaluation(version.Results, a.Evaluation)
+	return localized.ToProto(), nil
+}
+
+func (s *AttemptService) getQuiz(ctx context.Context, quizIDStr string) (*models.Quiz, error) {
+	quizID, err := uuid.Parse(quizIDStr)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid quiz ID format: %v", err)
+	}
+
+	q, err := s.quizService.GetByID(ctx, quizID)
+	if err != nil {
+		if errors.Is(err, quiz.ErrQuizNotFound) {
+			return nil, status.Errorf(codes.NotFound, "quiz not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get quiz: %v", err)
+	}
+
+	if !s.quizService.CanView(ctx, q) {
+		return nil, status.Errorf(codes.NotFound, "quiz not found: %v", quiz.ErrQuizNotFound)
+	}
+
+	return q, nil
+}
+
+func (s *AttemptService) getAttempt(ctx context.Context, q *models.Quiz, attemptIDStr string) (*models.Attempt, error) {
+	takerID, err := s.quizService.TakerID(ctx)
+	if err != nil {
+		if errors.Is(err, quiz.ErrNoQuizTaker) {
+			return nil, status.Errorf(codes.Unauthenticated, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to check guest session: %v", err)
+	}
+
+	attemptID, err := uuid.Parse(attemptIDStr)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid attempt ID format: %v", err)
+	}
+
+	a, err := s.service.Get(ctx, q.ID, attemptID, takerID)
+	if err != nil {
+		if errors.Is(err, attempt.ErrAttemptNotFound) {
+			return nil, status.Errorf(codes.NotFound, "attempt not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get attempt: %v", err)
+	}
+
+	return a, nil
+}
+
+// getVersion returns the quiz version the attempt was started on.
+func (s *AttemptService) getVersion(ctx context.Context, q *models.Quiz, a *models.Attempt) (*models.QuizVersion, error) {
+	version, err := s.quizService.GetVersion(ctx, q.ID, a.QuizVersionID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get quiz version: %v", err)
+	}
+	return version, nil
+}
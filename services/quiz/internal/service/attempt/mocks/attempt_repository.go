@@ -0,0 +1,40 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/attempt"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Start(ctx context.Context, a *models.Attempt) (*models.Attempt, error) {
+	args := m.Called(ctx, a)
+	return args.Get(0).(*models.Attempt), args.Error(1)
+}
+
+func (m *MockRepository) Query(ctx context.Context, query attempt.Query) ([]*models.Attempt, error) {
+	args := m.Called(ctx, query)
+	return args.Get(0).([]*models.Attempt), args.Error(1)
+}
+
+func (m *MockRepository) SaveAnswer(ctx context.Context, attemptID uuid.UUID, answer models.Answer) (time.Time, error) {
+	args := m.Called(ctx, attemptID, answer)
+	return args.Get(0).(time.Time), args.Error(1)
+}
+
+func (m *MockRepository) Finish(ctx context.Context, attemptID uuid.UUID, evaluation *models.Evaluation, finishedAt time.Time, message *models.OutboxMessage) error {
+	args := m.Called(ctx, attemptID, evaluation, finishedAt, message)
+	return args.Error(0)
+}
+
+func (m *MockRepository) Merge(ctx context.Context, fromUserID, toUserID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, fromUserID, toUserID)
+	return args.Get(0).(int64), args.Error(1)
+}
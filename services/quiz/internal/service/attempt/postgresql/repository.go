@@ -0,0 +1,222 @@
+package postgresql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/attempt"
+)
+
+type Repository struct {
+	pool *pgxpool.Pool
+}
+
+func NewRepository(pool *pgxpool.Pool) *Repository {
+	return &Repository{pool: pool}
+}
+
+func (r *Repository) Start(ctx context.Context, a *models.Attempt) (*models.Attempt, error) {
+	sql := `
+	insert into attempts (attempt_id, quiz_id, quiz_version_id, user_id, attempt_status,
+	                      time_limit_seconds, question_time_limit_seconds, draw_seed, drawn_question_ids)
+	values ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	on conflict (user_id, quiz_id) where attempt_status = 'in_progress' do nothing
+	`
+
+	_, err := r.pool.Exec(ctx, sql, a.ID, a.QuizID, a.QuizVersionID, a.UserID, models.AttemptStatusInProgress,
+		a.TimeLimitSeconds, a.QuestionTimeLimitSeconds, a.DrawSeed, a.QuestionIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert attempt: %w", err)
+	}
+
+	attempts, err := r.Query(ctx, attempt.Query{
+		UserID: &a.UserID,
+		QuizID: &a.QuizID,
+		Status: models.AttemptStatusInProgress,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(attempts) == 0 {
+		return nil, attempt.ErrAttemptNotFound
+	}
+
+	return attempts[0], nil
+}
+
+func (r *Repository) Query(ctx context.Context, query attempt.Query) ([]*models.Attempt, error) {
+	sql := `
+	select a.attempt_id,
+	       a.quiz_id,
+	       a.quiz_version_id,
+	       a.user_id,
+	       a.attempt_status,
+	       a.attempt_evaluation,
+	       a.started_at,
+	       a.finished_at,
+	       a.time_limit_seconds,
+	       a.question_time_limit_seconds,
+	       a.draw_seed,
+	       a.drawn_question_ids,
+	       answers.answers,
+	       answers.last_answered_at
+	from attempts a
+	         cross join lateral (
+	    select coalesce(jsonb_agg(aa.answer order by aa.answered_at), '[]') as answers,
+	           max(aa.answered_at)                                          as last_answered_at
+	    from attempt_answers aa
+	    where aa.attempt_id = a.attempt_id
+	    ) answers
+	where ($1::uuid[] is null or cardinality($1) = 0 or a.attempt_id = any ($1))
+	  and ($2::uuid is null or a.user_id = $2)
+	  and ($3::uuid is null or a.quiz_id = $3)
+	  and ($4 = '' or a.attempt_status = $4)
+	order by a.started_at
+	`
+
+	rows, err := r.pool.Query(ctx, sql, query.Ids, query.UserID, query.QuizID, string(query.Status))
+	if err != nil {
+		return nil, fmt.Errorf("query failed: %w", err)
+	}
+	defer rows.Close()
+
+	var attempts []*models.Attempt
+	for rows.Next() {
+		a := new(models.Attempt)
+		var evaluationJSON, answersJSON []byte
+
+		if err := rows.Scan(&a.ID, &a.QuizID, &a.QuizVersionID, &a.UserID, &a.Status,
+			&evaluationJSON, &a.StartedAt, &a.FinishedAt, &a.TimeLimitSeconds, &a.QuestionTimeLimitSeconds,
+			&a.DrawSeed, &a.QuestionIDs, &answersJSON, &a.LastAnsweredAt); err != nil {
+			return nil, fmt.Errorf("scan failed: %w", err)
+		}
+
+		if evaluationJSON != nil {
+			if err := json.Unmarshal(evaluationJSON, &a.Evaluation); err != nil {
+				return nil, fmt.Errorf("unmarshal failed: %w", err)
+			}
+		}
+
+		if err := json.Unmarshal(answersJSON, &a.Answers); err != nil {
+			return nil, fmt.Errorf("unmarshal failed: %w", err)
+		}
+
+		attempts = append(attempts, a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return attempts, nil
+}
+
+func (r *Repository) SaveAnswer(ctx context.Context, attemptID uuid.UUID, answer models.Answer) (time.Time, error) {
+	sql := `
+	insert into attempt_answers (attempt_id, question_id, answer)
+	select attempt_id, $2, $3
+	from attempts
+	where attempt_id = $1
+	  and attempt_status = 'in_progress'
+	on conflict (attempt_id, question_id) do update
+	    set answer      = excluded.answer,
+	        answered_at = now()
+	returning answered_at
+	`
+
+	answerJSON, err := json.Marshal(answer)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to marshal answer: %w", err)
+	}
+
+	var answeredAt time.Time
+	err = r.pool.QueryRow(ctx, sql, attemptID, answer.QuestionID, answerJSON).Scan(&answeredAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return time.Time{}, attempt.ErrAttemptFinished
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to save answer: %w", err)
+	}
+
+	return answeredAt, nil
+}
+
+func (r *Repository) Finish(ctx context.Context, attemptID uuid.UUID, evaluation *models.Evaluation, finishedAt time.Time, message *models.OutboxMessage) error {
+	sql := `
+	with finished as (
+	    update attempts
+	    set attempt_status     = 'finished',
+	        attempt_evaluation = $2,
+	        finished_at        = $3
+	    where attempt_id = $1
+	      and attempt_status = 'in_progress'
+	    returning quiz_id
+	), counted as (
+	    update quizzes
+	    set completion_count = completion_count + 1
+	    where quiz_id = (select quiz_id from finished)
+	), queued as (
+	    insert into history_outbox (idempotency_key, history_item)
+	    select $4, $5
+	    from finished
+	    on conflict (idempotency_key) do nothing
+	)
+	select quiz_id
+	from finished
+	`
+
+	evaluationJSON, err := json.Marshal(evaluation)
+	if err != nil {
+		return fmt.Errorf("failed to marshal evaluation: %w", err)
+	}
+
+	itemJSON, err := message.MarshalItem()
+	if err != nil {
+		return err
+	}
+
+	var quizID uuid.UUID
+	err = r.pool.QueryRow(ctx, sql, attemptID, evaluationJSON, finishedAt, message.IdempotencyKey, itemJSON).Scan(&quizID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return attempt.ErrAttemptFinished
+	}
+	if err != nil {
+		return fmt.Errorf("failed to finish attempt: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) Merge(ctx context.Context, fromUserID, toUserID uuid.UUID) (int64, error) {
+	sql := `
+	with ended as (
+	    insert into ended_guest_sessions (guest_id, user_id)
+	    values ($1, $2)
+	    on conflict (guest_id) do nothing
+	)
+	update attempts a
+	set user_id = $2
+	where a.user_id = $1
+	  and (a.attempt_status <> 'in_progress'
+	    or not exists (select 1
+	                   from attempts u
+	                   where u.user_id = $2
+	                     and u.quiz_id = a.quiz_id
+	                     and u.attempt_status = 'in_progress'))
+	`
+
+	tag, err := r.pool.Exec(ctx, sql, fromUserID, toUserID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to merge attempts: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
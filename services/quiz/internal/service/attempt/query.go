@@ -0,0 +1,13 @@
+package attempt
+
+import (
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+)
+
+type Query struct {
+	Ids    []uuid.UUID
+	UserID *uuid.UUID
+	QuizID *uuid.UUID
+	Status models.AttemptStatus
+}
@@ -0,0 +1,17 @@
+package attempt
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+)
+
+type Repository interface {
+	Start(ctx context.Context, attempt *models.Attempt) (*models.Attempt, error)
+	Query(ctx context.Context, query Query) ([]*models.Attempt, error)
+	SaveAnswer(ctx context.Context, attemptID uuid.UUID, answer models.Answer) (time.Time, error)
+	Finish(ctx context.Context, attemptID uuid.UUID, evaluation *models.Evaluation, finishedAt time.Time, message *models.OutboxMessage) error
+	Merge(ctx context.Context, fromUserID, toUserID uuid.UUID) (int64, error)
+}
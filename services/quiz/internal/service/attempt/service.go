@@ -0,0 +1,177 @@
+package attempt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/question"
+	"github.com/mibrgmv/whoami-server/shared/guest"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrAttemptNotFound   = errors.New("attempt not found")
+	ErrAttemptFinished   = errors.New("attempt is already finished")
+	ErrTimeLimitExceeded = errors.New("time limit exceeded")
+	ErrNotGuestSession   = errors.New("not a guest session")
+)
+
+type Service struct {
+	repo      Repository
+	questions *question.Service
+}
+
+func NewService(repo Repository, questions *question.Service) *Service {
+	return &Service{
+		repo:      repo,
+		questions: questions,
+	}
+}
+
+// Start returns the unfinished attempt of the user at the quiz, if there is
+// one, so that a client can resume after a disconnect, or starts a new one on
+// the given version of the quiz. A new attempt at a quiz with a question draw
+// records the seed and the drawn questions, which are the only ones it can
+// answer.
+func (s *Service) Start(ctx context.Context, quiz *models.Quiz, version *models.QuizVersion, userID uuid.UUID) (*models.Attempt, error) {
+	seed := rand.Int64()
+	drawn, err := s.questions.AtVersion(version).Draw(ctx, version.Apply(quiz), seed)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.Start(ctx, &models.Attempt{
+		ID:            uuid.New(),
+		QuizID:        quiz.ID,
+		QuizVersionID: version.ID,
+		UserID:        userID,
+		Status:        models.AttemptStatusInProgress,
+
+		TimeLimitSeconds:         quiz.TimeLimitSeconds,
+		QuestionTimeLimitSeconds: quiz.QuestionTimeLimitSeconds,
+		DrawSeed:                 seed,
+		QuestionIDs:              drawn,
+	})
+}
+
+// Get returns the attempt if it belongs to the given quiz and user.
+func (s *Service) Get(ctx context.Context, quizID, attemptID, userID uuid.UUID) (*models.Attempt, error) {
+	attempts, err := s.repo.Query(ctx, Query{Ids: []uuid.UUID{attemptID}})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(attempts) == 0 || attempts[0].QuizID != quizID || attempts[0].UserID != userID {
+		return nil, ErrAttemptNotFound
+	}
+
+	return attempts[0], nil
+}
+
+// SubmitAnswer validates the answer against the questions of the quiz version
+// the attempt was started on and records it, replacing an earlier answer to the same question. Only
+// questions on the path the recorded answers lead along can be answered. Answers
+// arriving after the attempt deadline or the per-question time limit are
+// rejected with ErrTimeLimitExceeded.
+func (s *Service) SubmitAnswer(ctx context.Context, quiz *models.Quiz, version *models.QuizVersion, attempt *models.Attempt, answer models.Answer) (*models.Attempt, error) {
+	if attempt.Status != models.AttemptStatusInProgress {
+		return nil, ErrAttemptFinished
+	}
+
+	if attempt.TimedOut(time.Now()) {
+		return nil, fmt.Errorf("%w: the answer was due at %s", ErrTimeLimitExceeded, attempt.AnswerDeadline().Format(time.RFC3339))
+	}
+
+	if err := s.questions.AtVersion(version).CheckAnswer(ctx, version.Apply(quiz), attempt.Answers, answer, attempt.QuestionIDs); err != nil {
+		return nil, err
+	}
+
+	answeredAt, err := s.repo.SaveAnswer(ctx, attempt.ID, answer)
+	if err != nil {
+		return nil, err
+	}
+
+	attempt.SetAnswer(answer)
+	attempt.LastAnsweredAt = &answeredAt
+	return attempt, nil
+}
+
+// NextQuestion returns the question the attempt continues with, or nil when
+// the attempt can be finished.
+func (s *Service) NextQuestion(ctx context.Context, quiz *models.Quiz, version *models.QuizVersion, attempt *models.Attempt) (*models.Question, error) {
+	if attempt.Status != models.AttemptStatusInProgress {
+		return nil, ErrAttemptFinished
+	}
+
+	return s.questions.AtVersion(version).NextQuestion(ctx, version.Apply(quiz), attempt.Answers, attempt.QuestionIDs)
+}
+
+// Finish evaluates the recorded answers against the quiz version the attempt
+// was started on and closes the attempt. An attempt that ran out of time is
+// evaluated with the answers it got, otherwise every question must be answered. Only one call can finish an attempt, every other
+// one gets ErrAttemptFinished. The completion is queued for the history
+// service together with closing the attempt, so it is recorded exactly once.
+func (s *Service) Finish(ctx context.Context, quiz *models.Quiz, version *models.QuizVersion, attempt *models.Attempt) (*models.Attempt, error) {
+	if attempt.Status != models.AttemptStatusInProgress {
+		return nil, ErrAttemptFinished
+	}
+
+	questions := s.questions.AtVersion(version)
+	quiz = version.Apply(quiz)
+
+	finishedAt := time.Now()
+
+	var evaluation *models.Evaluation
+	var err error
+	if attempt.TimedOut(finishedAt) {
+		evaluation, err = questions.EvaluatePartialAnswers(ctx, attempt.Answers, quiz, attempt.QuestionIDs)
+	} else {
+		evaluation, err = questions.EvaluateAnswers(ctx, attempt.Answers, quiz, attempt.QuestionIDs)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	finished := *attempt
+	finished.Status = models.AttemptStatusFinished
+	finished.Evaluation = evaluation
+	finished.FinishedAt = &finishedAt
+
+	item := evaluation.ToHistoryItem(attempt.UserID, attempt.QuizID, attempt.QuizVersionID)
+	item.ElapsedTime = durationpb.New(finished.ElapsedTime())
+	item.CompletedAt = timestamppb.New(finishedAt)
+
+	message := &models.OutboxMessage{
+		IdempotencyKey: models.AttemptIdempotencyKey(attempt.ID),
+		Item:           item,
+	}
+
+	if err := s.repo.Finish(ctx, attempt.ID, evaluation, finishedAt, message); err != nil {
+		return nil, err
+	}
+
+	*attempt = finished
+	return attempt, nil
+}
+
+// MergeGuest moves the attempts of a guest session to the user the guest signed
+// in as, so that they can go on with an unfinished attempt, and ends the guest
+// session. An unfinished attempt at a quiz the user is already taking stays
+// with the guest.
+func (s *Service) MergeGuest(ctx context.Context, guestID, userID uuid.UUID) (int64, error) {
+	if !guest.IsID(guestID) {
+		return 0, ErrNotGuestSession
+	}
+
+	if guestID == userID {
+		return 0, nil
+	}
+
+	return s.repo.Merge(ctx, guestID, userID)
+}
@@ -0,0 +1,306 @@
+package attempt_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/attempt"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/attempt/mocks"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/question"
+	questionmocks "github.com/mibrgmv/whoami-server/quiz/internal/service/question/mocks"
+	"github.com/mibrgmv/whoami-server/shared/guest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type fixture struct {
+	repo     *mocks.MockRepository
+	service  *attempt.Service
+	quiz     *models.Quiz
+	version  *models.QuizVersion
+	question *models.Question
+	yesID    uuid.UUID
+}
+
+func newFixture() *fixture {
+	quizID := uuid.New()
+	yesID := uuid.New()
+
+	q := &models.Question{
+		ID:     uuid.New(),
+		QuizID: quizID,
+		Body:   "Do you like drinking gasoline?",
+		Options: []models.Option{
+			{ID: yesID, Text: "Yes", Weights: []float32{0, 1}},
+			{ID: uuid.New(), Text: "No", Weights: []float32{1, 0}},
+		},
+	}
+
+	// The quiz was edited after the attempts were started: the current
+	// question flips the weights of the one in the version.
+	edited := *q
+	edited.Options = []models.Option{
+		{ID: yesID, Text: "Yes", Weights: []float32{1, 0}},
+		{ID: q.Options[1].ID, Text: "No", Weights: []float32{0, 1}},
+	}
+
+	questionRepo := new(questionmocks.MockRepository)
+	questionCache := new(questionmocks.MockCache)
+	questionCache.On("Get", mock.Anything, mock.Anything, mock.AnythingOfType("*[]*models.Question")).Run(func(args mock.Arguments) {
+		dest := args.Get(2).(*[]*models.Question)
+		*dest = []*models.Question{&edited}
+	}).Return(nil)
+
+	repo := new(mocks.MockRepository)
+	return &fixture{
+		repo:    repo,
+		service: attempt.NewService(repo, question.NewService(questionRepo, questionCache)),
+		quiz:    &models.Quiz{ID: quizID, Results: []string{"Franklin", "Trevor"}},
+		version: &models.QuizVersion{
+			ID:        uuid.New(),
+			QuizID:    quizID,
+			Version:   1,
+			Results:   []string{"Franklin", "Trevor"},
+			Questions: []*models.Question{q},
+		},
+		question: q,
+		yesID:    yesID,
+	}
+}
+
+func (f *fixture) attempt() *models.Attempt {
+	return &models.Attempt{
+		ID:            uuid.New(),
+		QuizID:        f.quiz.ID,
+		QuizVersionID: f.version.ID,
+		UserID:        uuid.New(),
+		Status:        models.AttemptStatusInProgress,
+		StartedAt:     time.Now(),
+	}
+}
+
+func TestStart_DrawsQuestions(t *testing.T) {
+	f := newFixture()
+	f.quiz.QuestionDraw = &models.QuestionDraw{Count: 1}
+
+	var started *models.Attempt
+	f.repo.On("Start", mock.Anything, mock.AnythingOfType("*models.Attempt")).Run(func(args mock.Arguments) {
+		started = args.Get(1).(*models.Attempt)
+	}).Return(&models.Attempt{}, nil)
+
+	_, err := f.service.Start(context.Background(), f.quiz, f.version, uuid.New())
+	assert.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{f.question.ID}, started.QuestionIDs)
+}
+
+func TestGet_OtherUser(t *testing.T) {
+	f := newFixture()
+	a := f.attempt()
+
+	f.repo.On("Query", mock.Anything, attempt.Query{Ids: []uuid.UUID{a.ID}}).Return([]*models.Attempt{a}, nil)
+
+	got, err := f.service.Get(context.Background(), f.quiz.ID, a.ID, a.UserID)
+	assert.NoError(t, err)
+	assert.Equal(t, a, got)
+
+	_, err = f.service.Get(context.Background(), f.quiz.ID, a.ID, uuid.New())
+	assert.ErrorIs(t, err, attempt.ErrAttemptNotFound)
+
+	_, err = f.service.Get(context.Background(), uuid.New(), a.ID, a.UserID)
+	assert.ErrorIs(t, err, attempt.ErrAttemptNotFound)
+}
+
+func TestSubmitAnswer(t *testing.T) {
+	f := newFixture()
+	a := f.attempt()
+	ctx := context.Background()
+
+	f.repo.On("SaveAnswer", mock.Anything, a.ID, mock.AnythingOfType("models.Answer")).Return(time.Now(), nil)
+
+	invalid := models.Answer{QuizID: f.quiz.ID, QuestionID: f.question.ID, OptionID: uuid.New()}
+	_, err := f.service.SubmitAnswer(ctx, f.quiz, f.version, a, invalid)
+	assert.ErrorIs(t, err, question.ErrInvalidAnswer)
+
+	unknown := models.Answer{QuizID: f.quiz.ID, QuestionID: uuid.New(), OptionID: f.yesID}
+	_, err = f.service.SubmitAnswer(ctx, f.quiz, f.version, a, unknown)
+	assert.ErrorIs(t, err, question.ErrInvalidAnswer)
+	f.repo.AssertNotCalled(t, "SaveAnswer", mock.Anything, mock.Anything, mock.Anything)
+
+	first := models.Answer{QuizID: f.quiz.ID, QuestionID: f.question.ID, Body: "No"}
+	second := models.Answer{QuizID: f.quiz.ID, QuestionID: f.question.ID, OptionID: f.yesID}
+
+	_, err = f.service.SubmitAnswer(ctx, f.quiz, f.version, a, first)
+	assert.NoError(t, err)
+	updated, err := f.service.SubmitAnswer(ctx, f.quiz, f.version, a, second)
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Answer{second}, updated.Answers)
+}
+
+func TestSubmitAnswer_FinishedAttempt(t *testing.T) {
+	f := newFixture()
+	a := f.attempt()
+	a.Status = models.AttemptStatusFinished
+
+	_, err := f.service.SubmitAnswer(context.Background(), f.quiz, f.version, a, models.Answer{QuizID: f.quiz.ID, QuestionID: f.question.ID, OptionID: f.yesID})
+	assert.ErrorIs(t, err, attempt.ErrAttemptFinished)
+	f.repo.AssertNotCalled(t, "SaveAnswer", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestFinish(t *testing.T) {
+	f := newFixture()
+	a := f.attempt()
+	a.Answers = []models.Answer{{QuizID: f.quiz.ID, QuestionID: f.question.ID, OptionID: f.yesID}}
+
+	var message *models.OutboxMessage
+	f.repo.On("Finish", mock.Anything, a.ID, mock.AnythingOfType("*models.Evaluation"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("*models.OutboxMessage")).Run(func(args mock.Arguments) {
+		message = args.Get(4).(*models.OutboxMessage)
+	}).Return(nil).Once()
+	f.repo.On("Finish", mock.Anything, a.ID, mock.AnythingOfType("*models.Evaluation"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("*models.OutboxMessage")).Return(attempt.ErrAttemptFinished)
+
+	concurrent := *a
+
+	finished, err := f.service.Finish(context.Background(), f.quiz, f.version, a)
+	assert.NoError(t, err)
+	assert.Equal(t, models.AttemptStatusFinished, finished.Status)
+	assert.Equal(t, "Trevor", finished.Evaluation.Result)
+	assert.NotNil(t, finished.FinishedAt)
+
+	assert.Equal(t, models.AttemptIdempotencyKey(a.ID), message.IdempotencyKey)
+	assert.Equal(t, a.UserID.String(), message.Item.UserId)
+	assert.Equal(t, "Trevor", message.Item.QuizResult)
+	assert.True(t, message.Item.CompletedAt.AsTime().Equal(*finished.FinishedAt))
+
+	_, err = f.service.Finish(context.Background(), f.quiz, f.version, finished)
+	assert.ErrorIs(t, err, attempt.ErrAttemptFinished)
+
+	_, err = f.service.Finish(context.Background(), f.quiz, f.version, &concurrent)
+	assert.ErrorIs(t, err, attempt.ErrAttemptFinished)
+	assert.Equal(t, models.AttemptStatusInProgress, concurrent.Status)
+	f.repo.AssertNumberOfCalls(t, "Finish", 2)
+}
+
+func TestFinish_StartedVersion(t *testing.T) {
+	f := newFixture()
+	f.quiz.Results = []string{"Michael", "Lamar"}
+	a := f.attempt()
+	a.Answers = []models.Answer{{QuizID: f.quiz.ID, QuestionID: f.question.ID, OptionID: f.yesID}}
+
+	f.repo.On("Finish", mock.Anything, a.ID, mock.AnythingOfType("*models.Evaluation"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("*models.OutboxMessage")).Return(nil)
+
+	finished, err := f.service.Finish(context.Background(), f.quiz, f.version, a)
+	assert.NoError(t, err)
+	assert.Equal(t, "Trevor", finished.Evaluation.Result, "the attempt is scored against the version it was started on")
+}
+
+func TestFinish_IncompleteAnswers(t *testing.T) {
+	f := newFixture()
+	a := f.attempt()
+
+	_, err := f.service.Finish(context.Background(), f.quiz, f.version, a)
+	assert.ErrorIs(t, err, question.ErrNoAnswers)
+	assert.Equal(t, models.AttemptStatusInProgress, a.Status)
+	f.repo.AssertNotCalled(t, "Finish", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSubmitAnswer_TimeLimits(t *testing.T) {
+	tests := []struct {
+		name        string
+		started     time.Duration
+		answered    time.Duration
+		limit       int32
+		perQuestion int32
+		wantErr     error
+	}{
+		{name: "No limits", started: -time.Hour},
+		{name: "Within the time limit", started: -time.Minute, limit: 120},
+		{name: "After the time limit", started: -3 * time.Minute, limit: 120, wantErr: attempt.ErrTimeLimitExceeded},
+		{name: "Within the question time limit", started: -time.Minute, answered: -10 * time.Second, perQuestion: 30},
+		{name: "After the question time limit", started: -time.Minute, answered: -40 * time.Second, perQuestion: 30, wantErr: attempt.ErrTimeLimitExceeded},
+		{name: "First answer after the question time limit", started: -time.Minute, perQuestion: 30, wantErr: attempt.ErrTimeLimitExceeded},
+		{name: "Question time limit past the time limit", started: -50 * time.Second, answered: -5 * time.Second, limit: 45, perQuestion: 30, wantErr: attempt.ErrTimeLimitExceeded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			a := f.attempt()
+			a.StartedAt = time.Now().Add(tt.started)
+			a.TimeLimitSeconds = tt.limit
+			a.QuestionTimeLimitSeconds = tt.perQuestion
+			if tt.answered != 0 {
+				answeredAt := time.Now().Add(tt.answered)
+				a.LastAnsweredAt = &answeredAt
+			}
+
+			f.repo.On("SaveAnswer", mock.Anything, a.ID, mock.AnythingOfType("models.Answer")).Return(time.Now(), nil)
+
+			_, err := f.service.SubmitAnswer(context.Background(), f.quiz, f.version, a, models.Answer{QuizID: f.quiz.ID, QuestionID: f.question.ID, OptionID: f.yesID})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				f.repo.AssertNotCalled(t, "SaveAnswer", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestFinish_TimedOut(t *testing.T) {
+	f := newFixture()
+	a := f.attempt()
+	a.StartedAt = time.Now().Add(-10 * time.Minute)
+	a.TimeLimitSeconds = 60
+
+	var message *models.OutboxMessage
+	f.repo.On("Finish", mock.Anything, a.ID, mock.AnythingOfType("*models.Evaluation"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("*models.OutboxMessage")).Run(func(args mock.Arguments) {
+		message = args.Get(4).(*models.OutboxMessage)
+	}).Return(nil)
+
+	finished, err := f.service.Finish(context.Background(), f.quiz, f.version, a)
+	assert.NoError(t, err)
+	assert.Equal(t, "Franklin", finished.Evaluation.Result)
+	assert.Equal(t, time.Minute, finished.ElapsedTime())
+	assert.Equal(t, time.Minute, message.Item.ElapsedTime.AsDuration())
+}
+
+func TestNextQuestion(t *testing.T) {
+	f := newFixture()
+	a := f.attempt()
+	ctx := context.Background()
+
+	next, err := f.service.NextQuestion(ctx, f.quiz, f.version, a)
+	assert.NoError(t, err)
+	assert.Equal(t, f.question, next)
+
+	a.Answers = []models.Answer{{QuizID: f.quiz.ID, QuestionID: f.question.ID, OptionID: f.yesID}}
+	next, err = f.service.NextQuestion(ctx, f.quiz, f.version, a)
+	assert.NoError(t, err)
+	assert.Nil(t, next)
+
+	a.Status = models.AttemptStatusFinished
+	_, err = f.service.NextQuestion(ctx, f.quiz, f.version, a)
+	assert.ErrorIs(t, err, attempt.ErrAttemptFinished)
+}
+
+func TestMergeGuest(t *testing.T) {
+	f := newFixture()
+	guestID := guest.NewID()
+	userID := uuid.New()
+
+	f.repo.On("Merge", mock.Anything, guestID, userID).Return(int64(2), nil)
+
+	merged, err := f.service.MergeGuest(context.Background(), guestID, userID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), merged)
+
+	_, err = f.service.MergeGuest(context.Background(), uuid.New(), userID)
+	assert.ErrorIs(t, err, attempt.ErrNotGuestSession)
+
+	merged, err = f.service.MergeGuest(context.Background(), guestID, guestID)
+	assert.NoError(t, err)
+	assert.Zero(t, merged)
+	f.repo.AssertNumberOfCalls(t, "Merge", 1)
+}
@@ -0,0 +1,184 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/google/uuid"
+	categoryv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/category/v1"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/category"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/quiz"
+	"github.com/mibrgmv/whoami-server/shared/grpc/interceptor"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type CategoryService struct {
+	service *category.Service
+	categoryv1.UnimplementedCategoryServiceServer
+}
+
+func NewService(service *category.Service) *CategoryService {
+	return &CategoryService{service: service}
+}
+
+func (s *CategoryService) CreateCategory(ctx context.Context, request *categoryv1.CreateCategoryRequest) (*categoryv1.Category, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	c, err := s.service.CreateCategory(ctx, request.Name, request.Description)
+	if err != nil {
+		return nil, categoryError(err, "failed to create category")
+	}
+
+	return c.ToProto(), nil
+}
+
+func (s *CategoryService) GetCategory(ctx context.Context, request *categoryv1.GetCategoryRequest) (*categoryv1.Category, error) {
+	categoryID, err := uuid.Parse(request.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid category ID format: %v", err)
+	}
+
+	c, err := s.service.GetCategory(ctx, categoryID)
+	if err != nil {
+		return nil, categoryError(err, "failed to get category")
+	}
+
+	return c.ToProto(), nil
+}
+
+func (s *CategoryService) ListCategories(ctx context.Context, _ *categoryv1.ListCategoriesRequest) (*categoryv1.ListCategoriesResponse, error) {
+	categories, err := s.service.ListCategories(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list categories: %v", err)
+	}
+
+	var pbCategories []*categoryv1.Category
+	for _, c := range categories {
+		pbCategories = append(pbCategories, c.ToProto())
+	}
+
+	return &categoryv1.ListCategoriesResponse{Categories: pbCategories}, nil
+}
+
+func (s *CategoryService) UpdateCategory(ctx context.Context, request *categoryv1.UpdateCategoryRequest) (*categoryv1.Category, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	categoryID, err := uuid.Parse(request.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid category ID format: %v", err)
+	}
+
+	c, err := s.service.UpdateCategory(ctx, categoryID, request.Name, request.Description)
+	if err != nil {
+		return nil, categoryError(err, "failed to update category")
+	}
+
+	return c.ToProto(), nil
+}
+
+func (s *CategoryService) DeleteCategory(ctx context.Context, request *categoryv1.DeleteCategoryRequest) (*categoryv1.DeleteCategoryResponse, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	categoryID, err := uuid.Parse(request.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid category ID format: %v", err)
+	}
+
+	if err := s.service.DeleteCategory(ctx, categoryID); err != nil {
+		return nil, categoryError(err, "failed to delete category")
+	}
+
+	return &categoryv1.DeleteCategoryResponse{
+		Id:      request.Id,
+		Message: "Category deleted successfully",
+	}, nil
+}
+
+func (s *CategoryService) CreateTag(ctx context.Context, request *categoryv1.CreateTagRequest) (*categoryv1.Tag, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	tag, err := s.service.CreateTag(ctx, request.Name)
+	if err != nil {
+		return nil, categoryError(err, "failed to create tag")
+	}
+
+	return tag.ToProto(), nil
+}
+
+func (s *CategoryService) ListTags(ctx context.Context, _ *categoryv1.ListTagsRequest) (*categoryv1.ListTagsResponse, error) {
+	tags, err := s.service.ListTags(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list tags: %v", err)
+	}
+
+	var pbTags []*categoryv1.Tag
+	for _, tag := range tags {
+		pbTags = append(pbTags, tag.ToProto())
+	}
+
+	return &categoryv1.ListTagsResponse{Tags: pbTags}, nil
+}
+
+func (s *CategoryService) UpdateTag(ctx context.Context, request *categoryv1.UpdateTagRequest) (*categoryv1.Tag, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	tag, err := s.service.RenameTag(ctx, request.Name, request.NewName)
+	if err != nil {
+		return nil, categoryError(err, "failed to update tag")
+	}
+
+	return tag.ToProto(), nil
+}
+
+func (s *CategoryService) DeleteTag(ctx context.Context, request *categoryv1.DeleteTagRequest) (*categoryv1.DeleteTagResponse, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := s.service.DeleteTag(ctx, request.Name); err != nil {
+		return nil, categoryError(err, "failed to delete tag")
+	}
+
+	return &categoryv1.DeleteTagResponse{
+		Name:    request.Name,
+		Message: "Tag deleted successfully",
+	}, nil
+}
+
+// requireAdmin lets only quiz admins manage categories and tags.
+func requireAdmin(ctx context.Context) error {
+	if _, err := interceptor.GetUserIDFromContext(ctx); err != nil {
+		return status.Errorf(codes.Unauthenticated, "user not authenticated: %v", err)
+	}
+
+	if !slices.Contains(interceptor.GetRolesFromContext(ctx), quiz.AdminRole) {
+		return status.Errorf(codes.PermissionDenied, "permission denied: only %s can manage categories and tags", quiz.AdminRole)
+	}
+
+	return nil
+}
+
+func categoryError(err error, message string) error {
+	switch {
+	case errors.Is(err, category.ErrCategoryNotFound), errors.Is(err, category.ErrTagNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, category.ErrCategoryExists), errors.Is(err, category.ErrTagExists):
+		return status.Errorf(codes.AlreadyExists, "%v", err)
+	case errors.Is(err, category.ErrInvalidCategory), errors.Is(err, category.ErrInvalidTag):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", message, err)
+	}
+}
@@ -0,0 +1,54 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/category"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) AddCategory(ctx context.Context, c *models.Category) (*models.Category, error) {
+	args := m.Called(ctx, c)
+	return args.Get(0).(*models.Category), args.Error(1)
+}
+
+func (m *MockRepository) QueryCategories(ctx context.Context, query category.Query) ([]*models.Category, error) {
+	args := m.Called(ctx, query)
+	return args.Get(0).([]*models.Category), args.Error(1)
+}
+
+func (m *MockRepository) UpdateCategory(ctx context.Context, c *models.Category) (*models.Category, error) {
+	args := m.Called(ctx, c)
+	return args.Get(0).(*models.Category), args.Error(1)
+}
+
+func (m *MockRepository) DeleteCategory(ctx context.Context, categoryID uuid.UUID) error {
+	args := m.Called(ctx, categoryID)
+	return args.Error(0)
+}
+
+func (m *MockRepository) AddTag(ctx context.Context, tag *models.Tag) (*models.Tag, error) {
+	args := m.Called(ctx, tag)
+	return args.Get(0).(*models.Tag), args.Error(1)
+}
+
+func (m *MockRepository) QueryTags(ctx context.Context, query category.TagQuery) ([]*models.Tag, error) {
+	args := m.Called(ctx, query)
+	return args.Get(0).([]*models.Tag), args.Error(1)
+}
+
+func (m *MockRepository) RenameTag(ctx context.Context, name, newName string) error {
+	args := m.Called(ctx, name, newName)
+	return args.Error(0)
+}
+
+func (m *MockRepository) DeleteTag(ctx context.Context, name string) error {
+	args := m.Called(ctx, name)
+	return args.Error(0)
+}
@@ -0,0 +1,212 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/category"
+)
+
+type Repository struct {
+	pool *pgxpool.Pool
+}
+
+func NewRepository(pool *pgxpool.Pool) *Repository {
+	return &Repository{pool: pool}
+}
+
+func (r *Repository) AddCategory(ctx context.Context, c *models.Category) (*models.Category, error) {
+	sql := `
+	insert into categories (category_id, category_name, category_description)
+	values ($1, $2, $3)
+	on conflict (category_name) do nothing
+	returning created_at
+	`
+
+	err := r.pool.QueryRow(ctx, sql, c.ID, c.Name, c.Description).Scan(&c.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %s", category.ErrCategoryExists, c.Name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert category: %w", err)
+	}
+
+	return c, nil
+}
+
+// QueryCategories counts only published quizzes of the categories.
+func (r *Repository) QueryCategories(ctx context.Context, query category.Query) ([]*models.Category, error) {
+	sql := `
+	select c.category_id,
+	       c.category_name,
+	       c.category_description,
+	       c.created_at,
+	       (select count(*)
+	        from quiz_categories qc
+	                 join quizzes q on q.quiz_id = qc.quiz_id
+	        where qc.category_id = c.category_id
+	          and q.quiz_status = 'published')
+	from categories c
+	where ($1::uuid[] is null or cardinality($1) = 0 or c.category_id = any ($1))
+	order by c.category_name
+	`
+
+	rows, err := r.pool.Query(ctx, sql, query.Ids)
+	if err != nil {
+		return nil, fmt.Errorf("query failed: %w", err)
+	}
+	defer rows.Close()
+
+	var categories []*models.Category
+	for rows.Next() {
+		c := new(models.Category)
+		if err := rows.Scan(&c.ID, &c.Name, &c.Description, &c.CreatedAt, &c.QuizCount); err != nil {
+			return nil, fmt.Errorf("scan failed: %w", err)
+		}
+
+		categories = append(categories, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return categories, nil
+}
+
+func (r *Repository) UpdateCategory(ctx context.Context, c *models.Category) (*models.Category, error) {
+	sql := `
+	update categories
+	set category_name        = $2,
+	    category_description = $3
+	where category_id = $1
+	`
+
+	tag, err := r.pool.Exec(ctx, sql, c.ID, c.Name, c.Description)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update category: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return nil, category.ErrCategoryNotFound
+	}
+
+	return c, nil
+}
+
+func (r *Repository) DeleteCategory(ctx context.Context, categoryID uuid.UUID) error {
+	sql := `
+	delete from categories
+	where category_id = $1
+	`
+
+	tag, err := r.pool.Exec(ctx, sql, categoryID)
+	if err != nil {
+		return fmt.Errorf("failed to delete category: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return category.ErrCategoryNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) AddTag(ctx context.Context, t *models.Tag) (*models.Tag, error) {
+	sql := `
+	insert into tags (tag_name)
+	values ($1)
+	on conflict (tag_name) do nothing
+	returning tag_name
+	`
+
+	err := r.pool.QueryRow(ctx, sql, t.Name).Scan(&t.Name)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %s", category.ErrTagExists, t.Name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert tag: %w", err)
+	}
+
+	return t, nil
+}
+
+// QueryTags counts only published quizzes with the tags.
+func (r *Repository) QueryTags(ctx context.Context, query category.TagQuery) ([]*models.Tag, error) {
+	sql := `
+	select t.tag_name,
+	       (select count(*)
+	        from quiz_tags qt
+	                 join quizzes q on q.quiz_id = qt.quiz_id
+	        where qt.tag_name = t.tag_name
+	          and q.quiz_status = 'published')
+	from tags t
+	where ($1::text[] is null or cardinality($1) = 0 or t.tag_name = any ($1))
+	order by t.tag_name
+	`
+
+	rows, err := r.pool.Query(ctx, sql, query.Names)
+	if err != nil {
+		return nil, fmt.Errorf("query failed: %w", err)
+	}
+	defer rows.Close()
+
+	var tags []*models.Tag
+	for rows.Next() {
+		t := new(models.Tag)
+		if err := rows.Scan(&t.Name, &t.QuizCount); err != nil {
+			return nil, fmt.Errorf("scan failed: %w", err)
+		}
+
+		tags = append(tags, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return tags, nil
+}
+
+// RenameTag relies on quiz_tags cascading the update of the tag name.
+func (r *Repository) RenameTag(ctx context.Context, name, newName string) error {
+	sql := `
+	update tags
+	set tag_name = $2
+	where tag_name = $1
+	`
+
+	tag, err := r.pool.Exec(ctx, sql, name, newName)
+	if err != nil {
+		return fmt.Errorf("failed to rename tag: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return category.ErrTagNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteTag(ctx context.Context, name string) error {
+	sql := `
+	delete from tags
+	where tag_name = $1
+	`
+
+	tag, err := r.pool.Exec(ctx, sql, name)
+	if err != nil {
+		return fmt.Errorf("failed to delete tag: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return category.ErrTagNotFound
+	}
+
+	return nil
+}
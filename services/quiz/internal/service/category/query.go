@@ -0,0 +1,11 @@
+package category
+
+import "github.com/google/uuid"
+
+type Query struct {
+	Ids []uuid.UUID
+}
+
+type TagQuery struct {
+	Names []string
+}
@@ -0,0 +1,19 @@
+package category
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+)
+
+type Repository interface {
+	AddCategory(ctx context.Context, category *models.Category) (*models.Category, error)
+	QueryCategories(ctx context.Context, query Query) ([]*models.Category, error)
+	UpdateCategory(ctx context.Context, category *models.Category) (*models.Category, error)
+	DeleteCategory(ctx context.Context, categoryID uuid.UUID) error
+	AddTag(ctx context.Context, tag *models.Tag) (*models.Tag, error)
+	QueryTags(ctx context.Context, query TagQuery) ([]*models.Tag, error)
+	RenameTag(ctx context.Context, name, newName string) error
+	DeleteTag(ctx context.Context, name string) error
+}
@@ -0,0 +1,173 @@
+package category
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+)
+
+var (
+	ErrCategoryNotFound = errors.New("category not found")
+	ErrCategoryExists   = errors.New("category already exists")
+	ErrInvalidCategory  = errors.New("invalid category")
+
+	ErrTagNotFound = errors.New("tag not found")
+	ErrTagExists   = errors.New("tag already exists")
+	ErrInvalidTag  = errors.New("invalid tag")
+)
+
+type Service struct {
+	repo Repository
+}
+
+func NewService(repo Repository) *Service {
+	return &Service{repo: repo}
+}
+
+func (s *Service) CreateCategory(ctx context.Context, name, description string) (*models.Category, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("%w: name must not be empty", ErrInvalidCategory)
+	}
+
+	return s.repo.AddCategory(ctx, &models.Category{
+		ID:          uuid.New(),
+		Name:        name,
+		Description: description,
+	})
+}
+
+func (s *Service) GetCategory(ctx context.Context, categoryID uuid.UUID) (*models.Category, error) {
+	categories, err := s.repo.QueryCategories(ctx, Query{Ids: []uuid.UUID{categoryID}})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(categories) == 0 {
+		return nil, ErrCategoryNotFound
+	}
+
+	return categories[0], nil
+}
+
+func (s *Service) ListCategories(ctx context.Context) ([]*models.Category, error) {
+	return s.repo.QueryCategories(ctx, Query{})
+}
+
+func (s *Service) UpdateCategory(ctx context.Context, categoryID uuid.UUID, name, description string) (*models.Category, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("%w: name must not be empty", ErrInvalidCategory)
+	}
+
+	categories, err := s.repo.QueryCategories(ctx, Query{})
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(categories, func(c *models.Category) bool { return c.ID == categoryID })
+	if i < 0 {
+		return nil, ErrCategoryNotFound
+	}
+
+	if slices.ContainsFunc(categories, func(c *models.Category) bool { return c.Name == name && c.ID != categoryID }) {
+		return nil, fmt.Errorf("%w: %s", ErrCategoryExists, name)
+	}
+
+	category := categories[i]
+	category.Name = name
+	category.Description = description
+	return s.repo.UpdateCategory(ctx, category)
+}
+
+func (s *Service) DeleteCategory(ctx context.Context, categoryID uuid.UUID) error {
+	return s.repo.DeleteCategory(ctx, categoryID)
+}
+
+func (s *Service) CreateTag(ctx context.Context, name string) (*models.Tag, error) {
+	name = models.NormalizeTag(name)
+	if name == "" {
+		return nil, fmt.Errorf("%w: name must not be empty", ErrInvalidTag)
+	}
+
+	return s.repo.AddTag(ctx, &models.Tag{Name: name})
+}
+
+func (s *Service) ListTags(ctx context.Context) ([]*models.Tag, error) {
+	return s.repo.QueryTags(ctx, TagQuery{})
+}
+
+// RenameTag renames the tag on every quiz it is assigned to.
+func (s *Service) RenameTag(ctx context.Context, name, newName string) (*models.Tag, error) {
+	newName = models.NormalizeTag(newName)
+	if newName == "" {
+		return nil, fmt.Errorf("%w: name must not be empty", ErrInvalidTag)
+	}
+
+	tags, err := s.repo.QueryTags(ctx, TagQuery{Names: []string{name, newName}})
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(tags, func(t *models.Tag) bool { return t.Name == name })
+	if i < 0 {
+		return nil, ErrTagNotFound
+	}
+
+	if name == newName {
+		return tags[i], nil
+	}
+
+	if slices.ContainsFunc(tags, func(t *models.Tag) bool { return t.Name == newName }) {
+		return nil, fmt.Errorf("%w: %s", ErrTagExists, newName)
+	}
+
+	if err := s.repo.RenameTag(ctx, name, newName); err != nil {
+		return nil, err
+	}
+
+	tag := tags[i]
+	tag.Name = newName
+	return tag, nil
+}
+
+func (s *Service) DeleteTag(ctx context.Context, name string) error {
+	return s.repo.DeleteTag(ctx, name)
+}
+
+// CheckExists returns ErrTagNotFound or ErrCategoryNotFound unless every given
+// tag and category exists.
+func (s *Service) CheckExists(ctx context.Context, tags []string, categoryIDs []uuid.UUID) error {
+	if len(tags) > 0 {
+		existing, err := s.repo.QueryTags(ctx, TagQuery{Names: tags})
+		if err != nil {
+			return err
+		}
+
+		for _, name := range tags {
+			if !slices.ContainsFunc(existing, func(t *models.Tag) bool { return t.Name == name }) {
+				return fmt.Errorf("%w: %s", ErrTagNotFound, name)
+			}
+		}
+	}
+
+	if len(categoryIDs) > 0 {
+		existing, err := s.repo.QueryCategories(ctx, Query{Ids: categoryIDs})
+		if err != nil {
+			return err
+		}
+
+		for _, id := range categoryIDs {
+			if !slices.ContainsFunc(existing, func(c *models.Category) bool { return c.ID == id }) {
+				return fmt.Errorf("%w: %s", ErrCategoryNotFound, id)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package category_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/category"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/category/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCheckExists(t *testing.T) {
+	repo := new(mocks.MockRepository)
+	service := category.NewService(repo)
+	ctx := context.Background()
+
+	categoryID := uuid.New()
+	missingID := uuid.New()
+
+	repo.On("QueryTags", mock.Anything, category.TagQuery{Names: []string{"music", "films"}}).
+		Return([]*models.Tag{{Name: "music"}}, nil).Once()
+
+	err := service.CheckExists(ctx, []string{"music", "films"}, nil)
+	assert.True(t, errors.Is(err, category.ErrTagNotFound))
+
+	repo.On("QueryTags", mock.Anything, category.TagQuery{Names: []string{"music"}}).
+		Return([]*models.Tag{{Name: "music"}}, nil).Once()
+	repo.On("QueryCategories", mock.Anything, category.Query{Ids: []uuid.UUID{categoryID, missingID}}).
+		Return([]*models.Category{{ID: categoryID}}, nil).Once()
+
+	err = service.CheckExists(ctx, []string{"music"}, []uuid.UUID{categoryID, missingID})
+	assert.True(t, errors.Is(err, category.ErrCategoryNotFound))
+
+	assert.NoError(t, service.CheckExists(ctx, nil, nil))
+	repo.AssertExpectations(t)
+}
+
+func TestUpdateCategory_NameTaken(t *testing.T) {
+	repo := new(mocks.MockRepository)
+	service := category.NewService(repo)
+	ctx := context.Background()
+
+	categoryID := uuid.New()
+	repo.On("QueryCategories", mock.Anything, category.Query{}).Return([]*models.Category{
+		{ID: categoryID, Name: "Personality"},
+		{ID: uuid.New(), Name: "Movies"},
+	}, nil)
+
+	_, err := service.UpdateCategory(ctx, categoryID, " Movies ", "")
+	assert.True(t, errors.Is(err, category.ErrCategoryExists))
+
+	_, err = service.UpdateCategory(ctx, uuid.New(), "Books", "")
+	assert.True(t, errors.Is(err, category.ErrCategoryNotFound))
+
+	repo.AssertNotCalled(t, "UpdateCategory", mock.Anything, mock.Anything)
+}
+
+func TestRenameTag(t *testing.T) {
+	repo := new(mocks.MockRepository)
+	service := category.NewService(repo)
+	ctx := context.Background()
+
+	repo.On("QueryTags", mock.Anything, category.TagQuery{Names: []string{"music", "songs"}}).
+		Return([]*models.Tag{{Name: "music", QuizCount: 3}}, nil).Once()
+	repo.On("RenameTag", mock.Anything, "music", "songs").Return(nil).Once()
+
+	tag, err := service.RenameTag(ctx, "music", " Songs ")
+	assert.NoError(t, err)
+	assert.Equal(t, "songs", tag.Name)
+	assert.Equal(t, int64(3), tag.QuizCount)
+
+	repo.On("QueryTags", mock.Anything, category.TagQuery{Names: []string{"music", "films"}}).
+		Return([]*models.Tag{{Name: "music"}, {Name: "films"}}, nil).Once()
+
+	_, err = service.RenameTag(ctx, "music", "films")
+	assert.True(t, errors.Is(err, category.ErrTagExists))
+
+	_, err = service.RenameTag(ctx, "music", "  ")
+	assert.True(t, errors.Is(err, category.ErrInvalidTag))
+
+	repo.AssertExpectations(t)
+}
@@ -0,0 +1,334 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+	feedbackv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/feedback/v1"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/feedback"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/quiz"
+	"github.com/mibrgmv/whoami-server/shared/grpc/interceptor"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type FeedbackService struct {
+	service     *feedback.Service
+	quizService *quiz.Service
+	feedbackv1.UnimplementedFeedbackServiceServer
+}
+
+func NewService(service *feedback.Service, quizService *quiz.Service) *FeedbackService {
+	return &FeedbackService{
+		service:     service,
+		quizService: quizService,
+	}
+}
+
+func (s *FeedbackService) RateQuiz(ctx context.Context, request *feedbackv1.RateQuizRequest) (*feedbackv1.Rating, error) {
+	userID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated: %v", err)
+	}
+
+	q, err := s.getPublished(ctx, request.QuizId)
+	if err != nil {
+		return nil, err
+	}
+
+	rating, err := s.service.Rate(ctx, q.ID, userID, request.Stars)
+	if err != nil {
+		return nil, feedbackError(err, "failed to rate quiz")
+	}
+
+	return rating.ToProto(), nil
+}
+
+func (s *FeedbackService) DeleteRating(ctx context.Context, request *feedbackv1.DeleteRatingRequest) (*feedbackv1.DeleteRatingResponse, error) {
+	userID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated: %v", err)
+	}
+
+	q, err := s.getViewable(ctx, request.QuizId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.service.DeleteRating(ctx, q.ID, userID); err != nil {
+		return nil, feedbackError(err, "failed to delete rating")
+	}
+
+	return &feedbackv1.DeleteRatingResponse{
+		QuizId:  request.QuizId,
+		Message: "Rating deleted successfully",
+	}, nil
+}
+
+func (s *FeedbackService) LikeQuiz(ctx context.Context, request *feedbackv1.LikeQuizRequest) (*feedbackv1.QuizFeedback, error) {
+	userID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated: %v", err)
+	}
+
+	q, err := s.getPublished(ctx, request.QuizId)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := s.service.Like(ctx, q.ID, userID)
+	if err != nil {
+		return nil, feedbackError(err, "failed to like quiz")
+	}
+
+	return f.ToProto(), nil
+}
+
+func (s *FeedbackService) UnlikeQuiz(ctx context.Context, request *feedbackv1.UnlikeQuizRequest) (*feedbackv1.QuizFeedback, error) {
+	userID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated: %v", err)
+	}
+
+	q, err := s.getViewable(ctx, request.QuizId)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := s.service.Unlike(ctx, q.ID, userID)
+	if err != nil {
+		return nil, feedbackError(err, "failed to unlike quiz")
+	}
+
+	return f.ToProto(), nil
+}
+
+func (s *FeedbackService) GetQuizFeedback(ctx context.Context, request *feedbackv1.GetQuizFeedbackRequest) (*feedbackv1.QuizFeedback, error) {
+	userID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated: %v", err)
+	}
+
+	q, err := s.getViewable(ctx, request.QuizId)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := s.service.Feedback(ctx, q.ID, userID)
+	if err != nil {
+		return nil, feedbackError(err, "failed to get quiz feedback")
+	}
+
+	return f.ToProto(), nil
+}
+
+func (s *FeedbackService) CreateComment(ctx context.Context, request *feedbackv1.CreateCommentRequest) (*feedbackv1.Comment, error) {
+	userID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated: %v", err)
+	}
+
+	parentID, err := parseOptionalID(request.ParentId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent comment ID format: %v", err)
+	}
+
+	q, err := s.getPublished(ctx, request.QuizId)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := s.service.CreateComment(ctx, q.ID, userID, parentID, request.Body)
+	if err != nil {
+		return nil, feedbackError(err, "failed to create comment")
+	}
+
+	return c.ToProto(), nil
+}
+
+func (s *FeedbackService) ListComments(ctx context.Context, request *feedbackv1.ListCommentsRequest) (*feedbackv1.ListCommentsResponse, error) {
+	parentID, err := parseOptionalID(request.ParentId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent comment ID format: %v", err)
+	}
+
+	q, err := s.getViewable(ctx, request.QuizId)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, nextPageToken, err := s.service.ListComments(ctx, q.ID, parentID, request.PageSize, request.PageToken)
+	if err != nil {
+		return nil, feedbackError(err, "failed to list comments")
+	}
+
+	return commentsToProto(comments, nextPageToken), nil
+}
+
+func (s *FeedbackService) UpdateComment(ctx context.Context, request *feedbackv1.UpdateCommentRequest) (*feedbackv1.Comment, error) {
+	if _, err := interceptor.GetUserIDFromContext(ctx); err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated: %v", err)
+	}
+
+	commentID, err := uuid.Parse(request.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid comment ID format: %v", err)
+	}
+
+	c, err := s.service.UpdateComment(ctx, commentID, request.Body)
+	if err != nil {
+		return nil, feedbackError(err, "failed to update comment")
+	}
+
+	return c.ToProto(), nil
+}
+
+func (s *FeedbackService) DeleteComment(ctx context.Context, request *feedbackv1.DeleteCommentRequest) (*feedbackv1.DeleteCommentResponse, error) {
+	if _, err := interceptor.GetUserIDFromContext(ctx); err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated: %v", err)
+	}
+
+	commentID, err := uuid.Parse(request.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid comment ID format: %v", err)
+	}
+
+	if err := s.service.DeleteComment(ctx, commentID); err != nil {
+		return nil, feedbackError(err, "failed to delete comment")
+	}
+
+	return &feedbackv1.DeleteCommentResponse{
+		Id:      request.Id,
+		Message: "Comment deleted successfully",
+	}, nil
+}
+
+func (s *FeedbackService) ModerateComment(ctx context.Context, request *feedbackv1.ModerateCommentRequest) (*feedbackv1.Comment, error) {
+	if err := requireModerator(ctx); err != nil {
+		return nil, err
+	}
+
+	commentID, err := uuid.Parse(request.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid comment ID format: %v", err)
+	}
+
+	c, err := s.service.Moderate(ctx, commentID, models.ModerationActionToModel(request.Action), request.Reason)
+	if err != nil {
+		return nil, feedbackError(err, "failed to moderate comment")
+	}
+
+	return c.ToProto(), nil
+}
+
+func (s *FeedbackService) ListHiddenComments(ctx context.Context, request *feedbackv1.ListHiddenCommentsRequest) (*feedbackv1.ListCommentsResponse, error) {
+	if err := requireModerator(ctx); err != nil {
+		return nil, err
+	}
+
+	quizID, err := parseOptionalID(request.QuizId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid quiz ID format: %v", err)
+	}
+
+	comments, nextPageToken, err := s.service.ListHiddenComments(ctx, quizID, request.PageSize, request.PageToken)
+	if err != nil {
+		return nil, feedbackError(err, "failed to list hidden comments")
+	}
+
+	return commentsToProto(comments, nextPageToken), nil
+}
+
+// getViewable returns the quiz if the user can see it, hiding drafts of other
+// users as missing.
+func (s *FeedbackService) getViewable(ctx context.Context, id string) (*models.Quiz, error) {
+	quizID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid quiz ID format: %v", err)
+	}
+
+	q, err := s.quizService.GetByID(ctx, quizID)
+	if err != nil {
+		if errors.Is(err, quiz.ErrQuizNotFound) {
+			return nil, status.Errorf(codes.NotFound, "quiz not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get quiz: %v", err)
+	}
+
+	if !s.quizService.CanView(ctx, q) {
+		return nil, status.Errorf(codes.NotFound, "quiz not found: %v", quiz.ErrQuizNotFound)
+	}
+
+	return q, nil
+}
+
+// getPublished returns the quiz if it takes new feedback, which only published
+// quizzes do.
+func (s *FeedbackService) getPublished(ctx context.Context, id string) (*models.Quiz, error) {
+	q, err := s.getViewable(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if q.Status != models.QuizStatusPublished {
+		return nil, status.Errorf(codes.FailedPrecondition, "quiz is %s, only published quizzes take feedback", q.Status)
+	}
+
+	return q, nil
+}
+
+// requireModerator lets only users with a moderating realm role moderate
+// comments.
+func requireModerator(ctx context.Context) error {
+	if _, err := interceptor.GetUserIDFromContext(ctx); err != nil {
+		return status.Errorf(codes.Unauthenticated, "user not authenticated: %v", err)
+	}
+
+	if !feedback.IsModerator(ctx) {
+		return status.Errorf(codes.PermissionDenied, "permission denied: only %s can moderate comments", feedback.ModeratorRole)
+	}
+
+	return nil
+}
+
+func parseOptionalID(id string) (*uuid.UUID, error) {
+	if id == "" {
+		return nil, nil
+	}
+
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &parsed, nil
+}
+
+func commentsToProto(comments []*models.Comment, nextPageToken string) *feedbackv1.ListCommentsResponse {
+	pbComments := make([]*feedbackv1.Comment, len(comments))
+	for i, c := range comments {
+		pbComments[i] = c.ToProto()
+	}
+
+	return &feedbackv1.ListCommentsResponse{
+		Comments:      pbComments,
+		NextPageToken: nextPageToken,
+	}
+}
+
+func feedbackError(err error, message string) error {
+	switch {
+	case errors.Is(err, quiz.ErrQuizNotFound), errors.Is(err, feedback.ErrRatingNotFound),
+		errors.Is(err, feedback.ErrCommentNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, feedback.ErrInvalidRating), errors.Is(err, feedback.ErrInvalidComment),
+		errors.Is(err, feedback.ErrInvalidModeration), errors.Is(err, feedback.ErrInvalidPageToken):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, feedback.ErrNotCommentAuthor):
+		return status.Errorf(codes.PermissionDenied, "permission denied: %v", err)
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", message, err)
+	}
+}
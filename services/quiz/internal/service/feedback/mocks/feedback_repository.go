@@ -0,0 +1,49 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/feedback"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) SetRating(ctx context.Context, rating *models.Rating) (*models.Rating, error) {
+	args := m.Called(ctx, rating)
+	return args.Get(0).(*models.Rating), args.Error(1)
+}
+
+func (m *MockRepository) DeleteRating(ctx context.Context, quizID, userID uuid.UUID) error {
+	args := m.Called(ctx, quizID, userID)
+	return args.Error(0)
+}
+
+func (m *MockRepository) SetLike(ctx context.Context, quizID, userID uuid.UUID, liked bool) error {
+	args := m.Called(ctx, quizID, userID, liked)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetFeedback(ctx context.Context, quizID, userID uuid.UUID) (*models.QuizFeedback, error) {
+	args := m.Called(ctx, quizID, userID)
+	return args.Get(0).(*models.QuizFeedback), args.Error(1)
+}
+
+func (m *MockRepository) AddComment(ctx context.Context, comment *models.Comment) (*models.Comment, error) {
+	args := m.Called(ctx, comment)
+	return args.Get(0).(*models.Comment), args.Error(1)
+}
+
+func (m *MockRepository) QueryComments(ctx context.Context, query feedback.CommentQuery) ([]*models.Comment, error) {
+	args := m.Called(ctx, query)
+	return args.Get(0).([]*models.Comment), args.Error(1)
+}
+
+func (m *MockRepository) UpdateComment(ctx context.Context, comment *models.Comment) (*models.Comment, error) {
+	args := m.Called(ctx, comment)
+	return args.Get(0).(*models.Comment), args.Error(1)
+}
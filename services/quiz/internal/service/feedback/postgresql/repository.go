@@ -0,0 +1,274 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/feedback"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/quiz"
+)
+
+type Repository struct {
+	pool *pgxpool.Pool
+}
+
+func NewRepository(pool *pgxpool.Pool) *Repository {
+	return &Repository{pool: pool}
+}
+
+func (r *Repository) SetRating(ctx context.Context, rating *models.Rating) (*models.Rating, error) {
+	sql := `
+	insert into quiz_ratings (quiz_id, user_id, rating_stars)
+	values ($1, $2, $3)
+	on conflict (quiz_id, user_id) do update
+	    set rating_stars = excluded.rating_stars,
+	        updated_at   = now()
+	returning created_at, updated_at
+	`
+
+	err := r.withQuiz(ctx, rating.QuizID, func(tx pgx.Tx) error {
+		if err := tx.QueryRow(ctx, sql, rating.QuizID, rating.UserID, rating.Stars).Scan(&rating.CreatedAt, &rating.UpdatedAt); err != nil {
+			return fmt.Errorf("failed to set rating: %w", err)
+		}
+		return refreshRating(ctx, tx, rating.QuizID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rating, nil
+}
+
+func (r *Repository) DeleteRating(ctx context.Context, quizID, userID uuid.UUID) error {
+	return r.withQuiz(ctx, quizID, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, `delete from quiz_ratings where quiz_id = $1 and user_id = $2`, quizID, userID)
+		if err != nil {
+			return fmt.Errorf("failed to delete rating: %w", err)
+		}
+
+		if tag.RowsAffected() == 0 {
+			return feedback.ErrRatingNotFound
+		}
+
+		return refreshRating(ctx, tx, quizID)
+	})
+}
+
+func (r *Repository) SetLike(ctx context.Context, quizID, userID uuid.UUID, liked bool) error {
+	sql := `delete from quiz_likes where quiz_id = $1 and user_id = $2`
+	if liked {
+		sql = `insert into quiz_likes (quiz_id, user_id) values ($1, $2) on conflict do nothing`
+	}
+
+	return r.withQuiz(ctx, quizID, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, sql, quizID, userID); err != nil {
+			return fmt.Errorf("failed to set like: %w", err)
+		}
+
+		countSQL := `
+		update quizzes
+		set like_count = (select count(*) from quiz_likes where quiz_id = $1)
+		where quiz_id = $1
+		`
+
+		if _, err := tx.Exec(ctx, countSQL, quizID); err != nil {
+			return fmt.Errorf("failed to count likes: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// withQuiz runs fn in a transaction holding the row of the quiz, so that the
+// aggregates stored on the quiz are recomputed one change at a time.
+func (r *Repository) withQuiz(ctx context.Context, quizID uuid.UUID, fn func(tx pgx.Tx) error) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction failed: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				fmt.Printf("transaction rollback failed: %v\n", rbErr)
+			}
+			return
+		}
+		if cErr := tx.Commit(ctx); cErr != nil {
+			fmt.Printf("transaction commit failed: %v\n", cErr)
+		}
+	}()
+
+	var locked uuid.UUID
+	err = tx.QueryRow(ctx, `select quiz_id from quizzes where quiz_id = $1 for update`, quizID).Scan(&locked)
+	if errors.Is(err, pgx.ErrNoRows) {
+		err = quiz.ErrQuizNotFound
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("failed to lock quiz: %w", err)
+	}
+
+	err = fn(tx)
+	return err
+}
+
+// refreshRating stores the average rating on the quiz for sorting.
+func refreshRating(ctx context.Context, tx pgx.Tx, quizID uuid.UUID) error {
+	sql := `
+	update quizzes
+	set rating_average = coalesce((select avg(rating_stars) from quiz_ratings where quiz_id = $1), 0)::real,
+	    rating_count   = (select count(*) from quiz_ratings where quiz_id = $1)
+	where quiz_id = $1
+	`
+
+	if _, err := tx.Exec(ctx, sql, quizID); err != nil {
+		return fmt.Errorf("failed to refresh rating: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) GetFeedback(ctx context.Context, quizID, userID uuid.UUID) (*models.QuizFeedback, error) {
+	sql := `
+	select rating_average,
+	       rating_count,
+	       like_count,
+	       (select count(*)
+	        from quiz_comments
+	        where quiz_comments.quiz_id = quizzes.quiz_id
+	          and deleted_at is null
+	          and hidden_at is null),
+	       coalesce((select rating_stars
+	                 from quiz_ratings
+	                 where quiz_ratings.quiz_id = quizzes.quiz_id
+	                   and user_id = $2), 0),
+	       exists (select 1
+	               from quiz_likes
+	               where quiz_likes.quiz_id = quizzes.quiz_id
+	                 and user_id = $2)
+	from quizzes
+	where quiz_id = $1
+	`
+
+	f := &models.QuizFeedback{QuizID: quizID}
+	err := r.pool.QueryRow(ctx, sql, quizID, userID).Scan(&f.RatingAverage, &f.RatingCount, &f.LikeCount, &f.CommentCount,
+		&f.MyRating, &f.Liked)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, quiz.ErrQuizNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get feedback: %w", err)
+	}
+
+	return f, nil
+}
+
+func (r *Repository) AddComment(ctx context.Context, c *models.Comment) (*models.Comment, error) {
+	sql := `
+	insert into quiz_comments (comment_id, quiz_id, parent_id, author_id, comment_body)
+	values ($1, $2, $3, $4, $5)
+	returning created_at, updated_at
+	`
+
+	if err := r.pool.QueryRow(ctx, sql, c.ID, c.QuizID, c.ParentID, c.AuthorID, c.Body).Scan(&c.CreatedAt, &c.UpdatedAt); err != nil {
+		return nil, fmt.Errorf("failed to insert comment: %w", err)
+	}
+
+	return c, nil
+}
+
+func (r *Repository) QueryComments(ctx context.Context, query feedback.CommentQuery) ([]*models.Comment, error) {
+	sql := `
+	select comment_id,
+	       quiz_id,
+	       parent_id,
+	       author_id,
+	       comment_body,
+	       (select count(*)
+	        from quiz_comments replies
+	        where replies.quiz_id = quiz_comments.quiz_id
+	          and replies.parent_id = quiz_comments.comment_id),
+	       created_at,
+	       updated_at,
+	       deleted_at,
+	       hidden_at,
+	       hidden_by,
+	       hidden_reason
+	from quiz_comments
+	where ($1::uuid[] is null or cardinality($1) = 0 or comment_id = any ($1))
+	  and ($2::uuid is null or quiz_id = $2)
+	  and ($3::uuid is null or parent_id = $3)
+	  and (not $4::boolean or parent_id is null)
+	  and (not $5::boolean or hidden_at is not null)
+	  and (not $6::boolean or (created_at, comment_id) > ($7, $8))
+	order by created_at, comment_id
+	limit $9
+	`
+
+	var pageSize int32
+	if query.PageSize > 0 {
+		pageSize = query.PageSize + 1
+	} else {
+		pageSize = query.PageSize
+	}
+
+	after := query.After
+	if after == nil {
+		after = &feedback.Cursor{}
+	}
+
+	rows, err := r.pool.Query(ctx, sql, query.Ids, query.QuizID, query.ParentID, query.TopLevel, query.Hidden,
+		query.After != nil, after.CreatedAt, after.ID, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("query failed: %w", err)
+	}
+	defer rows.Close()
+
+	var comments []*models.Comment
+	for rows.Next() {
+		c := new(models.Comment)
+		if err := rows.Scan(&c.ID, &c.QuizID, &c.ParentID, &c.AuthorID, &c.Body, &c.ReplyCount, &c.CreatedAt, &c.UpdatedAt,
+			&c.DeletedAt, &c.HiddenAt, &c.HiddenBy, &c.HiddenReason); err != nil {
+			return nil, fmt.Errorf("scan failed: %w", err)
+		}
+
+		comments = append(comments, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return comments, nil
+}
+
+// UpdateComment saves the body and the deletion and moderation state of the
+// comment. Only editing the body counts as an update.
+func (r *Repository) UpdateComment(ctx context.Context, c *models.Comment) (*models.Comment, error) {
+	sql := `
+	update quiz_comments
+	set updated_at    = case when comment_body <> $2 then now() else updated_at end,
+	    comment_body  = $2,
+	    deleted_at    = $3,
+	    hidden_at     = $4,
+	    hidden_by     = $5,
+	    hidden_reason = $6
+	where comment_id = $1
+	returning updated_at
+	`
+
+	err := r.pool.QueryRow(ctx, sql, c.ID, c.Body, c.DeletedAt, c.HiddenAt, c.HiddenBy, c.HiddenReason).Scan(&c.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, feedback.ErrCommentNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update comment: %w", err)
+	}
+
+	return c, nil
+}
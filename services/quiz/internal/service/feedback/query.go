@@ -0,0 +1,53 @@
+package feedback
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CommentQuery selects comments oldest first. TopLevel leaves out replies,
+// ParentID selects the replies to a comment.
+type CommentQuery struct {
+	Ids      []uuid.UUID
+	QuizID   *uuid.UUID
+	ParentID *uuid.UUID
+	TopLevel bool
+	Hidden   bool
+	PageSize int32
+	After    *Cursor
+}
+
+// Cursor is the position of the last comment of a page, the next page starts
+// right after it.
+type Cursor struct {
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (c *Cursor) PageToken() string {
+	data, _ := json.Marshal(c)
+	return base64.URLEncoding.EncodeToString(data)
+}
+
+// ParsePageToken returns nil for an empty token, meaning the first page.
+func ParsePageToken(token string) (*Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	data, err := base64.URLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPageToken, err)
+	}
+
+	cursor := new(Cursor)
+	if err := json.Unmarshal(data, cursor); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPageToken, err)
+	}
+
+	return cursor, nil
+}
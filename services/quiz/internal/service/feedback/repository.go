@@ -0,0 +1,18 @@
+package feedback
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+)
+
+type Repository interface {
+	SetRating(ctx context.Context, rating *models.Rating) (*models.Rating, error)
+	DeleteRating(ctx context.Context, quizID, userID uuid.UUID) error
+	SetLike(ctx context.Context, quizID, userID uuid.UUID, liked bool) error
+	GetFeedback(ctx context.Context, quizID, userID uuid.UUID) (*models.QuizFeedback, error)
+	AddComment(ctx context.Context, comment *models.Comment) (*models.Comment, error)
+	QueryComments(ctx context.Context, query CommentQuery) ([]*models.Comment, error)
+	UpdateComment(ctx context.Context, comment *models.Comment) (*models.Comment, error)
+}
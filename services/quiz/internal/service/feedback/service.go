@@ -0,0 +1,284 @@
+package feedback
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/quiz"
+	"github.com/mibrgmv/whoami-server/shared/grpc/interceptor"
+)
+
+// ModeratorRole is the realm role of the users who hide and restore comments.
+// Quiz admins moderate too.
+const ModeratorRole = "quiz-moderator"
+
+const (
+	maxCommentLength       = 2000
+	defaultCommentPageSize = 20
+	maxCommentPageSize     = 100
+)
+
+var (
+	ErrInvalidRating     = errors.New("invalid rating")
+	ErrRatingNotFound    = errors.New("rating not found")
+	ErrCommentNotFound   = errors.New("comment not found")
+	ErrInvalidComment    = errors.New("invalid comment")
+	ErrNotCommentAuthor  = errors.New("only the comment author can modify the comment")
+	ErrInvalidModeration = errors.New("invalid moderation action")
+	ErrInvalidPageToken  = errors.New("invalid page token")
+)
+
+type Service struct {
+	repo Repository
+}
+
+func NewService(repo Repository) *Service {
+	return &Service{repo: repo}
+}
+
+// Rate sets the rating of the user, replacing the previous one.
+func (s *Service) Rate(ctx context.Context, quizID, userID uuid.UUID, stars int32) (*models.Rating, error) {
+	if stars < 1 || stars > 5 {
+		return nil, fmt.Errorf("%w: stars must be from 1 to 5, got %d", ErrInvalidRating, stars)
+	}
+
+	return s.repo.SetRating(ctx, &models.Rating{QuizID: quizID, UserID: userID, Stars: stars})
+}
+
+func (s *Service) DeleteRating(ctx context.Context, quizID, userID uuid.UUID) error {
+	return s.repo.DeleteRating(ctx, quizID, userID)
+}
+
+func (s *Service) Like(ctx context.Context, quizID, userID uuid.UUID) (*models.QuizFeedback, error) {
+	if err := s.repo.SetLike(ctx, quizID, userID, true); err != nil {
+		return nil, err
+	}
+	return s.repo.GetFeedback(ctx, quizID, userID)
+}
+
+func (s *Service) Unlike(ctx context.Context, quizID, userID uuid.UUID) (*models.QuizFeedback, error) {
+	if err := s.repo.SetLike(ctx, quizID, userID, false); err != nil {
+		return nil, err
+	}
+	return s.repo.GetFeedback(ctx, quizID, userID)
+}
+
+func (s *Service) Feedback(ctx context.Context, quizID, userID uuid.UUID) (*models.QuizFeedback, error) {
+	return s.repo.GetFeedback(ctx, quizID, userID)
+}
+
+// CreateComment adds a comment to the quiz, or a reply when the parent is
+// given. Only visible comments of the same quiz can be replied to.
+func (s *Service) CreateComment(ctx context.Context, quizID, authorID uuid.UUID, parentID *uuid.UUID, body string) (*models.Comment, error) {
+	body, err := validateBody(body)
+	if err != nil {
+		return nil, err
+	}
+
+	if parentID != nil {
+		parent, err := s.GetComment(ctx, *parentID)
+		if err != nil {
+			return nil, err
+		}
+
+		if parent.QuizID != quizID {
+			return nil, fmt.Errorf("%w: parent comment belongs to another quiz", ErrInvalidComment)
+		}
+
+		if parent.Status() != models.CommentStatusVisible {
+			return nil, fmt.Errorf("%w: cannot reply to a %s comment", ErrInvalidComment, parent.Status())
+		}
+	}
+
+	return s.repo.AddComment(ctx, &models.Comment{
+		ID:       uuid.New(),
+		QuizID:   quizID,
+		ParentID: parentID,
+		AuthorID: authorID,
+		Body:     body,
+	})
+}
+
+func (s *Service) GetComment(ctx context.Context, commentID uuid.UUID) (*models.Comment, error) {
+	comments, err := s.repo.QueryComments(ctx, CommentQuery{Ids: []uuid.UUID{commentID}, PageSize: 1})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(comments) == 0 {
+		return nil, ErrCommentNotFound
+	}
+
+	return comments[0], nil
+}
+
+// ListComments lists the top-level comments of the quiz or the replies to the
+// parent. Deleted and hidden comments are redacted for everyone but
+// moderators.
+func (s *Service) ListComments(ctx context.Context, quizID uuid.UUID, parentID *uuid.UUID, pageSize int32, pageToken string) ([]*models.Comment, string, error) {
+	comments, nextPageToken, err := s.list(ctx, CommentQuery{QuizID: &quizID, ParentID: parentID, TopLevel: parentID == nil},
+		pageSize, pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if IsModerator(ctx) {
+		return comments, nextPageToken, nil
+	}
+
+	redacted := make([]*models.Comment, len(comments))
+	for i, c := range comments {
+		redacted[i] = c.Redacted()
+	}
+
+	return redacted, nextPageToken, nil
+}
+
+// ListHiddenComments lists the hidden comments, of the quiz if given.
+func (s *Service) ListHiddenComments(ctx context.Context, quizID *uuid.UUID, pageSize int32, pageToken string) ([]*models.Comment, string, error) {
+	return s.list(ctx, CommentQuery{QuizID: quizID, Hidden: true}, pageSize, pageToken)
+}
+
+func (s *Service) list(ctx context.Context, query CommentQuery, pageSize int32, pageToken string) ([]*models.Comment, string, error) {
+	after, err := ParsePageToken(pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if pageSize <= 0 {
+		pageSize = defaultCommentPageSize
+	}
+	query.PageSize = min(pageSize, maxCommentPageSize)
+	query.After = after
+
+	comments, err := s.repo.QueryComments(ctx, query)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var nextPageToken string
+	if len(comments) > int(query.PageSize) {
+		comments = comments[:len(comments)-1]
+		last := comments[len(comments)-1]
+		nextPageToken = (&Cursor{ID: last.ID, CreatedAt: last.CreatedAt}).PageToken()
+	}
+
+	return comments, nextPageToken, nil
+}
+
+// UpdateComment changes the body of a visible comment. Only its author can
+// edit it.
+func (s *Service) UpdateComment(ctx context.Context, commentID uuid.UUID, body string) (*models.Comment, error) {
+	body, err := validateBody(body)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := s.GetComment(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.AuthorID != userID {
+		return nil, ErrNotCommentAuthor
+	}
+
+	if comment.Status() != models.CommentStatusVisible {
+		return nil, fmt.Errorf("%w: cannot edit a %s comment", ErrInvalidComment, comment.Status())
+	}
+
+	comment.Body = body
+	return s.repo.UpdateComment(ctx, comment)
+}
+
+// DeleteComment marks the comment deleted, keeping its replies. Comments are
+// deleted by their authors and by moderators.
+func (s *Service) DeleteComment(ctx context.Context, commentID uuid.UUID) error {
+	comment, err := s.GetComment(ctx, commentID)
+	if err != nil {
+		return err
+	}
+
+	userID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if comment.AuthorID != userID && !IsModerator(ctx) {
+		return ErrNotCommentAuthor
+	}
+
+	if comment.DeletedAt != nil {
+		return nil
+	}
+
+	now := time.Now()
+	comment.DeletedAt = &now
+	_, err = s.repo.UpdateComment(ctx, comment)
+	return err
+}
+
+// Moderate hides the comment from everyone but moderators or restores it.
+// Callers check that the user is a moderator.
+func (s *Service) Moderate(ctx context.Context, commentID uuid.UUID, action models.ModerationAction, reason string) (*models.Comment, error) {
+	moderatorID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := s.GetComment(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch action {
+	case models.ModerationActionHide:
+		now := time.Now()
+		comment.HiddenAt = &now
+		comment.HiddenBy = &moderatorID
+		comment.HiddenReason = strings.TrimSpace(reason)
+	case models.ModerationActionRestore:
+		if comment.HiddenAt == nil {
+			return nil, fmt.Errorf("%w: comment is not hidden", ErrInvalidModeration)
+		}
+		comment.HiddenAt = nil
+		comment.HiddenBy = nil
+		comment.HiddenReason = ""
+	default:
+		return nil, fmt.Errorf("%w: action must be set", ErrInvalidModeration)
+	}
+
+	return s.repo.UpdateComment(ctx, comment)
+}
+
+// IsModerator reports whether the user has a realm role that moderates
+// comments.
+func IsModerator(ctx context.Context) bool {
+	roles := interceptor.GetRolesFromContext(ctx)
+	return slices.Contains(roles, ModeratorRole) || slices.Contains(roles, quiz.AdminRole)
+}
+
+func validateBody(body string) (string, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return "", fmt.Errorf("%w: body must not be empty", ErrInvalidComment)
+	}
+
+	if utf8.RuneCountInString(body) > maxCommentLength {
+		return "", fmt.Errorf("%w: body must not be longer than %d characters", ErrInvalidComment, maxCommentLength)
+	}
+
+	return body, nil
+}
@@ -0,0 +1,161 @@
+package feedback_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/feedback"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/feedback/mocks"
+	"github.com/mibrgmv/whoami-server/shared/grpc/interceptor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRate_Invalid(t *testing.T) {
+	repo := new(mocks.MockRepository)
+	service := feedback.NewService(repo)
+
+	for _, stars := range []int32{0, 6, -1} {
+		_, err := service.Rate(context.Background(), uuid.New(), uuid.New(), stars)
+		assert.True(t, errors.Is(err, feedback.ErrInvalidRating), "stars %d: got %v", stars, err)
+	}
+
+	repo.AssertNotCalled(t, "SetRating", mock.Anything, mock.Anything)
+}
+
+func TestCreateComment_Reply(t *testing.T) {
+	repo := new(mocks.MockRepository)
+	service := feedback.NewService(repo)
+	ctx := context.Background()
+
+	quizID := uuid.New()
+	deletedAt := time.Now()
+	parent := &models.Comment{ID: uuid.New(), QuizID: quizID, Body: "Trevor, obviously"}
+	otherQuiz := &models.Comment{ID: uuid.New(), QuizID: uuid.New(), Body: "Franklin"}
+	deleted := &models.Comment{ID: uuid.New(), QuizID: quizID, DeletedAt: &deletedAt}
+
+	for _, c := range []*models.Comment{parent, otherQuiz, deleted} {
+		repo.On("QueryComments", mock.Anything, feedback.CommentQuery{Ids: []uuid.UUID{c.ID}, PageSize: 1}).
+			Return([]*models.Comment{c}, nil)
+	}
+	reply := &models.Comment{ID: uuid.New(), QuizID: quizID, ParentID: &parent.ID, Body: "Same"}
+	repo.On("AddComment", mock.Anything, mock.MatchedBy(func(c *models.Comment) bool {
+		return c.QuizID == quizID && c.ParentID != nil && *c.ParentID == parent.ID && c.Body == "Same"
+	})).Return(reply, nil).Once()
+
+	_, err := service.CreateComment(ctx, quizID, uuid.New(), &otherQuiz.ID, "Same")
+	assert.True(t, errors.Is(err, feedback.ErrInvalidComment))
+
+	_, err = service.CreateComment(ctx, quizID, uuid.New(), &deleted.ID, "Same")
+	assert.True(t, errors.Is(err, feedback.ErrInvalidComment))
+
+	_, err = service.CreateComment(ctx, quizID, uuid.New(), &parent.ID, "   ")
+	assert.True(t, errors.Is(err, feedback.ErrInvalidComment))
+
+	created, err := service.CreateComment(ctx, quizID, uuid.New(), &parent.ID, " Same ")
+	assert.NoError(t, err)
+	assert.Equal(t, reply, created)
+	repo.AssertExpectations(t)
+}
+
+func TestListComments_Redacted(t *testing.T) {
+	repo := new(mocks.MockRepository)
+	service := feedback.NewService(repo)
+
+	quizID := uuid.New()
+	hiddenAt := time.Now()
+	visible := &models.Comment{ID: uuid.New(), QuizID: quizID, Body: "Nice quiz"}
+	hidden := &models.Comment{ID: uuid.New(), QuizID: quizID, Body: "Spam", HiddenAt: &hiddenAt, HiddenReason: "spam"}
+
+	repo.On("QueryComments", mock.Anything, mock.MatchedBy(func(query feedback.CommentQuery) bool {
+		return *query.QuizID == quizID && query.TopLevel && query.PageSize == 20
+	})).Return([]*models.Comment{visible, hidden}, nil)
+
+	ctx := context.WithValue(context.Background(), interceptor.UserIDKey, uuid.NewString())
+	comments, token, err := service.ListComments(ctx, quizID, nil, 0, "")
+	assert.NoError(t, err)
+	assert.Empty(t, token)
+	assert.Equal(t, "Nice quiz", comments[0].Body)
+	assert.Equal(t, models.CommentStatusHidden, comments[1].Status())
+	assert.Empty(t, comments[1].Body)
+	assert.Empty(t, comments[1].HiddenReason)
+	assert.Equal(t, "Spam", hidden.Body)
+
+	ctx = context.WithValue(ctx, interceptor.RolesKey, []string{feedback.ModeratorRole})
+	comments, _, err = service.ListComments(ctx, quizID, nil, 0, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "Spam", comments[1].Body)
+	assert.Equal(t, "spam", comments[1].HiddenReason)
+}
+
+func TestDeleteComment(t *testing.T) {
+	authorID := uuid.New()
+
+	tests := []struct {
+		name    string
+		userID  uuid.UUID
+		roles   []string
+		wantErr error
+	}{
+		{name: "Author", userID: authorID},
+		{name: "Other user", userID: uuid.New(), wantErr: feedback.ErrNotCommentAuthor},
+		{name: "Moderator", userID: uuid.New(), roles: []string{feedback.ModeratorRole}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(mocks.MockRepository)
+			service := feedback.NewService(repo)
+
+			comment := &models.Comment{ID: uuid.New(), AuthorID: authorID, Body: "First!"}
+			repo.On("QueryComments", mock.Anything, mock.Anything).Return([]*models.Comment{comment}, nil)
+			repo.On("UpdateComment", mock.Anything, mock.Anything).Return(comment, nil)
+
+			ctx := context.WithValue(context.Background(), interceptor.UserIDKey, tt.userID.String())
+			ctx = context.WithValue(ctx, interceptor.RolesKey, tt.roles)
+
+			err := service.DeleteComment(ctx, comment.ID)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				repo.AssertNotCalled(t, "UpdateComment", mock.Anything, mock.Anything)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, models.CommentStatusDeleted, comment.Status())
+			assert.Equal(t, "First!", comment.Body)
+		})
+	}
+}
+
+func TestModerate(t *testing.T) {
+	repo := new(mocks.MockRepository)
+	service := feedback.NewService(repo)
+
+	moderatorID := uuid.New()
+	ctx := context.WithValue(context.Background(), interceptor.UserIDKey, moderatorID.String())
+
+	comment := &models.Comment{ID: uuid.New(), Body: "Buy followers"}
+	repo.On("QueryComments", mock.Anything, mock.Anything).Return([]*models.Comment{comment}, nil)
+	repo.On("UpdateComment", mock.Anything, comment).Return(comment, nil)
+
+	_, err := service.Moderate(ctx, comment.ID, models.ModerationActionRestore, "")
+	assert.ErrorIs(t, err, feedback.ErrInvalidModeration)
+
+	hidden, err := service.Moderate(ctx, comment.ID, models.ModerationActionHide, " spam ")
+	assert.NoError(t, err)
+	assert.Equal(t, models.CommentStatusHidden, hidden.Status())
+	assert.Equal(t, &moderatorID, hidden.HiddenBy)
+	assert.Equal(t, "spam", hidden.HiddenReason)
+
+	restored, err := service.Moderate(ctx, comment.ID, models.ModerationActionRestore, "")
+	assert.NoError(t, err)
+	assert.Equal(t, models.CommentStatusVisible, restored.Status())
+
+	_, err = service.Moderate(ctx, comment.ID, "", "")
+	assert.ErrorIs(t, err, feedback.ErrInvalidModeration)
+}
@@ -0,0 +1,47 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	guestv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/guest/v1"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/attempt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GuestService struct {
+	attemptService *attempt.Service
+	guestv1.UnimplementedGuestServiceServer
+}
+
+func NewService(attemptService *attempt.Service) *GuestService {
+	return &GuestService{
+		attemptService: attemptService,
+	}
+}
+
+func (s *GuestService) MergeGuest(ctx context.Context, request *guestv1.MergeGuestRequest) (*guestv1.MergeGuestResponse, error) {
+	guestID, err := uuid.Parse(request.GuestId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid guest ID format: %v", err)
+	}
+
+	userID, err := uuid.Parse(request.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID format: %v", err)
+	}
+
+	merged, err := s.attemptService.MergeGuest(ctx, guestID, userID)
+	if err != nil {
+		if errors.Is(err, attempt.ErrNotGuestSession) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to merge guest attempts: %v", err)
+	}
+
+	return &guestv1.MergeGuestResponse{
+		MergedAttemptCount: merged,
+	}, nil
+}
@@ -0,0 +1,17 @@
+package media
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobStore keeps the content of uploaded media by key. Get returns
+// ErrBlobNotFound for an unknown key, Delete of an unknown key is not an error.
+type BlobStore interface {
+	Put(ctx context.Context, key string, content io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
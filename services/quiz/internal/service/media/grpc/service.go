@@ -0,0 +1,84 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/google/uuid"
+	mediav1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/media/v1"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/media"
+	"github.com/mibrgmv/whoami-server/shared/grpc/interceptor"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type MediaService struct {
+	service *media.Service
+	mediav1.UnimplementedMediaServiceServer
+}
+
+func NewService(service *media.Service) *MediaService {
+	return &MediaService{service: service}
+}
+
+func (s *MediaService) UploadMedia(ctx context.Context, request *mediav1.UploadMediaRequest) (*mediav1.Media, error) {
+	ownerID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated: %v", err)
+	}
+
+	m, err := s.service.Upload(ctx, ownerID, request.Data)
+	if err != nil {
+		if errors.Is(err, media.ErrInvalidMedia) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to upload media: %v", err)
+	}
+
+	return m.ToProto(), nil
+}
+
+func (s *MediaService) GetMedia(ctx context.Context, request *mediav1.GetMediaRequest) (*mediav1.Media, error) {
+	mediaID, err := uuid.Parse(request.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid media ID format: %v", err)
+	}
+
+	m, err := s.service.Get(ctx, mediaID)
+	if err != nil {
+		if errors.Is(err, media.ErrMediaNotFound) {
+			return nil, status.Errorf(codes.NotFound, "media not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get media: %v", err)
+	}
+
+	return m.ToProto(), nil
+}
+
+func (s *MediaService) GetMediaContent(ctx context.Context, request *mediav1.GetMediaContentRequest) (*httpbody.HttpBody, error) {
+	mediaID, err := uuid.Parse(request.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid media ID format: %v", err)
+	}
+
+	m, content, err := s.service.Open(ctx, mediaID)
+	if err != nil {
+		if errors.Is(err, media.ErrMediaNotFound) {
+			return nil, status.Errorf(codes.NotFound, "media not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get media: %v", err)
+	}
+	defer content.Close()
+
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read media content: %v", err)
+	}
+
+	return &httpbody.HttpBody{
+		ContentType: m.ContentType,
+		Data:        data,
+	}, nil
+}
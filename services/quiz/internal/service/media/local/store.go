@@ -0,0 +1,99 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/media"
+)
+
+type Config struct {
+	Root string `mapstructure:"root"`
+}
+
+// Store is a media.BlobStore keeping every blob in a file named by its key
+// under the root directory.
+type Store struct {
+	root string
+}
+
+func NewStore(cfg Config) (*Store, error) {
+	if err := os.MkdirAll(cfg.Root, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create blob store root: %w", err)
+	}
+
+	return &Store{root: cfg.Root}, nil
+}
+
+// Put writes the blob to a temporary file first, so that a failed write never
+// leaves a partial blob behind.
+func (s *Store) Put(_ context.Context, key string, content io.Reader) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(s.root, ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create blob file: %w", err)
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := io.Copy(f, content); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write blob: %w", err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write blob: %w", err)
+	}
+
+	if err := os.Rename(f.Name(), path); err != nil {
+		return fmt.Errorf("failed to store blob: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, media.ErrBlobNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open blob: %w", err)
+	}
+
+	return f, nil
+}
+
+func (s *Store) Delete(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete blob: %w", err)
+	}
+
+	return nil
+}
+
+// path rejects keys that would point outside of the root directory.
+func (s *Store) path(key string) (string, error) {
+	if key == "" || key == "." || key == ".." || key != filepath.Base(key) {
+		return "", fmt.Errorf("invalid blob key '%s'", key)
+	}
+	return filepath.Join(s.root, key), nil
+}
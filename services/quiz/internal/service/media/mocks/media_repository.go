@@ -0,0 +1,23 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/media"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Add(ctx context.Context, media *models.Media) (*models.Media, error) {
+	args := m.Called(ctx, media)
+	return args.Get(0).(*models.Media), args.Error(1)
+}
+
+func (m *MockRepository) Query(ctx context.Context, query media.Query) ([]*models.Media, error) {
+	args := m.Called(ctx, query)
+	return args.Get(0).([]*models.Media), args.Error(1)
+}
@@ -0,0 +1,79 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/media"
+)
+
+type Repository struct {
+	pool *pgxpool.Pool
+}
+
+func NewRepository(pool *pgxpool.Pool) *Repository {
+	return &Repository{pool: pool}
+}
+
+const insertMediaSQL = `
+	insert into media (media_id, owner_id, media_content_type, media_size)
+	values ($1, $2, $3, $4)
+	returning created_at
+	`
+
+func (r *Repository) Add(ctx context.Context, m *models.Media) (*models.Media, error) {
+	err := r.pool.QueryRow(ctx, insertMediaSQL, m.ID, m.OwnerID, m.ContentType, m.Size).Scan(&m.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert media: %w", err)
+	}
+
+	return m, nil
+}
+
+// Insert records the media within the transaction.
+func Insert(ctx context.Context, tx pgx.Tx, m *models.Media) error {
+	err := tx.QueryRow(ctx, insertMediaSQL, m.ID, m.OwnerID, m.ContentType, m.Size).Scan(&m.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert media: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) Query(ctx context.Context, query media.Query) ([]*models.Media, error) {
+	sql := `
+	select media_id,
+	       owner_id,
+	       media_content_type,
+	       media_size,
+	       created_at
+	from media
+	where ($1::uuid[] is null or cardinality($1) = 0 or media_id = any ($1))
+	order by created_at, media_id
+	`
+
+	rows, err := r.pool.Query(ctx, sql, query.Ids)
+	if err != nil {
+		return nil, fmt.Errorf("query failed: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*models.Media
+	for rows.Next() {
+		m := new(models.Media)
+		if err := rows.Scan(&m.ID, &m.OwnerID, &m.ContentType, &m.Size, &m.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan failed: %w", err)
+		}
+
+		result = append(result, m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,7 @@
+package media
+
+import "github.com/google/uuid"
+
+type Query struct {
+	Ids []uuid.UUID
+}
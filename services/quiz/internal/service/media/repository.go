@@ -0,0 +1,12 @@
+package media
+
+import (
+	"context"
+
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+)
+
+type Repository interface {
+	Add(ctx context.Context, media *models.Media) (*models.Media, error)
+	Query(ctx context.Context, query Query) ([]*models.Media, error)
+}
@@ -0,0 +1,148 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+)
+
+const defaultMaxSize = 5 << 20
+
+var (
+	ErrMediaNotFound = errors.New("media not found")
+	ErrInvalidMedia  = errors.New("invalid media")
+)
+
+// contentTypes are the content types media can be uploaded with.
+var contentTypes = []string{"image/png", "image/jpeg", "image/gif", "image/webp"}
+
+type Config struct {
+	MaxSize int64 `mapstructure:"max_size"`
+}
+
+type Service struct {
+	repo    Repository
+	blobs   BlobStore
+	maxSize int64
+}
+
+func NewService(repo Repository, blobs BlobStore, cfg Config) *Service {
+	maxSize := cfg.MaxSize
+	if maxSize <= 0 {
+		maxSize = defaultMaxSize
+	}
+
+	return &Service{
+		repo:    repo,
+		blobs:   blobs,
+		maxSize: maxSize,
+	}
+}
+
+// Upload stores the content and records it as media of the owner.
+func (s *Service) Upload(ctx context.Context, ownerID uuid.UUID, content []byte) (*models.Media, error) {
+	m := &models.Media{ID: uuid.New(), OwnerID: ownerID}
+	if err := s.Store(ctx, m, content); err != nil {
+		return nil, err
+	}
+
+	created, err := s.repo.Add(ctx, m)
+	if err != nil {
+		s.Discard(ctx, m.ID)
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// Store checks the content and puts it into the blob store under the ID of
+// the media, filling in its content type and size. The content type is
+// detected from the content itself and only images are accepted. The media is
+// not recorded, which is left to the caller.
+func (s *Service) Store(ctx context.Context, m *models.Media, content []byte) error {
+	if len(content) == 0 {
+		return fmt.Errorf("%w: content is empty", ErrInvalidMedia)
+	}
+
+	if int64(len(content)) > s.maxSize {
+		return fmt.Errorf("%w: content is larger than %d bytes", ErrInvalidMedia, s.maxSize)
+	}
+
+	contentType := http.DetectContentType(content)
+	if !slices.Contains(contentTypes, contentType) {
+		return fmt.Errorf("%w: content type %s is not supported", ErrInvalidMedia, contentType)
+	}
+
+	if err := s.blobs.Put(ctx, m.ID.String(), bytes.NewReader(content)); err != nil {
+		return fmt.Errorf("failed to store media content: %w", err)
+	}
+
+	m.ContentType = contentType
+	m.Size = int64(len(content))
+	return nil
+}
+
+// Discard removes stored content of media that ended up not being recorded.
+func (s *Service) Discard(ctx context.Context, id uuid.UUID) {
+	if err := s.blobs.Delete(ctx, id.String()); err != nil {
+		fmt.Printf("failed to delete media content: %v\n", err)
+	}
+}
+
+func (s *Service) Get(ctx context.Context, id uuid.UUID) (*models.Media, error) {
+	media, err := s.repo.Query(ctx, Query{Ids: []uuid.UUID{id}})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(media) == 0 {
+		return nil, ErrMediaNotFound
+	}
+
+	return media[0], nil
+}
+
+// Open returns the media along with its content, which the caller has to close.
+func (s *Service) Open(ctx context.Context, id uuid.UUID) (*models.Media, io.ReadCloser, error) {
+	m, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content, err := s.blobs.Get(ctx, m.ID.String())
+	if err != nil {
+		if errors.Is(err, ErrBlobNotFound) {
+			return nil, nil, fmt.Errorf("%w: content of media %s is missing", ErrMediaNotFound, m.ID)
+		}
+		return nil, nil, err
+	}
+
+	return m, content, nil
+}
+
+// CheckExists returns ErrMediaNotFound unless every given media is uploaded.
+func (s *Service) CheckExists(ctx context.Context, ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	media, err := s.repo.Query(ctx, Query{Ids: ids})
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if !slices.ContainsFunc(media, func(m *models.Media) bool { return m.ID == id }) {
+			return fmt.Errorf("%w: %s", ErrMediaNotFound, id)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package media_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/media"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/media/local"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/media/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var png = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00\x01\x00\x00\x00\x01\x08\x06\x00\x00\x00")
+
+func newService(t *testing.T, maxSize int64) (*media.Service, *mocks.MockRepository, *local.Store) {
+	store, err := local.NewStore(local.Config{Root: t.TempDir()})
+	if err != nil {
+		t.Fatalf("failed to create store: %v", err)
+	}
+
+	repo := new(mocks.MockRepository)
+	return media.NewService(repo, store, media.Config{MaxSize: maxSize}), repo, store
+}
+
+func TestUpload(t *testing.T) {
+	service, repo, _ := newService(t, 0)
+	ownerID := uuid.New()
+	ctx := context.Background()
+
+	var added *models.Media
+	repo.On("Add", mock.Anything, mock.AnythingOfType("*models.Media")).Run(func(args mock.Arguments) {
+		added = args.Get(1).(*models.Media)
+	}).Return(&models.Media{}, nil).Once()
+
+	_, err := service.Upload(ctx, ownerID, png)
+	assert.NoError(t, err)
+	assert.Equal(t, ownerID, added.OwnerID)
+	assert.Equal(t, "image/png", added.ContentType)
+	assert.Equal(t, int64(len(png)), added.Size)
+
+	repo.On("Query", mock.Anything, media.Query{Ids: []uuid.UUID{added.ID}}).Return([]*models.Media{added}, nil)
+
+	opened, content, err := service.Open(ctx, added.ID)
+	assert.NoError(t, err)
+	defer content.Close()
+
+	data, err := io.ReadAll(content)
+	assert.NoError(t, err)
+	assert.Equal(t, added, opened)
+	assert.Equal(t, png, data)
+}
+
+func TestUpload_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content []byte
+	}{
+		{name: "empty", content: nil},
+		{name: "too large", content: append(png, make([]byte, 64)...)},
+		{name: "not an image", content: []byte("just some text")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, repo, _ := newService(t, 64)
+
+			_, err := service.Upload(context.Background(), uuid.New(), tt.content)
+			assert.ErrorIs(t, err, media.ErrInvalidMedia)
+			repo.AssertNotCalled(t, "Add")
+		})
+	}
+}
+
+func TestUpload_RepositoryErrorRemovesContent(t *testing.T) {
+	service, repo, store := newService(t, 0)
+	ctx := context.Background()
+
+	var added *models.Media
+	repo.On("Add", mock.Anything, mock.AnythingOfType("*models.Media")).Run(func(args mock.Arguments) {
+		added = args.Get(1).(*models.Media)
+	}).Return((*models.Media)(nil), errors.New("db down"))
+
+	_, err := service.Upload(ctx, uuid.New(), png)
+	assert.Error(t, err)
+
+	_, err = store.Get(ctx, added.ID.String())
+	assert.ErrorIs(t, err, media.ErrBlobNotFound)
+}
+
+func TestCheckExists(t *testing.T) {
+	service, repo, _ := newService(t, 0)
+	ctx := context.Background()
+
+	uploaded := &models.Media{ID: uuid.New()}
+	missing := uuid.New()
+
+	repo.On("Query", mock.Anything, media.Query{Ids: []uuid.UUID{uploaded.ID}}).Return([]*models.Media{uploaded}, nil)
+	repo.On("Query", mock.Anything, media.Query{Ids: []uuid.UUID{uploaded.ID, missing}}).Return([]*models.Media{uploaded}, nil)
+
+	assert.NoError(t, service.CheckExists(ctx, nil))
+	assert.NoError(t, service.CheckExists(ctx, []uuid.UUID{uploaded.ID}))
+	assert.ErrorIs(t, service.CheckExists(ctx, []uuid.UUID{uploaded.ID, missing}), media.ErrMediaNotFound)
+}
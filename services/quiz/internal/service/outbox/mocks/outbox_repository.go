@@ -0,0 +1,43 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Add(ctx context.Context, message *models.OutboxMessage) error {
+	args := m.Called(ctx, message)
+	return args.Error(0)
+}
+
+func (m *MockRepository) Claim(ctx context.Context, limit int32, leaseUntil time.Time) ([]*models.OutboxMessage, error) {
+	args := m.Called(ctx, limit, leaseUntil)
+	return args.Get(0).([]*models.OutboxMessage), args.Error(1)
+}
+
+func (m *MockRepository) MarkDelivered(ctx context.Context, idempotencyKey string) error {
+	args := m.Called(ctx, idempotencyKey)
+	return args.Error(0)
+}
+
+func (m *MockRepository) Prune(ctx context.Context, deliveredBefore time.Time) (int64, error) {
+	args := m.Called(ctx, deliveredBefore)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) Retry(ctx context.Context, idempotencyKey string, nextAttemptAt time.Time, lastError string) error {
+	args := m.Called(ctx, idempotencyKey, nextAttemptAt, lastError)
+	return args.Error(0)
+}
+
+func (m *MockRepository) Fail(ctx context.Context, idempotencyKey string, lastError string) error {
+	args := m.Called(ctx, idempotencyKey, lastError)
+	return args.Error(0)
+}
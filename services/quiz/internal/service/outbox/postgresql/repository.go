@@ -0,0 +1,149 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+)
+
+type Repository struct {
+	pool *pgxpool.Pool
+}
+
+func NewRepository(pool *pgxpool.Pool) *Repository {
+	return &Repository{pool: pool}
+}
+
+// Add queues the message and counts the completion for its quiz in one
+// statement. A message with a key that is already in the outbox is neither
+// queued nor counted again.
+func (r *Repository) Add(ctx context.Context, message *models.OutboxMessage) error {
+	sql := `
+	with queued as (
+	    insert into history_outbox (idempotency_key, history_item)
+	    values ($1, $2)
+	    on conflict (idempotency_key) do nothing
+	    returning idempotency_key
+	)
+	update quizzes
+	set completion_count = completion_count + 1
+	where quiz_id = $3
+	  and exists (select 1 from queued)
+	`
+
+	item, err := message.MarshalItem()
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.pool.Exec(ctx, sql, message.IdempotencyKey, item, message.Item.QuizId); err != nil {
+		return fmt.Errorf("failed to add outbox message: %w", err)
+	}
+
+	return nil
+}
+
+// Claim returns up to limit messages that are due, oldest first, and hides
+// them from other relays until leaseUntil.
+func (r *Repository) Claim(ctx context.Context, limit int32, leaseUntil time.Time) ([]*models.OutboxMessage, error) {
+	sql := `
+	with due as (
+	    select idempotency_key
+	    from history_outbox
+	    where failed_at is null
+	      and delivered_at is null
+	      and next_attempt_at <= now()
+	    order by next_attempt_at
+	    limit $1
+	    for update skip locked
+	)
+	update history_outbox o
+	set next_attempt_at = $2
+	from due
+	where o.idempotency_key = due.idempotency_key
+	returning o.idempotency_key, o.history_item, o.attempt_count, coalesce(o.last_error, ''), o.created_at, o.next_attempt_at
+	`
+
+	rows, err := r.pool.Query(ctx, sql, limit, leaseUntil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim outbox messages: %w", err)
+	}
+	defer rows.Close()
+
+	var messages []*models.OutboxMessage
+	for rows.Next() {
+		var m models.OutboxMessage
+		var item []byte
+		if err := rows.Scan(&m.IdempotencyKey, &item, &m.AttemptCount, &m.LastError, &m.CreatedAt, &m.NextAttemptAt); err != nil {
+			return nil, fmt.Errorf("scan failed: %w", err)
+		}
+
+		if err := m.UnmarshalItem(item); err != nil {
+			return nil, err
+		}
+
+		messages = append(messages, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return messages, nil
+}
+
+func (r *Repository) MarkDelivered(ctx context.Context, idempotencyKey string) error {
+	sql := `update history_outbox set delivered_at = now() where idempotency_key = $1`
+
+	if _, err := r.pool.Exec(ctx, sql, idempotencyKey); err != nil {
+		return fmt.Errorf("failed to mark outbox message as delivered: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) Prune(ctx context.Context, deliveredBefore time.Time) (int64, error) {
+	sql := `delete from history_outbox where delivered_at < $1`
+
+	tag, err := r.pool.Exec(ctx, sql, deliveredBefore)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune outbox messages: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func (r *Repository) Retry(ctx context.Context, idempotencyKey string, nextAttemptAt time.Time, lastError string) error {
+	sql := `
+	update history_outbox
+	set attempt_count   = attempt_count + 1,
+	    last_error      = $3,
+	    next_attempt_at = $2
+	where idempotency_key = $1
+	`
+
+	if _, err := r.pool.Exec(ctx, sql, idempotencyKey, nextAttemptAt, lastError); err != nil {
+		return fmt.Errorf("failed to schedule outbox message retry: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) Fail(ctx context.Context, idempotencyKey string, lastError string) error {
+	sql := `
+	update history_outbox
+	set attempt_count = attempt_count + 1,
+	    last_error    = $2,
+	    failed_at     = now()
+	where idempotency_key = $1
+	`
+
+	if _, err := r.pool.Exec(ctx, sql, idempotencyKey, lastError); err != nil {
+		return fmt.Errorf("failed to mark outbox message as failed: %w", err)
+	}
+
+	return nil
+}
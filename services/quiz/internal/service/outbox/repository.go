@@ -0,0 +1,17 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+)
+
+type Repository interface {
+	Add(ctx context.Context, message *models.OutboxMessage) error
+	Claim(ctx context.Context, limit int32, leaseUntil time.Time) ([]*models.OutboxMessage, error)
+	MarkDelivered(ctx context.Context, idempotencyKey string) error
+	Prune(ctx context.Context, deliveredBefore time.Time) (int64, error)
+	Retry(ctx context.Context, idempotencyKey string, nextAttemptAt time.Time, lastError string) error
+	Fail(ctx context.Context, idempotencyKey string, lastError string) error
+}
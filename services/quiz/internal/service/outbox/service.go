@@ -0,0 +1,182 @@
+package outbox
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+	historyv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/history/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultInterval    = time.Second
+	defaultBatchSize   = 100
+	defaultLease       = time.Minute
+	defaultMinBackoff  = time.Second
+	defaultMaxBackoff  = 10 * time.Minute
+	defaultMaxAttempts = 5
+	defaultRetention   = 7 * 24 * time.Hour
+)
+
+type Config struct {
+	// Interval is how often the outbox is checked for messages to deliver.
+	Interval  time.Duration `mapstructure:"interval"`
+	BatchSize int32         `mapstructure:"batch_size"`
+	// Lease is how long a claimed message is hidden from other relays while
+	// it is being delivered.
+	Lease time.Duration `mapstructure:"lease"`
+	// A failed delivery is retried after MinBackoff, doubled with every
+	// failure up to MaxBackoff.
+	MinBackoff time.Duration `mapstructure:"min_backoff"`
+	MaxBackoff time.Duration `mapstructure:"max_backoff"`
+	// A message the history service rejected MaxAttempts times is marked as
+	// failed and no longer delivered. Messages that failed for a reason that
+	// passes, such as the history service being down, are retried until they
+	// are delivered.
+	MaxAttempts int32 `mapstructure:"max_attempts"`
+	// Retention is how long delivered messages are kept, so that a client
+	// retrying with the same key meanwhile is recognised as a retry.
+	Retention time.Duration `mapstructure:"retention"`
+}
+
+// Service relays quiz completions from the outbox to the history service.
+// Completions are written to the outbox in the same transaction as the
+// evaluation, so they are recorded even if the history service is down then.
+type Service struct {
+	repo          Repository
+	historyClient historyv1.HistoryServiceClient
+	config        Config
+}
+
+func NewService(repo Repository, historyClient historyv1.HistoryServiceClient, cfg Config) *Service {
+	if cfg.Interval <= 0 {
+		cfg.Interval = defaultInterval
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaultBatchSize
+	}
+	if cfg.Lease <= 0 {
+		cfg.Lease = defaultLease
+	}
+	if cfg.MinBackoff <= 0 {
+		cfg.MinBackoff = defaultMinBackoff
+	}
+	if cfg.MaxBackoff < cfg.MinBackoff {
+		cfg.MaxBackoff = max(defaultMaxBackoff, cfg.MinBackoff)
+	}
+
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = defaultMaxAttempts
+	}
+	if cfg.Retention <= 0 {
+		cfg.Retention = defaultRetention
+	}
+
+	return &Service{
+		repo:          repo,
+		historyClient: historyClient,
+		config:        cfg,
+	}
+}
+
+// Add puts the message in the outbox and counts the completion for its quiz,
+// unless a message with its idempotency key is already there.
+func (s *Service) Add(ctx context.Context, message *models.OutboxMessage) error {
+	return s.repo.Add(ctx, message)
+}
+
+// Run relays the outbox every interval until the context is done.
+func (s *Service) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.Relay(ctx); err != nil {
+				log.Printf("failed to relay history outbox: %v", err)
+			}
+			if _, err := s.Prune(ctx); err != nil {
+				log.Printf("failed to prune history outbox: %v", err)
+			}
+		}
+	}
+}
+
+// Relay delivers the messages that are due and returns how many were
+// delivered. Delivered messages are kept for the retention period, failed ones
+// are retried later unless the history service keeps rejecting them.
+func (s *Service) Relay(ctx context.Context) (int, error) {
+	now := time.Now()
+	messages, err := s.repo.Claim(ctx, s.config.BatchSize, now.Add(s.config.Lease))
+	if err != nil {
+		return 0, err
+	}
+
+	var delivered int
+	for _, m := range messages {
+		if _, err := s.historyClient.CreateItem(ctx, m.ToProto()); err != nil {
+			if !retryable(err) && m.AttemptCount+1 >= s.config.MaxAttempts {
+				log.Printf("giving up on delivering %s to history after %d attempts: %v", m.IdempotencyKey, m.AttemptCount+1, err)
+				if err := s.repo.Fail(ctx, m.IdempotencyKey, err.Error()); err != nil {
+					return delivered, err
+				}
+				continue
+			}
+
+			log.Printf("failed to deliver %s to history (attempt %d): %v", m.IdempotencyKey, m.AttemptCount+1, err)
+			if err := s.repo.Retry(ctx, m.IdempotencyKey, time.Now().Add(s.backoff(m.AttemptCount)), err.Error()); err != nil {
+				return delivered, err
+			}
+			continue
+		}
+
+		if err := s.repo.MarkDelivered(ctx, m.IdempotencyKey); err != nil {
+			return delivered, err
+		}
+		delivered++
+	}
+
+	return delivered, nil
+}
+
+// Prune removes messages delivered longer than the retention period ago and
+// returns how many were removed.
+func (s *Service) Prune(ctx context.Context) (int64, error) {
+	return s.repo.Prune(ctx, time.Now().Add(-s.config.Retention))
+}
+
+// backoff returns how long to wait before retrying a message that has failed
+// attempts times before.
+func (s *Service) backoff(attempts int32) time.Duration {
+	backoff := s.config.MinBackoff
+	for range attempts {
+		backoff *= 2
+		if backoff >= s.config.MaxBackoff {
+			return s.config.MaxBackoff
+		}
+	}
+	return backoff
+}
+
+// retryable reports whether a delivery that failed with err can succeed later.
+// Requests the history service rejects fail the same way every time.
+func retryable(err error) bool {
+	switch status.Code(err) {
+	case codes.InvalidArgument,
+		codes.FailedPrecondition,
+		codes.NotFound,
+		codes.AlreadyExists,
+		codes.PermissionDenied,
+		codes.Unauthenticated,
+		codes.OutOfRange,
+		codes.Unimplemented:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,108 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+	historyv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/history/v1"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/outbox"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/outbox/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type historyClient struct {
+	historyv1.HistoryServiceClient
+	mock.Mock
+}
+
+func (c *historyClient) CreateItem(ctx context.Context, in *historyv1.CreateItemRequest, _ ...grpc.CallOption) (*historyv1.QuizCompletionHistoryItem, error) {
+	args := c.Called(ctx, in)
+	return in.Item, args.Error(0)
+}
+
+func withKey(m *models.OutboxMessage) any {
+	return mock.MatchedBy(func(r *historyv1.CreateItemRequest) bool {
+		return r.IdempotencyKey == m.IdempotencyKey
+	})
+}
+
+func TestRelay(t *testing.T) {
+	repo := new(mocks.MockRepository)
+	client := new(historyClient)
+	service := outbox.NewService(repo, client, outbox.Config{MinBackoff: time.Second, MaxBackoff: 4 * time.Second, MaxAttempts: 3})
+
+	delivered := &models.OutboxMessage{IdempotencyKey: "attempt:1", Item: &historyv1.QuizCompletionHistoryItem{QuizResult: "Trevor"}}
+	failed := &models.OutboxMessage{IdempotencyKey: "attempt:2", Item: &historyv1.QuizCompletionHistoryItem{}, AttemptCount: 1}
+	capped := &models.OutboxMessage{IdempotencyKey: "attempt:3", Item: &historyv1.QuizCompletionHistoryItem{}, AttemptCount: 10}
+	unavailableLong := &models.OutboxMessage{IdempotencyKey: "attempt:4", Item: &historyv1.QuizCompletionHistoryItem{}, AttemptCount: 11}
+	rejected := &models.OutboxMessage{IdempotencyKey: "attempt:5", Item: &historyv1.QuizCompletionHistoryItem{}}
+	exhausted := &models.OutboxMessage{IdempotencyKey: "attempt:6", Item: &historyv1.QuizCompletionHistoryItem{}, AttemptCount: 2}
+
+	unavailable := status.Error(codes.Unavailable, "unavailable")
+	invalid := status.Error(codes.InvalidArgument, "invalid quiz ID")
+
+	repo.On("Claim", mock.Anything, int32(100), mock.AnythingOfType("time.Time")).Return([]*models.OutboxMessage{delivered, failed, capped, unavailableLong, rejected, exhausted}, nil)
+	client.On("CreateItem", mock.Anything, withKey(delivered)).Return(nil)
+	client.On("CreateItem", mock.Anything, withKey(rejected)).Return(invalid)
+	client.On("CreateItem", mock.Anything, withKey(exhausted)).Return(invalid)
+	client.On("CreateItem", mock.Anything, mock.Anything).Return(unavailable)
+	repo.On("MarkDelivered", mock.Anything, delivered.IdempotencyKey).Return(nil)
+	repo.On("Fail", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	retries := map[string]time.Time{}
+	repo.On("Retry", mock.Anything, mock.Anything, mock.AnythingOfType("time.Time"), mock.Anything).Run(func(args mock.Arguments) {
+		retries[args.String(1)] = args.Get(2).(time.Time)
+	}).Return(nil)
+
+	start := time.Now()
+	count, err := service.Relay(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	repo.AssertCalled(t, "MarkDelivered", mock.Anything, delivered.IdempotencyKey)
+	repo.AssertNumberOfCalls(t, "MarkDelivered", 1)
+
+	assert.Len(t, retries, 4)
+	assert.WithinRange(t, retries[failed.IdempotencyKey], start.Add(2*time.Second), time.Now().Add(2*time.Second))
+	assert.WithinRange(t, retries[capped.IdempotencyKey], start.Add(4*time.Second), time.Now().Add(4*time.Second))
+	assert.Contains(t, retries, unavailableLong.IdempotencyKey, "transient failures are retried however long they last")
+	assert.Contains(t, retries, rejected.IdempotencyKey, "a rejection is retried until the attempts run out")
+
+	repo.AssertCalled(t, "Fail", mock.Anything, exhausted.IdempotencyKey, invalid.Error())
+	repo.AssertNumberOfCalls(t, "Fail", 1)
+}
+
+func TestRelay_ClaimFailed(t *testing.T) {
+	repo := new(mocks.MockRepository)
+	client := new(historyClient)
+	service := outbox.NewService(repo, client, outbox.Config{})
+
+	repo.On("Claim", mock.Anything, mock.Anything, mock.Anything).Return([]*models.OutboxMessage(nil), errors.New("connection refused"))
+
+	_, err := service.Relay(context.Background())
+	assert.Error(t, err)
+	client.AssertNotCalled(t, "CreateItem", mock.Anything, mock.Anything)
+}
+
+func TestPrune(t *testing.T) {
+	repo := new(mocks.MockRepository)
+	service := outbox.NewService(repo, new(historyClient), outbox.Config{Retention: time.Hour})
+
+	var before time.Time
+	repo.On("Prune", mock.Anything, mock.AnythingOfType("time.Time")).Run(func(args mock.Arguments) {
+		before = args.Get(1).(time.Time)
+	}).Return(int64(3), nil)
+
+	start := time.Now()
+	pruned, err := service.Prune(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), pruned)
+	assert.WithinRange(t, before, start.Add(-time.Hour), time.Now().Add(-time.Hour))
+}
@@ -0,0 +1,112 @@
+package question
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+)
+
+// Draw picks the questions of an attempt according to the draw configuration
+// of the quiz, or returns nil, meaning every question, when the quiz does not
+// draw questions. Tags are drawn in alphabetical order and a question counts
+// towards the first tag it is drawn for. The tiebreaker question is always
+// included. The same seed and questions always give the same selection, which
+// is returned in flow order.
+func Draw(quiz *models.Quiz, questions []*models.Question, seed int64) []uuid.UUID {
+	if quiz.QuestionDraw == nil {
+		return nil
+	}
+
+	ordered := models.NewFlow(questions).Questions()
+	pool := slices.DeleteFunc(slices.Clone(ordered), func(q *models.Question) bool {
+		return quiz.IsTiebreaker(q.ID)
+	})
+
+	r := rand.New(rand.NewPCG(uint64(seed), uint64(len(pool))))
+	r.Shuffle(len(pool), func(i, j int) {
+		pool[i], pool[j] = pool[j], pool[i]
+	})
+
+	drawn := make(map[uuid.UUID]bool)
+	take := func(n int32, match func(q *models.Question) bool) {
+		for _, q := range pool {
+			if n <= 0 {
+				return
+			}
+			if !drawn[q.ID] && match(q) {
+				drawn[q.ID] = true
+				n--
+			}
+		}
+	}
+
+	tags := make([]string, 0, len(quiz.QuestionDraw.PerTag))
+	for tag := range quiz.QuestionDraw.PerTag {
+		tags = append(tags, tag)
+	}
+	slices.Sort(tags)
+
+	for _, tag := range tags {
+		take(quiz.QuestionDraw.PerTag[tag], func(q *models.Question) bool {
+			return slices.Contains(q.Tags, tag)
+		})
+	}
+
+	take(quiz.QuestionDraw.Count-int32(len(drawn)), func(*models.Question) bool { return true })
+
+	ids := make([]uuid.UUID, 0, len(drawn)+1)
+	for _, q := range ordered {
+		if drawn[q.ID] || quiz.IsTiebreaker(q.ID) {
+			ids = append(ids, q.ID)
+		}
+	}
+
+	return ids
+}
+
+// Draw picks the questions of a new attempt at the quiz, see Draw.
+func (s *Service) Draw(ctx context.Context, quiz *models.Quiz, seed int64) ([]uuid.UUID, error) {
+	if quiz.QuestionDraw == nil {
+		return nil, nil
+	}
+
+	questions, err := s.quizQuestions(ctx, quiz.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return Draw(quiz, questions, seed), nil
+}
+
+// drawnQuestions returns the drawn questions of the quiz, or every question
+// when drawn is nil.
+func (s *Service) drawnQuestions(ctx context.Context, quizID uuid.UUID, drawn []uuid.UUID) ([]*models.Question, error) {
+	questions, err := s.quizQuestions(ctx, quizID)
+	if err != nil || drawn == nil {
+		return questions, err
+	}
+
+	return slices.DeleteFunc(slices.Clone(questions), func(q *models.Question) bool {
+		return !slices.Contains(drawn, q.ID)
+	}), nil
+}
+
+// quizQuestions returns the questions of the version the service is at, or the
+// current questions of the quiz.
+func (s *Service) quizQuestions(ctx context.Context, quizID uuid.UUID) ([]*models.Question, error) {
+	if s.version != nil {
+		return s.version.Questions, nil
+	}
+	return s.GetByQuizID(ctx, quizID)
+}
+
+func checkDrawn(answer models.Answer, drawn []uuid.UUID) error {
+	if drawn != nil && !slices.Contains(drawn, answer.QuestionID) {
+		return fmt.Errorf("%w: question %s was not drawn for this attempt", ErrInvalidAnswer, answer.QuestionID)
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package question
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+)
+
+func validateRoutes(q *models.Question) error {
+	if err := validateRoute(q, q.Route); err != nil {
+		return err
+	}
+
+	for _, option := range q.Options {
+		if option.Route == nil {
+			continue
+		}
+		if q.Type != "" && q.Type != models.QuestionTypeSingleChoice {
+			return fmt.Errorf("%w: option '%s' routes, but only single choice options can", ErrInvalidQuestion, option.Text)
+		}
+		if err := validateRoute(q, option.Route); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateRoute(q *models.Question, route *models.Route) error {
+	if route == nil {
+		return nil
+	}
+	if route.End && route.NextQuestionID != nil {
+		return fmt.Errorf("%w: a route either ends the quiz or points at the next question", ErrInvalidQuestion)
+	}
+	if route.NextQuestionID != nil && q.ID != uuid.Nil && *route.NextQuestionID == q.ID {
+		return fmt.Errorf("%w: question %s routes to itself", ErrInvalidQuestion, q.ID)
+	}
+	return nil
+}
+
+// walk follows the flow of the quiz along the given answers and returns the
+// choices made on the way. It stops at the first unanswered question that skip
+// does not let it pass and returns it as the next question. Skipped questions
+// other than the tiebreaker count as answered with zero weights. The next
+// question is nil once the quiz is over: the flow has ended or, with early
+// termination, the leading result can no longer be caught up.
+func walk(quiz *models.Quiz, flow *models.Flow, answers map[uuid.UUID]models.Answer, skip func(q *models.Question) bool) ([]Choice, *models.Question, error) {
+	weightsLen := quiz.WeightsLen()
+	totals := make([]float32, weightsLen)
+
+	var choices []Choice
+	visited := make(map[uuid.UUID]bool)
+
+	for q := flow.Start(); q != nil; {
+		if visited[q.ID] {
+			return nil, nil, fmt.Errorf("%w: the flow returns to question %s", ErrInvalidQuestion, q.ID)
+		}
+		visited[q.ID] = true
+
+		answer, answered := answers[q.ID]
+		if !answered {
+			if skip == nil || !skip(q) {
+				return choices, q, nil
+			}
+			if !quiz.IsTiebreaker(q.ID) {
+				choices = append(choices, Choice{Question: q, Weights: make([]float32, weightsLen)})
+			}
+			q = flow.Next(q, nil)
+			continue
+		}
+
+		weights, err := answerWeights(q, answer, weightsLen)
+		if err != nil {
+			return nil, nil, err
+		}
+		choices = append(choices, Choice{Question: q, Weights: weights})
+
+		if !quiz.IsTiebreaker(q.ID) {
+			addWeights(totals, weights, 1)
+		}
+
+		q = flow.Next(q, &answer)
+		if q != nil && quiz.EarlyTermination && decided(quiz, flow.Reachable(q), totals) {
+			return choices, nil, nil
+		}
+	}
+
+	return choices, nil, nil
+}
+
+// decided reports whether the leading result of a weighted sum quiz stays
+// strictly ahead of every other result whatever is answered to the remaining
+// questions. The remaining questions are every question that may still be
+// asked, so the bound holds for any path through the flow.
+func decided(quiz *models.Quiz, remaining []*models.Question, totals []float32) bool {
+	if quiz.ScoringModel != "" && quiz.ScoringModel != models.ScoringWeightedSum || len(totals) < 2 {
+		return false
+	}
+
+	leader := 0
+	for i, total := range totals {
+		if total > totals[leader] {
+			leader = i
+		}
+	}
+
+	lows := make([]float32, len(totals))
+	highs := make([]float32, len(totals))
+	for _, q := range remaining {
+		if quiz.IsTiebreaker(q.ID) {
+			continue
+		}
+		for i := range totals {
+			low, high := weightBounds(q, i)
+			lows[i] += low
+			highs[i] += high
+		}
+	}
+
+	for i, total := range totals {
+		if i != leader && totals[leader]+lows[leader] <= total+highs[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// weightBounds returns how much an answer to the question can at least and at
+// most add to the result at index i. The bounds include zero because the
+// question may not be asked at all.
+func weightBounds(q *models.Question, i int) (float32, float32) {
+	var low, high float32
+	for _, option := range q.Options {
+		if i >= len(option.Weights) {
+			continue
+		}
+
+		weight := option.Weights[i]
+		if q.Type == models.QuestionTypeMultiSelect || q.Type == models.QuestionTypeRanking {
+			low += min(weight, 0)
+			high += max(weight, 0)
+			continue
+		}
+
+		low = min(low, weight)
+		high = max(high, weight)
+	}
+
+	return low, high
+}
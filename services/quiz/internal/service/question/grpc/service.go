@@ -4,85 +4,99 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 
 	"github.com/google/uuid"
 	"github.com/mibrgmv/whoami-server/quiz/internal/models"
-	historyv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/history/v1"
 	questionv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/question/v1"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/media"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/outbox"
 	"github.com/mibrgmv/whoami-server/quiz/internal/service/question"
 	"github.com/mibrgmv/whoami-server/quiz/internal/service/quiz"
-	"google.golang.org/grpc"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/translation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type QuestionService struct {
-	service       *question.Service
-	quizService   *quiz.Service
-	historyClient historyv1.QuizCompletionHistoryServiceClient
-	historyConn   *grpc.ClientConn
+	service            *question.Service
+	quizService        *quiz.Service
+	mediaService       *media.Service
+	translationService *translation.Service
+	outboxService      *outbox.Service
 	questionv1.UnimplementedQuestionServiceServer
 }
 
-func NewService(service *question.Service, quizService *quiz.Service, historyServiceAddr string) (*QuestionService, error) {
-	conn, err := grpc.NewClient(historyServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		return nil, fmt.Errorf("failed to connect to history service: %w", err)
-	}
-
-	historyClient := historyv1.NewQuizCompletionHistoryServiceClient(conn)
-
+func NewService(service *question.Service, quizService *quiz.Service, mediaService *media.Service,
+	translationService *translation.Service, outboxService *outbox.Service) *QuestionService {
 	return &QuestionService{
-		service:       service,
-		quizService:   quizService,
-		historyClient: historyClient,
-		historyConn:   conn,
-	}, nil
+		service:            service,
+		quizService:        quizService,
+		mediaService:       mediaService,
+		translationService: translationService,
+		outboxService:      outboxService,
+	}
 }
 
-func (s *QuestionService) Close() error {
-	if s.historyConn != nil {
-		err := s.historyConn.Close()
-		if err != nil {
-			return fmt.Errorf("failed to close connection to history service: %w", err)
+func (s *QuestionService) BatchCreateQuestions(ctx context.Context, request *questionv1.BatchCreateQuestionsRequest) (*questionv1.BatchCreateQuestionsResponse, error) {
+	quizID, err := uuid.Parse(request.QuizId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid quiz ID format: %v", err)
+	}
+
+	q, err := s.quizService.GetByID(ctx, quizID)
+	if err != nil {
+		if errors.Is(err, quiz.ErrQuizNotFound) {
+			return nil, status.Errorf(codes.NotFound, "quiz not found: %v", err)
 		}
-		log.Printf("closed connection with quiz completion history")
+		return nil, status.Errorf(codes.Internal, "failed to get quiz: %v", err)
 	}
-	return nil
-}
 
-func (s *QuestionService) BatchCreateQuestions(ctx context.Context, request *questionv1.BatchCreateQuestionsRequest) (*questionv1.BatchCreateQuestionsResponse, error) {
-	var questionsToCreate []*models.Question
-	for _, req := range request.Requests {
+	if err := s.authorize(ctx, q); err != nil {
+		return nil, err
+	}
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	questionsToCreate := make([]*models.Question, 0, len(request.Requests))
+	for i, req := range request.Requests {
+		field := fmt.Sprintf("requests[%d]", i)
 		if req.QuizId != request.QuizId {
-			return nil, status.Error(codes.InvalidArgument, "quiz id does not match request quiz id")
+			violations = append(violations, fieldViolation(field, "quiz_id", "quiz id does not match request quiz id"))
+			continue
 		}
 
-		q, err := models.QuestionToModel(req)
+		parsed, err := models.QuestionToModel(req)
 		if err != nil {
-			log.Fatalf("parse error %v", err)
+			violations = append(violations, fieldViolation(field, "", err.Error()))
+			continue
 		}
 
-		questionsToCreate = append(questionsToCreate, q)
+		for _, violation := range question.ValidateForQuiz(q, parsed) {
+			violations = append(violations, fieldViolation(field, violation.Field, violation.Description))
+		}
+
+		questionsToCreate = append(questionsToCreate, parsed)
 	}
 
-	quizID, err := uuid.Parse(request.QuizId)
-	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid quiz ID format: %v", err)
+	if len(violations) > 0 {
+		return nil, badRequest("invalid questions", violations)
 	}
 
-	_, err = s.quizService.GetByID(ctx, quizID)
-	if err != nil {
-		if errors.Is(err, quiz.ErrQuizNotFound) {
-			return nil, status.Errorf(codes.NotFound, "quiz not found: %v", err)
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get quiz: %v", err)
+	var mediaIDs []uuid.UUID
+	for _, q := range questionsToCreate {
+		mediaIDs = append(mediaIDs, q.MediaIDs()...)
+	}
+
+	if err := s.checkMedia(ctx, mediaIDs); err != nil {
+		return nil, err
 	}
 
 	createdQuestions, err := s.service.Add(ctx, quizID, questionsToCreate)
 	if err != nil {
+		if errors.Is(err, question.ErrInvalidQuestion) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid question: %v", err)
+		}
 		return nil, status.Errorf(codes.Internal, "error creating questions: %v", err)
 	}
 
@@ -102,13 +116,34 @@ func (s *QuestionService) BatchGetQuestions(ctx context.Context, request *questi
 		return nil, status.Errorf(codes.InvalidArgument, "invalid quiz ID format: %v", err)
 	}
 
+	q, err := s.quizService.GetByID(ctx, quizID)
+	if err != nil {
+		if errors.Is(err, quiz.ErrQuizNotFound) {
+			return nil, status.Errorf(codes.NotFound, "quiz not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get quiz: %v", err)
+	}
+
+	if !s.quizService.CanView(ctx, q) {
+		return nil, status.Errorf(codes.NotFound, "quiz not found: %v", quiz.ErrQuizNotFound)
+	}
+
 	questions, err := s.service.GetByQuizID(ctx, quizID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get questions by quiz id: %v", err)
 	}
 
+	localization, err := s.translationService.Localize(ctx, q)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to localize quiz: %v", err)
+	}
+
 	var pbQuestions []*questionv1.QuestionResponse
 	for _, q := range questions {
+		q = localization.Question(q)
+		if request.ShuffleOptions {
+			q = q.WithShuffledOptions()
+		}
 		pbQuestions = append(pbQuestions, q.ToProtoWithoutWeights())
 	}
 
@@ -117,7 +152,84 @@ func (s *QuestionService) BatchGetQuestions(ctx context.Context, request *questi
 	}, nil
 }
 
+func (s *QuestionService) UpdateQuestion(ctx context.Context, request *questionv1.UpdateQuestionRequest) (*questionv1.Question, error) {
+	questionToUpdate, err := models.UpdateQuestionToModel(request)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid question: %v", err)
+	}
+
+	q, err := s.quizService.GetByID(ctx, questionToUpdate.QuizID)
+	if err != nil {
+		if errors.Is(err, quiz.ErrQuizNotFound) {
+			return nil, status.Errorf(codes.NotFound, "quiz not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get quiz: %v", err)
+	}
+
+	if err := s.authorize(ctx, q); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkMedia(ctx, questionToUpdate.MediaIDs()); err != nil {
+		return nil, err
+	}
+
+	updatedQuestion, err := s.service.Update(ctx, questionToUpdate)
+	if err != nil {
+		if errors.Is(err, question.ErrQuestionNotFound) {
+			return nil, status.Errorf(codes.NotFound, "question not found: %v", err)
+		}
+		if errors.Is(err, question.ErrInvalidQuestion) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid question: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update question: %v", err)
+	}
+
+	return updatedQuestion.ToProto(), nil
+}
+
+func (s *QuestionService) DeleteQuestion(ctx context.Context, request *questionv1.DeleteQuestionRequest) (*questionv1.DeleteQuestionResponse, error) {
+	questionID, err := uuid.Parse(request.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid question ID format: %v", err)
+	}
+
+	quizID, err := uuid.Parse(request.QuizId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid quiz ID format: %v", err)
+	}
+
+	q, err := s.quizService.GetByID(ctx, quizID)
+	if err != nil {
+		if errors.Is(err, quiz.ErrQuizNotFound) {
+			return nil, status.Errorf(codes.NotFound, "quiz not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get quiz: %v", err)
+	}
+
+	if err := s.authorize(ctx, q); err != nil {
+		return nil, err
+	}
+
+	err = s.service.Delete(ctx, quizID, questionID)
+	if err != nil {
+		if errors.Is(err, question.ErrQuestionNotFound) {
+			return nil, status.Errorf(codes.NotFound, "question not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete question: %v", err)
+	}
+
+	return &questionv1.DeleteQuestionResponse{
+		Id:      request.Id,
+		Message: "Question deleted successfully",
+	}, nil
+}
+
 func (s *QuestionService) EvaluateAnswers(ctx context.Context, request *questionv1.EvaluateAnswersRequest) (*questionv1.EvaluateAnswersResponse, error) {
+	if len(request.IdempotencyKey) > models.MaxIdempotencyKeyLength {
+		return nil, status.Errorf(codes.InvalidArgument, "idempotency key is longer than %d characters", models.MaxIdempotencyKeyLength)
+	}
+
 	var answers []models.Answer
 	for _, answer := range request.Answers {
 		modelAnswer, err := models.AnswerToModel(answer)
@@ -140,44 +252,108 @@ func (s *QuestionService) EvaluateAnswers(ctx context.Context, request *question
 		return nil, status.Errorf(codes.Internal, "failed to get quiz: %v", err)
 	}
 
-	result, err := s.service.EvaluateAnswers(ctx, answers, q)
+	if !s.quizService.CanView(ctx, q) {
+		return nil, status.Errorf(codes.NotFound, "quiz not found: %v", quiz.ErrQuizNotFound)
+	}
+
+	if q.Status == models.QuizStatusArchived {
+		return nil, status.Error(codes.FailedPrecondition, "quiz is archived")
+	}
+
+	if q.QuestionDraw != nil {
+		return nil, status.Error(codes.FailedPrecondition, "quiz draws questions per attempt, take it through an attempt")
+	}
+
+	if q.TimeLimitSeconds > 0 || q.QuestionTimeLimitSeconds > 0 {
+		return nil, status.Error(codes.FailedPrecondition, "quiz has a time limit, take it through an attempt")
+	}
+
+	evaluation, err := s.service.EvaluateAnswers(ctx, answers, q, nil)
 	if err != nil {
+		switch {
+		case errors.Is(err, question.ErrNoAnswers),
+			errors.Is(err, question.ErrIncompleteAnswers),
+			errors.Is(err, question.ErrDuplicateAnswer),
+			errors.Is(err, question.ErrInvalidAnswer):
+			return nil, status.Errorf(codes.InvalidArgument, "invalid answers: %v", err)
+		case errors.Is(err, question.ErrTiebreakerQuestionNotSet),
+			errors.Is(err, question.ErrTiebreakerAnswerRequired):
+			return nil, status.Errorf(codes.FailedPrecondition, "failed to break tie: %v", err)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to evaluate answers: %v", err)
 	}
 
-	userIDStr, ok := ctx.Value("user_id").(string)
-	if !ok {
-		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	takerID, err := s.quizService.TakerID(ctx)
+	if err != nil {
+		if errors.Is(err, quiz.ErrNoQuizTaker) {
+			return nil, status.Errorf(codes.Unauthenticated, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to check guest session: %v", err)
+	}
+
+	version, err := s.quizService.EnsureVersion(ctx, q.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get quiz version: %v", err)
 	}
 
-	userID, err := uuid.Parse(userIDStr)
+	item := evaluation.ToHistoryItem(takerID, q.ID, version.ID)
+	item.CompletedAt = timestamppb.Now()
+
+	err = s.outboxService.Add(ctx, &models.OutboxMessage{
+		IdempotencyKey: models.EvaluationIdempotencyKey(takerID, request.IdempotencyKey),
+		Item:           item,
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID format: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to record quiz completion: %v", err)
 	}
 
-	err = s.addToQuizCompletionHistory(ctx, userID, q.ID, result)
+	localization, err := s.translationService.Localize(ctx, q)
 	if err != nil {
-		log.Printf("failed to add to quiz completion history: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to localize quiz: %v", err)
 	}
 
-	return &questionv1.EvaluateAnswersResponse{Result: result}, nil
+	return localization.Evaluation(q.Results, evaluation).ToProto(), nil
 }
 
-func (s *QuestionService) addToQuizCompletionHistory(ctx context.Context, userID, quizID uuid.UUID, result string) error {
-	historyItem := &historyv1.QuizCompletionHistoryItem{
-		UserId:     userID.String(),
-		QuizId:     quizID.String(),
-		QuizResult: result,
+func (s *QuestionService) authorize(ctx context.Context, q *models.Quiz) error {
+	err := s.quizService.CheckAuthor(ctx, q)
+	if err == nil {
+		return nil
 	}
 
-	request := &historyv1.CreateItemRequest{
-		Item: historyItem,
+	if errors.Is(err, quiz.ErrNotQuizAuthor) {
+		return status.Errorf(codes.PermissionDenied, "permission denied: %v", err)
 	}
+	return status.Errorf(codes.Unauthenticated, "user not authenticated: %v", err)
+}
 
-	_, err := s.historyClient.CreateItem(ctx, request)
+func fieldViolation(prefix, field, description string) *errdetails.BadRequest_FieldViolation {
+	if field != "" {
+		prefix += "." + field
+	}
+	return &errdetails.BadRequest_FieldViolation{Field: prefix, Description: description}
+}
+
+// badRequest reports the violations as google.rpc.BadRequest details of an
+// InvalidArgument status.
+func badRequest(message string, violations []*errdetails.BadRequest_FieldViolation) error {
+	st := status.New(codes.InvalidArgument, message)
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
 	if err != nil {
-		return status.Errorf(codes.Internal, "failed to add quiz history: %v", err)
+		return st.Err()
 	}
+	return detailed.Err()
+}
 
-	return nil
+// checkMedia rejects references to media that was never uploaded.
+func (s *QuestionService) checkMedia(ctx context.Context, ids []uuid.UUID) error {
+	err := s.mediaService.CheckExists(ctx, ids)
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, media.ErrMediaNotFound) {
+		return status.Errorf(codes.InvalidArgument, "invalid media reference: %v", err)
+	}
+	return status.Errorf(codes.Internal, "failed to check media: %v", err)
 }
@@ -3,6 +3,7 @@ package mocks
 import (
 	"context"
 
+	"github.com/google/uuid"
 	"github.com/mibrgmv/whoami-server/quiz/internal/models"
 	"github.com/mibrgmv/whoami-server/quiz/internal/service/question"
 	"github.com/stretchr/testify/mock"
@@ -21,3 +22,13 @@ func (m *MockRepository) Query(ctx context.Context, query question.Query) ([]*mo
 	args := m.Called(ctx, query)
 	return args.Get(0).([]*models.Question), args.Error(1)
 }
+
+func (m *MockRepository) Update(ctx context.Context, q *models.Question) (*models.Question, error) {
+	args := m.Called(ctx, q)
+	return args.Get(0).(*models.Question), args.Error(1)
+}
+
+func (m *MockRepository) Delete(ctx context.Context, quizID, questionID uuid.UUID) error {
+	args := m.Called(ctx, quizID, questionID)
+	return args.Error(0)
+}
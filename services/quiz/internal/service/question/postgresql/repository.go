@@ -6,11 +6,18 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/mibrgmv/whoami-server/quiz/internal/models"
 	"github.com/mibrgmv/whoami-server/quiz/internal/service/question"
 )
 
+const resetQuizVersionSQL = `
+	update quizzes
+	set current_version_id = null
+	where quiz_id = any ($1)
+	`
+
 type Repository struct {
 	pool *pgxpool.Pool
 }
@@ -37,34 +44,83 @@ func (r *Repository) Add(ctx context.Context, questions []*models.Question) ([]*
 
 	}()
 
+	createdQuestions, err := Insert(ctx, tx, questions)
+	if err != nil {
+		return nil, err
+	}
+
+	return createdQuestions, nil
+}
+
+// Insert adds the questions after the existing questions of their quizzes
+// within the transaction. Questions without an ID get a new one.
+func Insert(ctx context.Context, tx pgx.Tx, questions []*models.Question) ([]*models.Question, error) {
 	sql := `
-	insert into questions (question_id, quiz_id, question_body, question_options_weights)
+	insert into questions (question_id, quiz_id, question_body, question_options, question_type, question_position,
+	                       question_route, question_tags, question_image_id)
 	select question_id,
 	       quiz_id,
 	       question_body,
-	       question_options_weights
-	from unnest($1::uuid[], $2::uuid[], $3::text[], $4::jsonb[])
-	    as source (question_id, quiz_id, question_body, question_options_weights)
-	returning question_id
+	       question_options,
+	       question_type,
+	       coalesce((select max(question_position) + 1
+	                 from questions
+	                 where questions.quiz_id = source.quiz_id), 0) + source.ordinality - 1,
+	       nullif(question_route, 'null'),
+	       array(select jsonb_array_elements_text(question_tags)),
+	       nullif(question_image_id, '')::uuid
+	from unnest($1::uuid[], $2::uuid[], $3::text[], $4::jsonb[], $5::text[], $6::jsonb[], $7::jsonb[], $8::text[])
+	    with ordinality
+	    as source (question_id, quiz_id, question_body, question_options, question_type, question_route, question_tags,
+	               question_image_id, ordinality)
+	returning question_id, question_position
 	`
 
 	questionIDs := make([]uuid.UUID, len(questions))
 	quizIDs := make([]uuid.UUID, len(questions))
 	bodies := make([]string, len(questions))
-	optionWeights := make([][]byte, len(questions))
+	options := make([][]byte, len(questions))
+	types := make([]string, len(questions))
+	routes := make([][]byte, len(questions))
+	tags := make([][]byte, len(questions))
+	imageIDs := make([]string, len(questions))
 
 	for i, q := range questions {
-		questionIDs[i] = uuid.New()
+		if q.ID == uuid.Nil {
+			q.ID = uuid.New()
+		}
+		questionIDs[i] = q.ID
 		quizIDs[i] = q.QuizID
 		bodies[i] = q.Body
-		optionsWeightsJSON, err := json.Marshal(q.OptionsWeights)
+		optionsJSON, err := json.Marshal(q.Options)
 		if err != nil {
-			return nil, fmt.Errorf("failed to marshal options_weights: %w", err)
+			return nil, fmt.Errorf("failed to marshal options: %w", err)
+		}
+		options[i] = optionsJSON
+
+		if q.Type == "" {
+			q.Type = models.QuestionTypeSingleChoice
+		}
+		types[i] = string(q.Type)
+
+		routeJSON, err := json.Marshal(q.Route)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal route: %w", err)
+		}
+		routes[i] = routeJSON
+
+		tagsJSON, err := json.Marshal(q.Tags)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal tags: %w", err)
+		}
+		tags[i] = tagsJSON
+
+		if q.ImageID != nil {
+			imageIDs[i] = q.ImageID.String()
 		}
-		optionWeights[i] = optionsWeightsJSON
 	}
 
-	rows, err := tx.Query(ctx, sql, questionIDs, quizIDs, bodies, optionWeights)
+	rows, err := tx.Query(ctx, sql, questionIDs, quizIDs, bodies, options, types, routes, tags, imageIDs)
 	if err != nil {
 		return nil, fmt.Errorf("failed to insert questions: %w", err)
 	}
@@ -73,17 +129,24 @@ func (r *Repository) Add(ctx context.Context, questions []*models.Question) ([]*
 	createdQuestions := make([]*models.Question, 0, len(questions))
 	for i := 0; rows.Next(); i++ {
 		var createdID uuid.UUID
-		if err := rows.Scan(&createdID); err != nil {
+		var position int32
+		if err := rows.Scan(&createdID, &position); err != nil {
 			return nil, fmt.Errorf("failed to scan returned question_id: %w", err)
 		}
 
 		questions[i].ID = createdID
+		questions[i].Position = position
 		createdQuestions = append(createdQuestions, questions[i])
 	}
 
 	if err := rows.Err(); err != nil {
 		return nil, fmt.Errorf("rows error: %w", err)
 	}
+	rows.Close()
+
+	if _, err := tx.Exec(ctx, resetQuizVersionSQL, quizIDs); err != nil {
+		return nil, fmt.Errorf("failed to reset quiz version: %w", err)
+	}
 
 	return createdQuestions, nil
 }
@@ -93,9 +156,15 @@ func (r *Repository) Query(ctx context.Context, query question.Query) ([]*models
 	select question_id,
 	       quiz_id,
 		   question_body,
-		   question_options_weights
+		   question_options,
+		   question_type,
+		   question_position,
+		   question_route,
+		   question_tags,
+		   question_image_id
 	from questions
-	where ($1::uuid[] is null or cardinality($1) = 0 or quiz_id = any ($1))`
+	where ($1::uuid[] is null or cardinality($1) = 0 or quiz_id = any ($1))
+	order by question_position, question_id`
 
 	rows, err := r.pool.Query(ctx, sql, query.QuizIds)
 	if err != nil {
@@ -106,13 +175,14 @@ func (r *Repository) Query(ctx context.Context, query question.Query) ([]*models
 	var questions []*models.Question
 	for rows.Next() {
 		q := new(models.Question)
-		var optionsWeightsJSON []byte
+		var optionsJSON []byte
 
-		if err := rows.Scan(&q.ID, &q.QuizID, &q.Body, &optionsWeightsJSON); err != nil {
+		if err := rows.Scan(&q.ID, &q.QuizID, &q.Body, &optionsJSON, &q.Type, &q.Position, &q.Route, &q.Tags,
+			&q.ImageID); err != nil {
 			return nil, fmt.Errorf("scan failed: %w", err)
 		}
 
-		if err = json.Unmarshal(optionsWeightsJSON, &q.OptionsWeights); err != nil {
+		if err = json.Unmarshal(optionsJSON, &q.Options); err != nil {
 			return nil, fmt.Errorf("unmarshal failed: %w", err)
 		}
 
@@ -125,3 +195,68 @@ func (r *Repository) Query(ctx context.Context, query question.Query) ([]*models
 
 	return questions, nil
 }
+
+func (r *Repository) Update(ctx context.Context, q *models.Question) (*models.Question, error) {
+	sql := `
+	with updated as (
+	    update questions
+	    set question_body     = $3,
+	        question_options  = $4,
+	        question_type     = $5,
+	        question_route    = $6,
+	        question_tags     = coalesce($7::text[], '{}'),
+	        question_image_id = $8
+	    where question_id = $1
+	      and quiz_id = $2
+	    returning quiz_id
+	)
+	update quizzes
+	set current_version_id = null
+	where quiz_id in (select quiz_id from updated)
+	`
+
+	optionsJSON, err := json.Marshal(q.Options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal options: %w", err)
+	}
+
+	if q.Type == "" {
+		q.Type = models.QuestionTypeSingleChoice
+	}
+
+	tag, err := r.pool.Exec(ctx, sql, q.ID, q.QuizID, q.Body, optionsJSON, q.Type, q.Route, q.Tags, q.ImageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update question: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return nil, question.ErrQuestionNotFound
+	}
+
+	return q, nil
+}
+
+func (r *Repository) Delete(ctx context.Context, quizID, questionID uuid.UUID) error {
+	sql := `
+	with deleted as (
+	    delete from questions
+	    where question_id = $1
+	      and quiz_id = $2
+	    returning quiz_id
+	)
+	update quizzes
+	set current_version_id = null
+	where quiz_id in (select quiz_id from deleted)
+	`
+
+	tag, err := r.pool.Exec(ctx, sql, questionID, quizID)
+	if err != nil {
+		return fmt.Errorf("failed to delete question: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return question.ErrQuestionNotFound
+	}
+
+	return nil
+}
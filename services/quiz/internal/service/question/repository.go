@@ -3,10 +3,13 @@ package question
 import (
 	"context"
 
+	"github.com/google/uuid"
 	"github.com/mibrgmv/whoami-server/quiz/internal/models"
 )
 
 type Repository interface {
 	Add(ctx context.Context, questions []*models.Question) ([]*models.Question, error)
 	Query(ctx context.Context, query Query) ([]*models.Question, error)
+	Update(ctx context.Context, question *models.Question) (*models.Question, error)
+	Delete(ctx context.Context, quizID, questionID uuid.UUID) error
 }
@@ -0,0 +1,204 @@
+package question
+
+import (
+	"math/rand/v2"
+
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+)
+
+// Choice is a validated answer: the answered question and the weights of the chosen option.
+type Choice struct {
+	Question *models.Question
+	Weights  []float32
+}
+
+// Scorer turns the choices of a completed quiz into an evaluation. Choices are
+// already checked against the quiz: every question on the path taken through
+// the quiz flow is answered once (questions left unanswered when an attempt ran
+// out of time get zero weights) and every weights slice has quiz.WeightsLen()
+// elements.
+type Scorer interface {
+	Score(quiz *models.Quiz, choices []Choice) (*models.Evaluation, error)
+}
+
+func defaultScorers() map[models.ScoringModel]Scorer {
+	return map[models.ScoringModel]Scorer{
+		models.ScoringWeightedSum: WeightedSumScorer{},
+		models.ScoringKnowledge:   KnowledgeScorer{},
+		models.ScoringTraits:      TraitsScorer{},
+		models.ScoringBands:       BandsScorer{},
+	}
+}
+
+// WeightedSumScorer adds up the per-result weights of the chosen options and
+// picks the result with the highest total, breaking ties by the quiz policy.
+type WeightedSumScorer struct{}
+
+func (WeightedSumScorer) Score(quiz *models.Quiz, choices []Choice) (*models.Evaluation, error) {
+	if len(quiz.Results) == 0 {
+		return nil, ErrNoResults
+	}
+
+	results := make([]float32, len(quiz.Results))
+	var tiebreakerWeights []float32
+
+	for _, choice := range choices {
+		if quiz.IsTiebreaker(choice.Question.ID) {
+			tiebreakerWeights = choice.Weights
+			continue
+		}
+
+		for i, weight := range choice.Weights {
+			results[i] += weight
+		}
+	}
+
+	policy := quiz.TieBreakPolicy
+	if policy == "" {
+		policy = models.TieBreakFirstDeclared
+	}
+
+	tied := topIndexes(results)
+	winner := tied[0]
+
+	if len(tied) > 1 {
+		switch policy {
+		case models.TieBreakRandom:
+			r := rand.New(rand.NewPCG(uint64(quiz.TieBreakSeed), uint64(len(tied))))
+			winner = tied[r.IntN(len(tied))]
+		case models.TieBreakTiebreakerQuestion:
+			if tiebreakerWeights == nil {
+				return nil, ErrTiebreakerAnswerRequired
+			}
+			for _, i := range tied {
+				if tiebreakerWeights[i] > tiebreakerWeights[winner] {
+					winner = i
+				}
+			}
+		}
+	}
+
+	evaluation := models.NewEvaluation(quiz.Results, results, winner)
+	evaluation.ScoringModel = models.ScoringWeightedSum
+	evaluation.TieBreakPolicy = policy
+	if policy == models.TieBreakReportAll && len(tied) > 1 {
+		for _, i := range tied {
+			evaluation.TiedResults = append(evaluation.TiedResults, quiz.Results[i])
+		}
+	}
+
+	return evaluation, nil
+}
+
+// KnowledgeScorer treats the single weight of an option as the points it is
+// worth; answers earning positive points are correct. The share of the maximum
+// points is mapped to a result through the quiz thresholds (in percent), or
+// split evenly across the results when the quiz has none.
+type KnowledgeScorer struct{}
+
+func (KnowledgeScorer) Score(quiz *models.Quiz, choices []Choice) (*models.Evaluation, error) {
+	if len(quiz.Results) == 0 {
+		return nil, ErrNoResults
+	}
+
+	evaluation := &models.Evaluation{ScoringModel: models.ScoringKnowledge}
+	for _, choice := range choices {
+		if choice.Weights[0] > 0 {
+			evaluation.Score += choice.Weights[0]
+			evaluation.CorrectAnswers++
+		}
+
+		evaluation.MaxScore += maxPoints(choice.Question)
+	}
+
+	var percentage float32
+	if evaluation.MaxScore > 0 {
+		percentage = evaluation.Score / evaluation.MaxScore * 100
+	}
+
+	thresholds := quiz.ScoreThresholds
+	if len(thresholds) == 0 {
+		for i := 1; i < len(quiz.Results); i++ {
+			thresholds = append(thresholds, float32(i)*100/float32(len(quiz.Results)))
+		}
+	}
+
+	evaluation.Result = quiz.Results[min(band(thresholds, percentage), len(quiz.Results)-1)]
+	return evaluation, nil
+}
+
+// TraitsScorer scores every trait axis independently: the i-th weight of an
+// option moves axis i towards its positive pole when positive and towards the
+// negative pole otherwise. The result is the concatenation of the winning
+// poles, with a balanced axis resolved to its positive pole.
+type TraitsScorer struct{}
+
+func (TraitsScorer) Score(quiz *models.Quiz, choices []Choice) (*models.Evaluation, error) {
+	traits := make([]models.TraitScore, len(quiz.TraitAxes))
+	for i, axis := range quiz.TraitAxes {
+		traits[i].TraitAxis = axis
+	}
+
+	for _, choice := range choices {
+		for i, weight := range choice.Weights {
+			traits[i].Score += weight
+		}
+	}
+
+	evaluation := &models.Evaluation{ScoringModel: models.ScoringTraits}
+	for i := range traits {
+		if traits[i].Score >= 0 {
+			traits[i].Pole = traits[i].Positive
+		} else {
+			traits[i].Pole = traits[i].Negative
+		}
+		evaluation.Result += traits[i].Pole
+	}
+	evaluation.Traits = traits
+
+	return evaluation, nil
+}
+
+// BandsScorer adds up the single weight of the chosen options and maps the
+// total to a result through the quiz thresholds: a quiz with n results has
+// n-1 ascending thresholds, and a total reaching the i-th threshold lands in
+// the (i+1)-th result.
+type BandsScorer struct{}
+
+func (BandsScorer) Score(quiz *models.Quiz, choices []Choice) (*models.Evaluation, error) {
+	if len(quiz.Results) == 0 {
+		return nil, ErrNoResults
+	}
+
+	evaluation := &models.Evaluation{ScoringModel: models.ScoringBands}
+	for _, choice := range choices {
+		evaluation.Score += choice.Weights[0]
+	}
+
+	evaluation.Result = quiz.Results[min(band(quiz.ScoreThresholds, evaluation.Score), len(quiz.Results)-1)]
+	return evaluation, nil
+}
+
+// band returns the number of thresholds the score reaches.
+func band(thresholds []float32, score float32) int {
+	i := 0
+	for i < len(thresholds) && score >= thresholds[i] {
+		i++
+	}
+	return i
+}
+
+// topIndexes returns the indexes of all results sharing the highest total, in declaration order.
+func topIndexes(totals []float32) []int {
+	top := []int{0}
+	for i := 1; i < len(totals); i++ {
+		switch {
+		case totals[i] > totals[top[0]]:
+			top = []int{i}
+		case totals[i] == totals[top[0]]:
+			top = append(top, i)
+		}
+	}
+
+	return top
+}
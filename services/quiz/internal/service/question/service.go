@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/google/uuid"
 	"github.com/mibrgmv/whoami-server/quiz/internal/models"
@@ -11,37 +12,142 @@ import (
 )
 
 const (
-	questionsCacheKey = "questions:quiz:%s"
+	questionsCacheKey = "questions:v2:quiz:%s"
 )
 
 var (
+	ErrQuestionNotFound       = errors.New("question not found")
 	ErrAnswerQuizIdMismatch   = errors.New("answer quiz ID does not match quiz ID")
 	ErrQuestionQuizIdMismatch = errors.New("question quiz ID does not match quiz ID")
+
+	ErrNoAnswers                = errors.New("no answers provided")
+	ErrIncompleteAnswers        = errors.New("not all questions are answered")
+	ErrDuplicateAnswer          = errors.New("question is answered more than once")
+	ErrTiebreakerQuestionNotSet = errors.New("quiz has no tiebreaker question")
+	ErrTiebreakerAnswerRequired = errors.New("results are tied, an answer to the tiebreaker question is required")
+
+	ErrInvalidQuestion = errors.New("invalid question")
+	ErrInvalidAnswer   = errors.New("invalid answer")
+
+	ErrUnknownScoringModel = errors.New("unknown scoring model")
+	ErrNoResults           = errors.New("quiz has no results")
 )
 
 type Service struct {
-	repo  Repository
-	cache storage.Cache
+	repo    Repository
+	cache   storage.Cache
+	scorers map[models.ScoringModel]Scorer
+
+	// version holds the questions to walk and score instead of the current
+	// questions of the quiz, see AtVersion.
+	version *models.QuizVersion
 }
 
 func NewService(repo Repository, cache storage.Cache) *Service {
 	return &Service{
-		repo:  repo,
-		cache: cache,
+		repo:    repo,
+		cache:   cache,
+		scorers: defaultScorers(),
 	}
 }
 
+// RegisterScorer replaces the scorer used for quizzes with the given scoring model.
+func (s *Service) RegisterScorer(model models.ScoringModel, scorer Scorer) {
+	s.scorers[model] = scorer
+}
+
+// AtVersion returns a service that draws, walks and scores the questions of
+// the quiz version instead of the current questions of the quiz, so that an
+// attempt stays on the version it was started on.
+func (s *Service) AtVersion(version *models.QuizVersion) *Service {
+	at := *s
+	at.version = version
+	return &at
+}
+
 func (s *Service) Add(ctx context.Context, quizID uuid.UUID, questions []*models.Question) ([]*models.Question, error) {
-	cacheKey := fmt.Sprintf(questionsCacheKey, quizID)
+	for _, q := range questions {
+		assignOptionIDs(q, nil)
+		if err := ValidateQuestion(q); err != nil {
+			return nil, err
+		}
+	}
 
-	err := s.cache.Delete(ctx, cacheKey)
-	if err != nil {
+	if err := s.InvalidateCache(ctx, quizID); err != nil {
 		return nil, err
 	}
 
 	return s.repo.Add(ctx, questions)
 }
 
+func (s *Service) Update(ctx context.Context, question *models.Question) (*models.Question, error) {
+	questions, err := s.GetByQuizID(ctx, question.QuizID)
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(questions, func(q *models.Question) bool { return q.ID == question.ID })
+	if i < 0 {
+		return nil, ErrQuestionNotFound
+	}
+
+	for _, option := range question.Options {
+		if _, exists := questions[i].OptionByID(option.ID); option.ID != uuid.Nil && !exists {
+			return nil, fmt.Errorf("%w: option ID %s does not belong to question %s", ErrInvalidQuestion, option.ID, question.ID)
+		}
+	}
+
+	assignOptionIDs(question, questions[i])
+	question.Position = questions[i].Position
+	if err := ValidateQuestion(question); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.Update(ctx, question)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.InvalidateCache(ctx, question.QuizID); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// assignOptionIDs gives every option without an ID the ID of the existing
+// option with the same text, if any, or a new one, so that options keep their
+// IDs across updates made with option texts only.
+func assignOptionIDs(q, existing *models.Question) {
+	for i := range q.Options {
+		option := &q.Options[i]
+		if option.ID != uuid.Nil {
+			continue
+		}
+
+		if existing != nil {
+			if previous, exists := existing.OptionByText(option.Text); exists {
+				option.ID = previous.ID
+				continue
+			}
+		}
+
+		option.ID = uuid.New()
+	}
+}
+
+func (s *Service) Delete(ctx context.Context, quizID, questionID uuid.UUID) error {
+	if err := s.repo.Delete(ctx, quizID, questionID); err != nil {
+		return err
+	}
+
+	return s.InvalidateCache(ctx, quizID)
+}
+
+func (s *Service) InvalidateCache(ctx context.Context, quizID uuid.UUID) error {
+	return s.cache.Delete(ctx, fmt.Sprintf(questionsCacheKey, quizID))
+}
+
 func (s *Service) GetByQuizID(ctx context.Context, quizID uuid.UUID) ([]*models.Question, error) {
 	cacheKey := fmt.Sprintf(questionsCacheKey, quizID)
 
@@ -63,65 +169,151 @@ func (s *Service) GetByQuizID(ctx context.Context, quizID uuid.UUID) ([]*models.
 	return questions, nil
 }
 
-func (s *Service) EvaluateAnswers(ctx context.Context, answers []models.Answer, quiz *models.Quiz) (string, error) {
-	questions, err := s.GetByQuizID(ctx, quiz.ID)
+// CheckAnswer validates an answer to a quiz that has already been answered
+// with the given answers. The question has to be drawn, when drawn is not nil,
+// and be on the path these answers lead along through the quiz flow or be the
+// next one to answer.
+func (s *Service) CheckAnswer(ctx context.Context, quiz *models.Quiz, answers []models.Answer, answer models.Answer, drawn []uuid.UUID) error {
+	if answer.QuizID != quiz.ID {
+		return ErrAnswerQuizIdMismatch
+	}
+
+	if err := checkDrawn(answer, drawn); err != nil {
+		return err
+	}
+
+	questions, err := s.drawnQuestions(ctx, quiz.ID, drawn)
+	if err != nil {
+		return err
+	}
+
+	flow := models.NewFlow(questions)
+	q, exists := flow.Question(answer.QuestionID)
+	if !exists {
+		return fmt.Errorf("%w: question with ID %s not found", ErrInvalidAnswer, answer.QuestionID)
+	}
+
+	choices, next, err := walk(quiz, flow, answersByQuestion(answers), nil)
+	if err != nil {
+		return err
+	}
+
+	onPath := q == next || slices.ContainsFunc(choices, func(c Choice) bool { return c.Question == q })
+	if !onPath {
+		return fmt.Errorf("%w: question %s is not on the path of the given answers", ErrInvalidAnswer, q.ID)
+	}
+
+	_, err = answerWeights(q, answer, quiz.WeightsLen())
+	return err
+}
+
+// NextQuestion returns the question to answer after the given answers, or nil
+// when the quiz is over and the answers can be evaluated.
+func (s *Service) NextQuestion(ctx context.Context, quiz *models.Quiz, answers []models.Answer, drawn []uuid.UUID) (*models.Question, error) {
+	questions, err := s.drawnQuestions(ctx, quiz.ID, drawn)
+	if err != nil {
+		return nil, err
+	}
+
+	_, next, err := walk(quiz, models.NewFlow(questions), answersByQuestion(answers), nil)
+	return next, err
+}
+
+// answersByQuestion keeps the last answer to every question.
+func answersByQuestion(answers []models.Answer) map[uuid.UUID]models.Answer {
+	byQuestion := make(map[uuid.UUID]models.Answer, len(answers))
+	for _, answer := range answers {
+		byQuestion[answer.QuestionID] = answer
+	}
+	return byQuestion
+}
+
+// EvaluateAnswers evaluates the answers to the questions drawn for an attempt,
+// or to every question of the quiz when drawn is nil. Answers to questions that
+// were not drawn are rejected with ErrInvalidAnswer.
+func (s *Service) EvaluateAnswers(ctx context.Context, answers []models.Answer, quiz *models.Quiz, drawn []uuid.UUID) (*models.Evaluation, error) {
+	if len(answers) == 0 {
+		return nil, ErrNoAnswers
+	}
+
+	return s.evaluate(ctx, answers, quiz, drawn, false)
+}
+
+// EvaluatePartialAnswers evaluates an attempt that ran out of time: unanswered
+// questions count as answered with zero weights.
+func (s *Service) EvaluatePartialAnswers(ctx context.Context, answers []models.Answer, quiz *models.Quiz, drawn []uuid.UUID) (*models.Evaluation, error) {
+	return s.evaluate(ctx, answers, quiz, drawn, true)
+}
+
+func (s *Service) evaluate(ctx context.Context, answers []models.Answer, quiz *models.Quiz, drawn []uuid.UUID, partial bool) (*models.Evaluation, error) {
+	model := quiz.ScoringModel
+	if model == "" {
+		model = models.ScoringWeightedSum
+	}
+
+	scorer, ok := s.scorers[model]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownScoringModel, model)
+	}
+
+	if quiz.TieBreakPolicy == models.TieBreakTiebreakerQuestion && quiz.TiebreakerQuestionID == nil {
+		return nil, ErrTiebreakerQuestionNotSet
+	}
+
+	questions, err := s.drawnQuestions(ctx, quiz.ID, drawn)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	for _, answer := range answers {
 		if answer.QuizID != quiz.ID {
-			return "", ErrAnswerQuizIdMismatch
+			return nil, ErrAnswerQuizIdMismatch
+		}
+		if err := checkDrawn(answer, drawn); err != nil {
+			return nil, err
 		}
 	}
 
 	for _, question := range questions {
 		if question.QuizID != quiz.ID {
-			return "", ErrQuestionQuizIdMismatch
+			return nil, ErrQuestionQuizIdMismatch
 		}
 	}
 
-	numResults := len(quiz.Results)
-	results := make([]float32, numResults)
-
-	questionsMap := make(map[uuid.UUID]*models.Question)
-	for _, q := range questions {
-		questionsMap[q.ID] = q
-	}
+	flow := models.NewFlow(questions)
+	answered := make(map[uuid.UUID]models.Answer, len(answers))
 
 	for _, answer := range answers {
-		question, exists := questionsMap[answer.QuestionID]
+		question, exists := flow.Question(answer.QuestionID)
 		if !exists {
-			return "", fmt.Errorf("question with ID %d not found", answer.QuestionID)
+			return nil, fmt.Errorf("question with ID %s not found", answer.QuestionID)
 		}
 
-		weights, exists := question.OptionsWeights[answer.Body]
-		if !exists {
-			return "", fmt.Errorf("option '%s' not found for question %d", answer.Body, question.ID)
+		if _, exists := answered[question.ID]; exists {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicateAnswer, question.ID)
 		}
+		answered[question.ID] = answer
+	}
 
-		if len(weights) != numResults {
-			return "", fmt.Errorf("weights length for option '%s' does not match number of results", answer.Body)
-		}
+	choices, next, err := walk(quiz, flow, answered, func(q *models.Question) bool {
+		return partial || quiz.IsTiebreaker(q.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		for i, weight := range weights {
-			results[i] += weight
-		}
+	if next != nil {
+		return nil, fmt.Errorf("%w: question %s has no answer", ErrIncompleteAnswers, next.ID)
 	}
 
-	maxIndex := 0
-	maxWeight := results[0]
-	for i, weight := range results {
-		if weight > maxWeight {
-			maxWeight = weight
-			maxIndex = i
-		}
+	evaluation, err := scorer.Score(quiz, choices)
+	if err != nil {
+		return nil, err
 	}
 
-	if maxIndex >= len(quiz.Results) {
-		return "", fmt.Errorf("cannot map actual results to expected")
+	if evaluation.Result != "" {
+		evaluation.ResultDetail = quiz.ResultDetail(evaluation.Result)
 	}
 
-	topResult := quiz.Results[maxIndex]
-	return topResult, nil
+	return evaluation, nil
 }
@@ -3,6 +3,8 @@ package question_test
 import (
 	"context"
 	"errors"
+	"math"
+	"slices"
 	"testing"
 
 	"github.com/google/uuid"
@@ -37,41 +39,41 @@ func TestEvaluateAnswers(t *testing.T) {
 			ID:     questionIDs[0],
 			QuizID: quizID,
 			Body:   "Do you like drinking gasoline?",
-			OptionsWeights: map[string][]float32{
+			Options: models.OptionsFromWeights(map[string][]float32{
 				"Yes": {0.0, 0.0, 1.0},
 				"No":  {0.5, 0.5, 0.0},
-			},
+			}),
 		},
 		{
 			ID:     questionIDs[1],
 			QuizID: quizID,
 			Body:   "Do you like betraying your friends?",
-			OptionsWeights: map[string][]float32{
+			Options: models.OptionsFromWeights(map[string][]float32{
 				"Yes": {1.0, 0.0, 0.0},
 				"No":  {0.0, 0.5, 0.5},
-			},
+			}),
 		},
 		{
 			ID:     questionIDs[2],
 			QuizID: quizID,
 			Body:   "Are you good at math?",
-			OptionsWeights: map[string][]float32{
+			Options: models.OptionsFromWeights(map[string][]float32{
 				"Yes": {0.0, 0.0, 0.0},
 				"No":  {0.0, 0.0, 0.0},
-			},
+			}),
 		},
 		{
 			ID:     questionIDs[3],
 			QuizID: quizID,
 			Body:   "Are you fond of hip hop?",
-			OptionsWeights: map[string][]float32{
+			Options: models.OptionsFromWeights(map[string][]float32{
 				"Yes": {0.0, 1.0, 0.0},
 				"No":  {0.5, 0.0, 0.5},
-			},
+			}),
 		},
 	}
 
-	cacheKey := "questions:quiz:" + quizID.String()
+	cacheKey := "questions:v2:quiz:" + quizID.String()
 
 	mockCache.On("Get", mock.Anything, cacheKey, mock.AnythingOfType("*[]*models.Question")).Return(errors.New("cache miss"))
 	mockRepo.On("Query", mock.Anything, question.Query{QuizIds: []uuid.UUID{quizID}}).Return(questions, nil)
@@ -150,16 +152,35 @@ func TestEvaluateAnswers(t *testing.T) {
 		{
 			name:     "Empty answers",
 			answers:  []models.Answer{},
-			expected: "Michael", // No answers meaning all weights are 0, first result is returned
-			wantErr:  false,
-			errMsg:   "",
+			expected: "",
+			wantErr:  true,
+			errMsg:   "no answers provided",
+		},
+		{
+			name: "Incomplete answers",
+			answers: []models.Answer{
+				{QuizID: quizID, QuestionID: questionIDs[0], Body: "Yes"},
+			},
+			expected: "",
+			wantErr:  true,
+			errMsg:   "not all questions are answered",
+		},
+		{
+			name: "Duplicate answer",
+			answers: []models.Answer{
+				{QuizID: quizID, QuestionID: questionIDs[0], Body: "Yes"},
+				{QuizID: quizID, QuestionID: questionIDs[0], Body: "No"},
+			},
+			expected: "",
+			wantErr:  true,
+			errMsg:   "question is answered more than once",
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			ctx := context.Background()
-			result, err := service.EvaluateAnswers(ctx, tt.answers, &quiz)
+			result, err := service.EvaluateAnswers(ctx, tt.answers, &quiz, nil)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -170,8 +191,8 @@ func TestEvaluateAnswers(t *testing.T) {
 			}
 
 			assert.NoError(t, err)
-			assert.Equal(t, tt.expected, result,
-				"Expected %v, got %v", tt.expected, result)
+			assert.Equal(t, tt.expected, result.Result,
+				"Expected %v, got %v", tt.expected, result.Result)
 		})
 	}
 }
@@ -197,13 +218,13 @@ func TestEvaluateAnswers_QuestionQuizIDMismatch(t *testing.T) {
 			ID:     questionID,
 			QuizID: wrongQuizID,
 			Body:   "Question with wrong quiz ID",
-			OptionsWeights: map[string][]float32{
+			Options: models.OptionsFromWeights(map[string][]float32{
 				"Yes": {1.0, 0.0, 0.0},
-			},
+			}),
 		},
 	}
 
-	cacheKey := "questions:quiz:" + quizID.String()
+	cacheKey := "questions:v2:quiz:" + quizID.String()
 
 	mockCache.On("Get", mock.Anything, cacheKey, mock.AnythingOfType("*[]*models.Question")).Return(errors.New("cache miss"))
 	mockRepo.On("Query", mock.Anything, question.Query{QuizIds: []uuid.UUID{quizID}}).Return(questions, nil)
@@ -214,10 +235,10 @@ func TestEvaluateAnswers_QuestionQuizIDMismatch(t *testing.T) {
 	}
 
 	ctx := context.Background()
-	result, err := service.EvaluateAnswers(ctx, answers, &quiz)
+	result, err := service.EvaluateAnswers(ctx, answers, &quiz, nil)
 	assert.Error(t, err)
 	assert.Equal(t, err, question.ErrQuestionQuizIdMismatch)
-	assert.Empty(t, result)
+	assert.Nil(t, result)
 }
 
 // Test for weight length mismatch
@@ -240,13 +261,13 @@ func TestEvaluateAnswers_WeightLengthMismatch(t *testing.T) {
 			ID:     questionID,
 			QuizID: quizID,
 			Body:   "Question with wrong weights length",
-			OptionsWeights: map[string][]float32{
+			Options: models.OptionsFromWeights(map[string][]float32{
 				"Yes": {1.0, 0.0},
-			},
+			}),
 		},
 	}
 
-	cacheKey := "questions:quiz:" + quizID.String()
+	cacheKey := "questions:v2:quiz:" + quizID.String()
 
 	mockCache.On("Get", mock.Anything, cacheKey, mock.AnythingOfType("*[]*models.Question")).Return(errors.New("cache miss"))
 	mockRepo.On("Query", mock.Anything, question.Query{QuizIds: []uuid.UUID{quizID}}).Return(questions, nil)
@@ -257,10 +278,10 @@ func TestEvaluateAnswers_WeightLengthMismatch(t *testing.T) {
 	}
 
 	ctx := context.Background()
-	result, err := service.EvaluateAnswers(ctx, answers, &quiz)
+	result, err := service.EvaluateAnswers(ctx, answers, &quiz, nil)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "weights length for option 'Yes' does not match number of results")
-	assert.Empty(t, result)
+	assert.Nil(t, result)
 }
 
 func TestEvaluateAnswers_CacheHit(t *testing.T) {
@@ -282,14 +303,14 @@ func TestEvaluateAnswers_CacheHit(t *testing.T) {
 			ID:     questionID,
 			QuizID: quizID,
 			Body:   "Do you like drinking gasoline?",
-			OptionsWeights: map[string][]float32{
+			Options: models.OptionsFromWeights(map[string][]float32{
 				"Yes": {0.0, 0.0, 1.0},
 				"No":  {0.5, 0.5, 0.0},
-			},
+			}),
 		},
 	}
 
-	cacheKey := "questions:quiz:" + quizID.String()
+	cacheKey := "questions:v2:quiz:" + quizID.String()
 
 	mockCache.On("Get", mock.Anything, cacheKey, mock.AnythingOfType("*[]*models.Question")).Run(func(args mock.Arguments) {
 		dest := args.Get(2).(*[]*models.Question)
@@ -301,9 +322,1088 @@ func TestEvaluateAnswers_CacheHit(t *testing.T) {
 	}
 
 	ctx := context.Background()
-	result, err := service.EvaluateAnswers(ctx, answers, &quiz)
+	result, err := service.EvaluateAnswers(ctx, answers, &quiz, nil)
 
 	assert.NoError(t, err)
-	assert.Equal(t, "Trevor", result)
+	assert.Equal(t, "Trevor", result.Result)
 	mockRepo.AssertNotCalled(t, "Query")
 }
+
+func TestEvaluateAnswers_ScoreBreakdown(t *testing.T) {
+	mockRepo := new(mocks.MockRepository)
+	mockCache := new(mocks.MockCache)
+	service := question.NewService(mockRepo, mockCache)
+
+	quizID := uuid.New()
+	questionIDs := []uuid.UUID{uuid.New(), uuid.New()}
+
+	quiz := models.Quiz{
+		ID:      quizID,
+		Title:   "GTA Character Quiz",
+		Results: []string{"Michael", "Franklin", "Trevor"},
+	}
+
+	questions := []*models.Question{
+		{
+			ID:     questionIDs[0],
+			QuizID: quizID,
+			Body:   "Do you like drinking gasoline?",
+			Options: models.OptionsFromWeights(map[string][]float32{
+				"Yes": {0.0, 0.0, 1.0},
+				"No":  {0.5, 0.5, 0.0},
+			}),
+		},
+		{
+			ID:     questionIDs[1],
+			QuizID: quizID,
+			Body:   "Are you fond of hip hop?",
+			Options: models.OptionsFromWeights(map[string][]float32{
+				"Yes": {0.0, 1.0, 0.0},
+				"No":  {0.5, 0.0, 0.5},
+			}),
+		},
+	}
+
+	cacheKey := "questions:v2:quiz:" + quizID.String()
+
+	mockCache.On("Get", mock.Anything, cacheKey, mock.AnythingOfType("*[]*models.Question")).Return(errors.New("cache miss"))
+	mockRepo.On("Query", mock.Anything, question.Query{QuizIds: []uuid.UUID{quizID}}).Return(questions, nil)
+	mockCache.On("Set", mock.Anything, cacheKey, mock.AnythingOfType("*[]*models.Question")).Return(nil)
+
+	answers := []models.Answer{
+		{QuizID: quizID, QuestionID: questionIDs[0], Body: "No"},  // +0.5 Michael/Franklin
+		{QuizID: quizID, QuestionID: questionIDs[1], Body: "Yes"}, // +1.0 Franklin
+	}
+
+	result, err := service.EvaluateAnswers(context.Background(), answers, &quiz, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Franklin", result.Result)
+	assert.Equal(t, []models.ResultScore{
+		{Result: "Michael", Total: 0.5, Percentage: 25, Rank: 2},
+		{Result: "Franklin", Total: 1.5, Percentage: 75, Rank: 1},
+		{Result: "Trevor", Total: 0, Percentage: 0, Rank: 3},
+	}, result.Scores)
+}
+
+func TestMutations_InvalidateCache(t *testing.T) {
+	mockRepo := new(mocks.MockRepository)
+	mockCache := new(mocks.MockCache)
+	service := question.NewService(mockRepo, mockCache)
+
+	quizID := uuid.New()
+	questionID := uuid.New()
+	yesID, noID := uuid.New(), uuid.New()
+	cacheKey := "questions:v2:quiz:" + quizID.String()
+
+	existing := &models.Question{
+		ID:     questionID,
+		QuizID: quizID,
+		Body:   "Do you like drinking gasoline?",
+		Options: []models.Option{
+			{ID: yesID, Text: "Yes", Weights: []float32{0.0, 0.0, 1.0}},
+			{ID: noID, Text: "No", Weights: []float32{0.5, 0.5, 0.0}},
+		},
+	}
+
+	q := &models.Question{
+		ID:     questionID,
+		QuizID: quizID,
+		Body:   "Do you enjoy drinking gasoline?",
+		Options: models.OptionsFromWeights(map[string][]float32{
+			"Yes":   {0.0, 0.0, 1.0},
+			"No":    {0.5, 0.5, 0.0},
+			"Maybe": {0.3, 0.3, 0.3},
+		}),
+	}
+
+	mockCache.On("Get", mock.Anything, cacheKey, mock.AnythingOfType("*[]*models.Question")).Return(errors.New("cache miss"))
+	mockRepo.On("Query", mock.Anything, question.Query{QuizIds: []uuid.UUID{quizID}}).Return([]*models.Question{existing}, nil)
+	mockCache.On("Set", mock.Anything, cacheKey, mock.AnythingOfType("*[]*models.Question")).Return(nil)
+	mockRepo.On("Update", mock.Anything, q).Return(q, nil)
+	mockRepo.On("Delete", mock.Anything, quizID, questionID).Return(nil)
+	mockCache.On("Delete", mock.Anything, cacheKey).Return(nil)
+
+	ctx := context.Background()
+
+	updated, err := service.Update(ctx, q)
+	assert.NoError(t, err)
+	assert.Equal(t, q, updated)
+
+	yes, _ := updated.OptionByText("Yes")
+	no, _ := updated.OptionByText("No")
+	maybe, _ := updated.OptionByText("Maybe")
+	assert.Equal(t, yesID, yes.ID)
+	assert.Equal(t, noID, no.ID)
+	assert.NotEqual(t, uuid.Nil, maybe.ID)
+
+	err = service.Delete(ctx, quizID, questionID)
+	assert.NoError(t, err)
+
+	mockCache.AssertNumberOfCalls(t, "Delete", 2)
+}
+
+func TestUpdate_UnknownOptionID(t *testing.T) {
+	mockRepo := new(mocks.MockRepository)
+	mockCache := new(mocks.MockCache)
+	service := question.NewService(mockRepo, mockCache)
+
+	quizID := uuid.New()
+	questionID := uuid.New()
+
+	existing := &models.Question{
+		ID:      questionID,
+		QuizID:  quizID,
+		Body:    "Question",
+		Options: []models.Option{{ID: uuid.New(), Text: "Yes", Weights: []float32{1}}},
+	}
+
+	mockCache.On("Get", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("cache miss"))
+	mockRepo.On("Query", mock.Anything, mock.Anything).Return([]*models.Question{existing}, nil)
+	mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	_, err := service.Update(context.Background(), &models.Question{
+		ID:      questionID,
+		QuizID:  quizID,
+		Body:    "Question",
+		Options: []models.Option{{ID: uuid.New(), Text: "Yes", Weights: []float32{1}}},
+	})
+	assert.ErrorIs(t, err, question.ErrInvalidQuestion)
+
+	_, err = service.Update(context.Background(), &models.Question{ID: uuid.New(), QuizID: quizID})
+	assert.ErrorIs(t, err, question.ErrQuestionNotFound)
+	mockRepo.AssertNotCalled(t, "Update")
+}
+
+func TestDelete_NotFoundKeepsCache(t *testing.T) {
+	mockRepo := new(mocks.MockRepository)
+	mockCache := new(mocks.MockCache)
+	service := question.NewService(mockRepo, mockCache)
+
+	quizID := uuid.New()
+	questionID := uuid.New()
+
+	mockRepo.On("Delete", mock.Anything, quizID, questionID).Return(question.ErrQuestionNotFound)
+
+	err := service.Delete(context.Background(), quizID, questionID)
+	assert.ErrorIs(t, err, question.ErrQuestionNotFound)
+	mockCache.AssertNotCalled(t, "Delete")
+}
+
+func TestEvaluateAnswers_TieBreak(t *testing.T) {
+	quizID := uuid.New()
+	questionIDs := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+
+	questions := []*models.Question{
+		{
+			ID:     questionIDs[0],
+			QuizID: quizID,
+			Body:   "Do you like drinking gasoline?",
+			Options: models.OptionsFromWeights(map[string][]float32{
+				"Yes": {0.0, 1.0},
+				"No":  {1.0, 0.0},
+			}),
+		},
+		{
+			ID:     questionIDs[1],
+			QuizID: quizID,
+			Body:   "Do you like betraying your friends?",
+			Options: models.OptionsFromWeights(map[string][]float32{
+				"Yes": {1.0, 0.0},
+				"No":  {0.0, 1.0},
+			}),
+		},
+		{
+			ID:     questionIDs[2],
+			QuizID: quizID,
+			Body:   "Who would you rather rob a bank with?",
+			Options: models.OptionsFromWeights(map[string][]float32{
+				"Michael": {1.0, 0.0},
+				"Trevor":  {0.0, 1.0},
+				"Nobody":  {0.0, 0.0},
+			}),
+		},
+	}
+
+	tiedAnswers := []models.Answer{
+		{QuizID: quizID, QuestionID: questionIDs[0], Body: "Yes"}, // +1.0 Trevor
+		{QuizID: quizID, QuestionID: questionIDs[1], Body: "Yes"}, // +1.0 Michael
+	}
+	withAnswer := func(body string) []models.Answer {
+		return append(slices.Clone(tiedAnswers), models.Answer{QuizID: quizID, QuestionID: questionIDs[2], Body: body})
+	}
+
+	tests := []struct {
+		name                 string
+		policy               models.TieBreakPolicy
+		seed                 int64
+		tiebreakerQuestionID *uuid.UUID
+		answers              []models.Answer
+		expected             []string
+		expectedTied         []string
+		wantErr              error
+	}{
+		{
+			name:     "Default policy picks first declared",
+			answers:  withAnswer("Nobody"),
+			expected: []string{"Michael"},
+		},
+		{
+			name:     "First declared",
+			policy:   models.TieBreakFirstDeclared,
+			answers:  withAnswer("Nobody"),
+			expected: []string{"Michael"},
+		},
+		{
+			name:         "Report all",
+			policy:       models.TieBreakReportAll,
+			answers:      withAnswer("Nobody"),
+			expected:     []string{"Michael"},
+			expectedTied: []string{"Michael", "Trevor"},
+		},
+		{
+			name:     "Random with seed",
+			policy:   models.TieBreakRandom,
+			seed:     42,
+			answers:  withAnswer("Nobody"),
+			expected: []string{"Michael", "Trevor"},
+		},
+		{
+			name:                 "Tiebreaker question decides",
+			policy:               models.TieBreakTiebreakerQuestion,
+			tiebreakerQuestionID: &questionIDs[2],
+			answers:              withAnswer("Trevor"),
+			expected:             []string{"Trevor"},
+		},
+		{
+			name:                 "Tiebreaker answer missing",
+			policy:               models.TieBreakTiebreakerQuestion,
+			tiebreakerQuestionID: &questionIDs[2],
+			answers:              tiedAnswers,
+			wantErr:              question.ErrTiebreakerAnswerRequired,
+		},
+		{
+			name:    "Tiebreaker question not set",
+			policy:  models.TieBreakTiebreakerQuestion,
+			answers: withAnswer("Trevor"),
+			wantErr: question.ErrTiebreakerQuestionNotSet,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mocks.MockRepository)
+			mockCache := new(mocks.MockCache)
+			service := question.NewService(mockRepo, mockCache)
+
+			mockCache.On("Get", mock.Anything, mock.Anything, mock.AnythingOfType("*[]*models.Question")).Run(func(args mock.Arguments) {
+				dest := args.Get(2).(*[]*models.Question)
+				*dest = questions
+			}).Return(nil)
+
+			quiz := &models.Quiz{
+				ID:                   quizID,
+				Title:                "GTA V Character Quiz",
+				Results:              []string{"Michael", "Trevor"},
+				TieBreakPolicy:       tt.policy,
+				TieBreakSeed:         tt.seed,
+				TiebreakerQuestionID: tt.tiebreakerQuestionID,
+			}
+
+			result, err := service.EvaluateAnswers(context.Background(), tt.answers, quiz, nil)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Contains(t, tt.expected, result.Result)
+			assert.Equal(t, tt.expectedTied, result.TiedResults)
+
+			again, err := service.EvaluateAnswers(context.Background(), tt.answers, quiz, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, result.Result, again.Result, "tie-break must be deterministic")
+		})
+	}
+}
+
+func TestEvaluateAnswers_ScoringModels(t *testing.T) {
+	quizID := uuid.New()
+	questionIDs := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+
+	newQuestions := func(weights ...map[string][]float32) []*models.Question {
+		questions := make([]*models.Question, len(weights))
+		for i, w := range weights {
+			questions[i] = &models.Question{ID: questionIDs[i], QuizID: quizID, Body: "Question", Options: models.OptionsFromWeights(w)}
+		}
+		return questions
+	}
+	answer := func(i int, body string) models.Answer {
+		return models.Answer{QuizID: quizID, QuestionID: questionIDs[i], Body: body}
+	}
+
+	tests := []struct {
+		name      string
+		quiz      models.Quiz
+		questions []*models.Question
+		answers   []models.Answer
+		check     func(t *testing.T, e *models.Evaluation)
+	}{
+		{
+			name: "Knowledge",
+			quiz: models.Quiz{
+				Results:         []string{"Tourist", "Local", "Gangster"},
+				ScoringModel:    models.ScoringKnowledge,
+				ScoreThresholds: []float32{50, 90},
+			},
+			questions: newQuestions(
+				map[string][]float32{"Los Santos": {1}, "Liberty City": {0}},
+				map[string][]float32{"Trevor": {2}, "Niko": {0}},
+				map[string][]float32{"1987": {0}, "2013": {1}},
+			),
+			answers: []models.Answer{answer(0, "Los Santos"), answer(1, "Trevor"), answer(2, "1987")},
+			check: func(t *testing.T, e *models.Evaluation) {
+				assert.Equal(t, "Local", e.Result)
+				assert.Equal(t, float32(3), e.Score)
+				assert.Equal(t, float32(4), e.MaxScore)
+				assert.Equal(t, int32(2), e.CorrectAnswers)
+			},
+		},
+		{
+			name: "Knowledge without thresholds",
+			quiz: models.Quiz{
+				Results:      []string{"Tourist", "Gangster"},
+				ScoringModel: models.ScoringKnowledge,
+			},
+			questions: newQuestions(
+				map[string][]float32{"Los Santos": {1}, "Liberty City": {0}},
+				map[string][]float32{"Trevor": {1}, "Niko": {0}},
+			),
+			answers: []models.Answer{answer(0, "Los Santos"), answer(1, "Trevor")},
+			check: func(t *testing.T, e *models.Evaluation) {
+				assert.Equal(t, "Gangster", e.Result)
+				assert.Equal(t, int32(2), e.CorrectAnswers)
+			},
+		},
+		{
+			name: "Traits",
+			quiz: models.Quiz{
+				ScoringModel: models.ScoringTraits,
+				TraitAxes:    []models.TraitAxis{{Positive: "E", Negative: "I"}, {Positive: "T", Negative: "F"}},
+			},
+			questions: newQuestions(
+				map[string][]float32{"Party": {1, 0}, "Home": {-1, 0}},
+				map[string][]float32{"Logic": {0, 1}, "Feelings": {0, -1}},
+				map[string][]float32{"Plan": {0.5, -0.5}, "Improvise": {-0.5, 0.5}},
+			),
+			answers: []models.Answer{answer(0, "Home"), answer(1, "Logic"), answer(2, "Improvise")},
+			check: func(t *testing.T, e *models.Evaluation) {
+				assert.Equal(t, "IT", e.Result)
+				assert.Equal(t, []models.TraitScore{
+					{TraitAxis: models.TraitAxis{Positive: "E", Negative: "I"}, Score: -1.5, Pole: "I"},
+					{TraitAxis: models.TraitAxis{Positive: "T", Negative: "F"}, Score: 1.5, Pole: "T"},
+				}, e.Traits)
+			},
+		},
+		{
+			name: "Bands",
+			quiz: models.Quiz{
+				Results:         []string{"Calm", "Nervous", "Wanted level 5"},
+				ScoringModel:    models.ScoringBands,
+				ScoreThresholds: []float32{3, 6},
+			},
+			questions: newQuestions(
+				map[string][]float32{"Never": {0}, "Sometimes": {1}, "Often": {3}},
+				map[string][]float32{"Never": {0}, "Sometimes": {1}, "Often": {3}},
+			),
+			answers: []models.Answer{answer(0, "Often"), answer(1, "Sometimes")},
+			check: func(t *testing.T, e *models.Evaluation) {
+				assert.Equal(t, "Nervous", e.Result)
+				assert.Equal(t, float32(4), e.Score)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mocks.MockRepository)
+			mockCache := new(mocks.MockCache)
+			service := question.NewService(mockRepo, mockCache)
+
+			mockCache.On("Get", mock.Anything, mock.Anything, mock.AnythingOfType("*[]*models.Question")).Run(func(args mock.Arguments) {
+				dest := args.Get(2).(*[]*models.Question)
+				*dest = tt.questions
+			}).Return(nil)
+
+			tt.quiz.ID = quizID
+			result, err := service.EvaluateAnswers(context.Background(), tt.answers, &tt.quiz, nil)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.quiz.ScoringModel, result.ScoringModel)
+			tt.check(t, result)
+		})
+	}
+}
+
+type constantScorer string
+
+func (s constantScorer) Score(*models.Quiz, []question.Choice) (*models.Evaluation, error) {
+	return &models.Evaluation{Result: string(s)}, nil
+}
+
+func TestRegisterScorer(t *testing.T) {
+	mockRepo := new(mocks.MockRepository)
+	mockCache := new(mocks.MockCache)
+	service := question.NewService(mockRepo, mockCache)
+	service.RegisterScorer(models.ScoringWeightedSum, constantScorer("Lamar"))
+
+	quizID := uuid.New()
+	questionID := uuid.New()
+	questions := []*models.Question{
+		{ID: questionID, QuizID: quizID, Body: "Question", Options: models.OptionsFromWeights(map[string][]float32{"Yes": {1, 0}})},
+	}
+
+	mockCache.On("Get", mock.Anything, mock.Anything, mock.AnythingOfType("*[]*models.Question")).Run(func(args mock.Arguments) {
+		dest := args.Get(2).(*[]*models.Question)
+		*dest = questions
+	}).Return(nil)
+
+	quiz := &models.Quiz{ID: quizID, Results: []string{"Franklin", "Trevor"}}
+	result, err := service.EvaluateAnswers(context.Background(), []models.Answer{{QuizID: quizID, QuestionID: questionID, Body: "Yes"}}, quiz, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Lamar", result.Result)
+}
+
+func TestEvaluateAnswers_QuestionTypes(t *testing.T) {
+	quizID := uuid.New()
+	questionID := uuid.New()
+	value := func(v float32) *float32 { return &v }
+	moneyID, familyID := uuid.New(), uuid.New()
+	options := func() []models.Option {
+		return []models.Option{
+			{ID: moneyID, Text: "Money", Weights: []float32{1, 0}},
+			{ID: familyID, Text: "Family", Weights: []float32{0, 1}},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		question *models.Question
+		answer   models.Answer
+		expected []float32
+		wantErr  error
+	}{
+		{
+			name:     "Single choice by option ID",
+			question: &models.Question{Options: options()},
+			answer:   models.Answer{OptionID: familyID},
+			expected: []float32{0, 1},
+		},
+		{
+			name:     "Single choice with an unknown option ID",
+			question: &models.Question{Options: options()},
+			answer:   models.Answer{OptionID: uuid.New(), Body: "Family"},
+			wantErr:  question.ErrInvalidAnswer,
+		},
+		{
+			name:     "Multi-select by option IDs",
+			question: &models.Question{Type: models.QuestionTypeMultiSelect, Options: options()},
+			answer:   models.Answer{OptionIDs: []uuid.UUID{moneyID, familyID}},
+			expected: []float32{1, 1},
+		},
+		{
+			name: "Multi-select sums chosen options",
+			question: &models.Question{
+				Type:    models.QuestionTypeMultiSelect,
+				Options: models.OptionsFromWeights(map[string][]float32{"Cars": {1, 0}, "Planes": {0, 1}, "Boats": {0.5, 0.5}}),
+			},
+			answer:   models.Answer{Options: []string{"Cars", "Boats"}},
+			expected: []float32{1.5, 0.5},
+		},
+		{
+			name: "Multi-select rejects duplicate options",
+			question: &models.Question{
+				Type:    models.QuestionTypeMultiSelect,
+				Options: models.OptionsFromWeights(map[string][]float32{"Cars": {1, 0}, "Planes": {0, 1}}),
+			},
+			answer:  models.Answer{Options: []string{"Cars", "Cars"}},
+			wantErr: question.ErrInvalidAnswer,
+		},
+		{
+			name: "Likert interpolates between anchors",
+			question: &models.Question{
+				Type:    models.QuestionTypeLikert,
+				Options: models.OptionsFromWeights(map[string][]float32{"1": {1, 0}, "5": {0, 1}}),
+			},
+			answer:   models.Answer{Value: value(2)},
+			expected: []float32{0.75, 0.25},
+		},
+		{
+			name: "Likert rejects values outside of the scale",
+			question: &models.Question{
+				Type:    models.QuestionTypeLikert,
+				Options: models.OptionsFromWeights(map[string][]float32{"1": {1, 0}, "5": {0, 1}}),
+			},
+			answer:  models.Answer{Value: value(6)},
+			wantErr: question.ErrInvalidAnswer,
+		},
+		{
+			name: "Numeric picks the matching range",
+			question: &models.Question{
+				Type:    models.QuestionTypeNumeric,
+				Options: models.OptionsFromWeights(map[string][]float32{"..18": {1, 0}, "18..40": {0.5, 0.5}, "40..": {0, 1}}),
+			},
+			answer:   models.Answer{Value: value(18)},
+			expected: []float32{0.5, 0.5},
+		},
+		{
+			name: "Numeric requires a value",
+			question: &models.Question{
+				Type:    models.QuestionTypeNumeric,
+				Options: models.OptionsFromWeights(map[string][]float32{"..18": {1, 0}, "18..": {0, 1}}),
+			},
+			answer:  models.Answer{Body: "18"},
+			wantErr: question.ErrInvalidAnswer,
+		},
+		{
+			name: "Ranking scales weights by position",
+			question: &models.Question{
+				Type:    models.QuestionTypeRanking,
+				Options: models.OptionsFromWeights(map[string][]float32{"Money": {1, 0}, "Family": {0, 1}}),
+			},
+			answer:   models.Answer{Options: []string{"Family", "Money"}},
+			expected: []float32{0.5, 1},
+		},
+		{
+			name:     "Ranking by option IDs",
+			question: &models.Question{Type: models.QuestionTypeRanking, Options: options()},
+			answer:   models.Answer{OptionIDs: []uuid.UUID{moneyID, familyID}},
+			expected: []float32{1, 0.5},
+		},
+		{
+			name: "Ranking requires every option",
+			question: &models.Question{
+				Type:    models.QuestionTypeRanking,
+				Options: models.OptionsFromWeights(map[string][]float32{"Money": {1, 0}, "Family": {0, 1}}),
+			},
+			answer:  models.Answer{Options: []string{"Family"}},
+			wantErr: question.ErrInvalidAnswer,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mocks.MockRepository)
+			mockCache := new(mocks.MockCache)
+			service := question.NewService(mockRepo, mockCache)
+
+			tt.question.ID = questionID
+			tt.question.QuizID = quizID
+			mockCache.On("Get", mock.Anything, mock.Anything, mock.AnythingOfType("*[]*models.Question")).Run(func(args mock.Arguments) {
+				dest := args.Get(2).(*[]*models.Question)
+				*dest = []*models.Question{tt.question}
+			}).Return(nil)
+
+			tt.answer.QuizID = quizID
+			tt.answer.QuestionID = questionID
+			quiz := &models.Quiz{ID: quizID, Results: []string{"Franklin", "Trevor"}}
+
+			result, err := service.EvaluateAnswers(context.Background(), []models.Answer{tt.answer}, quiz, nil)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			for i, score := range result.Scores {
+				assert.InDelta(t, tt.expected[i], score.Total, 1e-6)
+			}
+		})
+	}
+}
+
+func TestValidateQuestion(t *testing.T) {
+	optionID := uuid.New()
+
+	tests := []struct {
+		name     string
+		question *models.Question
+		wantErr  bool
+	}{
+		{
+			name:     "Single choice",
+			question: &models.Question{Options: models.OptionsFromWeights(map[string][]float32{"Yes": {1}, "No": {0}})},
+		},
+		{
+			name:     "Likert with numeric anchors",
+			question: &models.Question{Type: models.QuestionTypeLikert, Options: models.OptionsFromWeights(map[string][]float32{"1": {0}, "3": {1}, "5": {2}})},
+		},
+		{
+			name:     "Likert with a non-numeric anchor",
+			question: &models.Question{Type: models.QuestionTypeLikert, Options: models.OptionsFromWeights(map[string][]float32{"1": {0}, "a lot": {1}})},
+			wantErr:  true,
+		},
+		{
+			name:     "Likert with a single anchor",
+			question: &models.Question{Type: models.QuestionTypeLikert, Options: models.OptionsFromWeights(map[string][]float32{"1": {0}})},
+			wantErr:  true,
+		},
+		{
+			name:     "Numeric with open ranges",
+			question: &models.Question{Type: models.QuestionTypeNumeric, Options: models.OptionsFromWeights(map[string][]float32{"..0": {0}, "0..10": {1}, "10..": {2}})},
+		},
+		{
+			name:     "Numeric with overlapping ranges",
+			question: &models.Question{Type: models.QuestionTypeNumeric, Options: models.OptionsFromWeights(map[string][]float32{"0..10": {1}, "5..15": {2}})},
+			wantErr:  true,
+		},
+		{
+			name:     "Numeric with a malformed range",
+			question: &models.Question{Type: models.QuestionTypeNumeric, Options: models.OptionsFromWeights(map[string][]float32{"ten": {1}})},
+			wantErr:  true,
+		},
+		{
+			name:     "Ranking with a single option",
+			question: &models.Question{Type: models.QuestionTypeRanking, Options: models.OptionsFromWeights(map[string][]float32{"Money": {1}})},
+			wantErr:  true,
+		},
+		{
+			name: "Duplicate option texts",
+			question: &models.Question{Options: []models.Option{
+				{ID: uuid.New(), Text: "Yes", Weights: []float32{1}},
+				{ID: uuid.New(), Text: "Yes", Weights: []float32{0}},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "Duplicate option IDs",
+			question: &models.Question{Options: []models.Option{
+				{ID: optionID, Text: "Yes", Weights: []float32{1}},
+				{ID: optionID, Text: "No", Weights: []float32{0}},
+			}},
+			wantErr: true,
+		},
+		{
+			name:     "Unknown type",
+			question: &models.Question{Type: "essay", Options: models.OptionsFromWeights(map[string][]float32{"Yes": {1}})},
+			wantErr:  true,
+		},
+		{
+			name: "Single choice option with a route",
+			question: &models.Question{Options: []models.Option{
+				{Text: "Yes", Weights: []float32{1}, Route: &models.Route{End: true}},
+				{Text: "No", Weights: []float32{0}, Route: &models.Route{NextQuestionID: &optionID}},
+			}},
+		},
+		{
+			name: "Multi-select option with a route",
+			question: &models.Question{Type: models.QuestionTypeMultiSelect, Options: []models.Option{
+				{Text: "Money", Weights: []float32{1}, Route: &models.Route{End: true}},
+				{Text: "Fame", Weights: []float32{0}},
+			}},
+			wantErr: true,
+		},
+		{
+			name:     "Route that both ends and continues",
+			question: &models.Question{Route: &models.Route{End: true, NextQuestionID: &optionID}},
+			wantErr:  true,
+		},
+		{
+			name:     "Question routing to itself",
+			question: &models.Question{ID: optionID, Route: &models.Route{NextQuestionID: &optionID}},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := question.ValidateQuestion(tt.question)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, question.ErrInvalidQuestion)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestQuestionOptionsOrder(t *testing.T) {
+	q := &models.Question{
+		ID:      uuid.New(),
+		QuizID:  uuid.New(),
+		Body:    "What matters most?",
+		Type:    models.QuestionTypeRanking,
+		Options: models.OptionsFromWeights(map[string][]float32{"Money": {1}, "Family": {2}, "Career": {3}, "Health": {4}}),
+	}
+
+	for range 10 {
+		assert.Equal(t, []string{"Career", "Family", "Health", "Money"}, q.ToProtoWithoutWeights().Options)
+	}
+
+	shuffled := q.WithShuffledOptions()
+	assert.ElementsMatch(t, q.Options, shuffled.Options)
+	assert.Equal(t, []string{"Career", "Family", "Health", "Money"}, q.ToProtoWithoutWeights().Options)
+
+	scale := &models.Question{
+		Type:    models.QuestionTypeLikert,
+		Options: models.OptionsFromWeights(map[string][]float32{"1": {0}, "2": {1}, "3": {2}}),
+	}
+	assert.Equal(t, scale.Options, scale.WithShuffledOptions().Options)
+}
+
+func newFlowService(quizID uuid.UUID, questions []*models.Question) *question.Service {
+	mockCache := new(mocks.MockCache)
+	mockCache.On("Get", mock.Anything, "questions:v2:quiz:"+quizID.String(), mock.AnythingOfType("*[]*models.Question")).Run(func(args mock.Arguments) {
+		dest := args.Get(2).(*[]*models.Question)
+		*dest = questions
+	}).Return(nil)
+
+	return question.NewService(new(mocks.MockRepository), mockCache)
+}
+
+func TestEvaluateAnswers_Flow(t *testing.T) {
+	quizID := uuid.New()
+	quiz := &models.Quiz{ID: quizID, Results: []string{"Michael", "Trevor"}}
+
+	questions := make([]*models.Question, 4)
+	for i := range questions {
+		questions[i] = &models.Question{
+			ID:       uuid.New(),
+			QuizID:   quizID,
+			Body:     "Do you like drinking gasoline?",
+			Position: int32(i),
+			Options: []models.Option{
+				{ID: uuid.New(), Text: "Yes", Weights: []float32{0, 1}},
+				{ID: uuid.New(), Text: "No", Weights: []float32{1, 0}},
+			},
+		}
+	}
+	// "Yes" to the first question skips the second one, which ends the quiz.
+	questions[0].Options[0].Route = &models.Route{NextQuestionID: &questions[2].ID}
+	questions[1].Route = &models.Route{End: true}
+
+	service := newFlowService(quizID, questions)
+	answer := func(i, option int) models.Answer {
+		return models.Answer{QuizID: quizID, QuestionID: questions[i].ID, OptionID: questions[i].Options[option].ID}
+	}
+
+	tests := []struct {
+		name       string
+		answers    []models.Answer
+		wantNext   *models.Question
+		wantResult string
+		wantErr    error
+	}{
+		{
+			name:       "Skipping branch",
+			answers:    []models.Answer{answer(0, 0), answer(2, 1), answer(3, 1)},
+			wantResult: "Michael",
+		},
+		{
+			name:     "Skipping branch without the last answer",
+			answers:  []models.Answer{answer(0, 0), answer(2, 1)},
+			wantNext: questions[3],
+			wantErr:  question.ErrIncompleteAnswers,
+		},
+		{
+			name:       "Ending branch",
+			answers:    []models.Answer{answer(0, 1), answer(1, 1)},
+			wantResult: "Michael",
+		},
+		{
+			name:       "Answers off the path are ignored",
+			answers:    []models.Answer{answer(0, 1), answer(1, 1), answer(2, 0), answer(3, 0)},
+			wantResult: "Michael",
+		},
+		{
+			name:     "Ending branch without an answer",
+			answers:  []models.Answer{answer(0, 1)},
+			wantNext: questions[1],
+			wantErr:  question.ErrIncompleteAnswers,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			next, err := service.NextQuestion(ctx, quiz, tt.answers, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantNext, next)
+
+			result, err := service.EvaluateAnswers(ctx, tt.answers, quiz, nil)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantResult, result.Result)
+		})
+	}
+}
+
+func TestEvaluateAnswers_EarlyTermination(t *testing.T) {
+	quizID := uuid.New()
+	quiz := &models.Quiz{ID: quizID, Results: []string{"Michael", "Trevor"}, EarlyTermination: true}
+
+	questions := make([]*models.Question, 3)
+	for i := range questions {
+		questions[i] = &models.Question{
+			ID:       uuid.New(),
+			QuizID:   quizID,
+			Body:     "Do you like drinking gasoline?",
+			Position: int32(i),
+			Options: []models.Option{
+				{ID: uuid.New(), Text: "Yes", Weights: []float32{0, 1}},
+				{ID: uuid.New(), Text: "No", Weights: []float32{1, 0}},
+			},
+		}
+	}
+
+	service := newFlowService(quizID, questions)
+	answer := func(i, option int) models.Answer {
+		return models.Answer{QuizID: quizID, QuestionID: questions[i].ID, OptionID: questions[i].Options[option].ID}
+	}
+	ctx := context.Background()
+
+	next, err := service.NextQuestion(ctx, quiz, []models.Answer{answer(0, 0), answer(1, 1)}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, questions[2], next, "a one point lead can still be caught up")
+
+	answers := []models.Answer{answer(0, 0), answer(1, 0)}
+	next, err = service.NextQuestion(ctx, quiz, answers, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, next, "a two point lead cannot be caught up with one question left")
+
+	result, err := service.EvaluateAnswers(ctx, answers, quiz, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "Trevor", result.Result)
+
+	quiz.EarlyTermination = false
+	_, err = service.EvaluateAnswers(ctx, answers, quiz, nil)
+	assert.ErrorIs(t, err, question.ErrIncompleteAnswers)
+}
+
+func TestCheckAnswer_OffPath(t *testing.T) {
+	quizID := uuid.New()
+	quiz := &models.Quiz{ID: quizID, Results: []string{"Michael", "Trevor"}}
+
+	questions := make([]*models.Question, 3)
+	for i := range questions {
+		questions[i] = &models.Question{
+			ID:       uuid.New(),
+			QuizID:   quizID,
+			Body:     "Do you like drinking gasoline?",
+			Position: int32(i),
+			Options: []models.Option{
+				{ID: uuid.New(), Text: "Yes", Weights: []float32{0, 1}},
+				{ID: uuid.New(), Text: "No", Weights: []float32{1, 0}},
+			},
+		}
+	}
+	questions[0].Options[0].Route = &models.Route{NextQuestionID: &questions[2].ID}
+
+	service := newFlowService(quizID, questions)
+	answer := func(i, option int) models.Answer {
+		return models.Answer{QuizID: quizID, QuestionID: questions[i].ID, OptionID: questions[i].Options[option].ID}
+	}
+	ctx := context.Background()
+
+	assert.NoError(t, service.CheckAnswer(ctx, quiz, nil, answer(0, 0), nil))
+	assert.ErrorIs(t, service.CheckAnswer(ctx, quiz, nil, answer(1, 0), nil), question.ErrInvalidAnswer)
+
+	answered := []models.Answer{answer(0, 0)}
+	assert.NoError(t, service.CheckAnswer(ctx, quiz, answered, answer(2, 0), nil))
+	assert.NoError(t, service.CheckAnswer(ctx, quiz, answered, answer(0, 1), nil), "answered questions can be answered again")
+	assert.ErrorIs(t, service.CheckAnswer(ctx, quiz, answered, answer(1, 0), nil), question.ErrInvalidAnswer)
+}
+
+func TestDraw(t *testing.T) {
+	quizID := uuid.New()
+	tiebreakerID := uuid.New()
+	quiz := &models.Quiz{
+		ID:                   quizID,
+		Results:              []string{"Michael", "Trevor"},
+		TieBreakPolicy:       models.TieBreakTiebreakerQuestion,
+		TiebreakerQuestionID: &tiebreakerID,
+		QuestionDraw:         &models.QuestionDraw{Count: 5, PerTag: map[string]int32{"heists": 2, "family": 1}},
+	}
+
+	questions := make([]*models.Question, 12)
+	for i := range questions {
+		questions[i] = &models.Question{ID: uuid.New(), QuizID: quizID, Position: int32(i)}
+		switch {
+		case i < 4:
+			questions[i].Tags = []string{"heists"}
+		case i < 8:
+			questions[i].Tags = []string{"family"}
+		}
+	}
+	questions[11].ID = tiebreakerID
+
+	drawn := question.Draw(quiz, questions, 42)
+	assert.Equal(t, drawn, question.Draw(quiz, questions, 42), "the same seed draws the same questions")
+	assert.Len(t, drawn, 6)
+	assert.Contains(t, drawn, tiebreakerID)
+
+	tagged := map[string]int{}
+	for _, q := range questions {
+		if slices.Contains(drawn, q.ID) {
+			for _, tag := range q.Tags {
+				tagged[tag]++
+			}
+		}
+	}
+	assert.GreaterOrEqual(t, tagged["heists"], 2)
+	assert.GreaterOrEqual(t, tagged["family"], 1)
+
+	assert.True(t, slices.IsSortedFunc(drawn, func(a, b uuid.UUID) int {
+		return slices.IndexFunc(questions, func(q *models.Question) bool { return q.ID == a }) -
+			slices.IndexFunc(questions, func(q *models.Question) bool { return q.ID == b })
+	}), "drawn questions keep their order")
+
+	quiz.QuestionDraw = nil
+	assert.Nil(t, question.Draw(quiz, questions, 42))
+}
+
+func TestEvaluateAnswers_Drawn(t *testing.T) {
+	quizID := uuid.New()
+	quiz := &models.Quiz{ID: quizID, Results: []string{"Michael", "Trevor"}}
+
+	questions := make([]*models.Question, 3)
+	for i := range questions {
+		questions[i] = &models.Question{
+			ID:       uuid.New(),
+			QuizID:   quizID,
+			Body:     "Do you like drinking gasoline?",
+			Position: int32(i),
+			Options: []models.Option{
+				{ID: uuid.New(), Text: "Yes", Weights: []float32{0, 1}},
+				{ID: uuid.New(), Text: "No", Weights: []float32{1, 0}},
+			},
+		}
+	}
+
+	service := newFlowService(quizID, questions)
+	answer := func(i, option int) models.Answer {
+		return models.Answer{QuizID: quizID, QuestionID: questions[i].ID, OptionID: questions[i].Options[option].ID}
+	}
+	drawn := []uuid.UUID{questions[0].ID, questions[2].ID}
+	ctx := context.Background()
+
+	result, err := service.EvaluateAnswers(ctx, []models.Answer{answer(0, 0), answer(2, 0)}, quiz, drawn)
+	assert.NoError(t, err)
+	assert.Equal(t, "Trevor", result.Result)
+
+	_, err = service.EvaluateAnswers(ctx, []models.Answer{answer(0, 0), answer(1, 0), answer(2, 0)}, quiz, drawn)
+	assert.ErrorIs(t, err, question.ErrInvalidAnswer)
+
+	_, err = service.EvaluateAnswers(ctx, []models.Answer{answer(0, 0), answer(2, 0)}, quiz, nil)
+	assert.ErrorIs(t, err, question.ErrIncompleteAnswers)
+
+	next, err := service.NextQuestion(ctx, quiz, []models.Answer{answer(0, 0)}, drawn)
+	assert.NoError(t, err)
+	assert.Equal(t, questions[2], next)
+
+	assert.ErrorIs(t, service.CheckAnswer(ctx, quiz, nil, answer(1, 0), drawn), question.ErrInvalidAnswer)
+}
+
+func TestEvaluateAnswers_ResultDetail(t *testing.T) {
+	quizID := uuid.New()
+	imageID := uuid.New()
+	quiz := &models.Quiz{
+		ID:      quizID,
+		Results: []string{"Michael", "Trevor"},
+		ResultDetails: []models.ResultDetail{
+			{Title: "Trevor", Description: "Unpredictable and violent.", ImageID: &imageID},
+		},
+	}
+
+	q := &models.Question{
+		ID:     uuid.New(),
+		QuizID: quizID,
+		Body:   "Do you like drinking gasoline?",
+		Options: []models.Option{
+			{ID: uuid.New(), Text: "Yes", Weights: []float32{0, 1}},
+			{ID: uuid.New(), Text: "No", Weights: []float32{1, 0}},
+		},
+	}
+
+	service := newFlowService(quizID, []*models.Question{q})
+	answer := func(option int) []models.Answer {
+		return []models.Answer{{QuizID: quizID, QuestionID: q.ID, OptionID: q.Options[option].ID}}
+	}
+	ctx := context.Background()
+
+	result, err := service.EvaluateAnswers(ctx, answer(0), quiz, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, &models.ResultDetail{Title: "Trevor", Description: "Unpredictable and violent.", ImageID: &imageID}, result.ResultDetail)
+
+	result, err = service.EvaluateAnswers(ctx, answer(1), quiz, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, &models.ResultDetail{Title: "Michael"}, result.ResultDetail)
+}
+
+func TestValidateForQuiz(t *testing.T) {
+	quiz := &models.Quiz{Results: []string{"Mars", "Venus"}}
+	nan := float32(math.NaN())
+
+	tests := []struct {
+		name     string
+		question *models.Question
+		fields   []string
+	}{
+		{
+			name: "valid",
+			question: &models.Question{Body: "Hot?", Options: []models.Option{
+				{Text: "Yes", Weights: []float32{0, 1}},
+				{Text: "No", Weights: []float32{1, 0}},
+			}},
+		},
+		{
+			name: "empty body and option text",
+			question: &models.Question{Body: " ", Options: []models.Option{
+				{Text: "", Weights: []float32{0, 1}},
+			}},
+			fields: []string{"body", "options[0].text"},
+		},
+		{
+			name: "duplicate options",
+			question: &models.Question{Body: "Hot?", Options: []models.Option{
+				{Text: "Yes", Weights: []float32{0, 1}},
+				{Text: "Yes", Weights: []float32{1, 0}},
+			}},
+			fields: []string{"options[1].text"},
+		},
+		{
+			name: "weights length and NaN weights",
+			question: &models.Question{Body: "Hot?", Options: []models.Option{
+				{Text: "Yes", Weights: []float32{1}},
+				{Text: "No", Weights: []float32{nan, 0}},
+			}},
+			fields: []string{"options[0].weights", "options[1].weights"},
+		},
+		{
+			name: "type specific",
+			question: &models.Question{Body: "Rank", Type: models.QuestionTypeRanking, Options: []models.Option{
+				{Text: "Mercury", Weights: []float32{0, 1}},
+			}},
+			fields: []string{""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fields []string
+			for _, violation := range question.ValidateForQuiz(quiz, tt.question) {
+				assert.NotEmpty(t, violation.Description)
+				fields = append(fields, violation.Field)
+			}
+			assert.Equal(t, tt.fields, fields)
+		})
+	}
+}
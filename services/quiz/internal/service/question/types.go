@@ -0,0 +1,334 @@
+package question
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+)
+
+// ValidateQuestion checks that the options of a question make sense for its
+// type: Likert options are numeric scale anchors, numeric options are
+// non-overlapping "min..max" ranges with optional bounds, and ranking
+// questions have at least two options to order. Only single choice options
+// may route the flow of the quiz.
+func ValidateQuestion(q *models.Question) error {
+	for i, option := range q.Options {
+		for _, previous := range q.Options[:i] {
+			if option.Text == previous.Text {
+				return fmt.Errorf("%w: option '%s' is declared more than once", ErrInvalidQuestion, option.Text)
+			}
+			if option.ID != uuid.Nil && option.ID == previous.ID {
+				return fmt.Errorf("%w: option ID %s is declared more than once", ErrInvalidQuestion, option.ID)
+			}
+		}
+	}
+
+	if err := validateRoutes(q); err != nil {
+		return err
+	}
+
+	switch q.Type {
+	case "", models.QuestionTypeSingleChoice, models.QuestionTypeMultiSelect:
+		return nil
+	case models.QuestionTypeLikert:
+		if len(q.Options) < 2 {
+			return fmt.Errorf("%w: likert question needs at least two scale anchors", ErrInvalidQuestion)
+		}
+		_, err := likertAnchors(q)
+		return err
+	case models.QuestionTypeNumeric:
+		ranges, err := numericRanges(q)
+		if err != nil {
+			return err
+		}
+		for i := 1; i < len(ranges); i++ {
+			if ranges[i].min < ranges[i-1].max {
+				return fmt.Errorf("%w: ranges '%s' and '%s' overlap", ErrInvalidQuestion, ranges[i-1].option.Text, ranges[i].option.Text)
+			}
+		}
+		return nil
+	case models.QuestionTypeRanking:
+		if len(q.Options) < 2 {
+			return fmt.Errorf("%w: ranking question needs at least two options", ErrInvalidQuestion)
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w: unknown question type '%s'", ErrInvalidQuestion, q.Type)
+	}
+}
+
+// answerWeights resolves an answer to the weights it contributes according to
+// the question type. Options are referenced by ID, or by text for clients that
+// predate option IDs.
+func answerWeights(q *models.Question, answer models.Answer, weightsLen int) ([]float32, error) {
+	for _, option := range q.Options {
+		if len(option.Weights) != weightsLen {
+			return nil, fmt.Errorf("weights length for option '%s' does not match number of results", option.Text)
+		}
+	}
+
+	switch q.Type {
+	case models.QuestionTypeMultiSelect:
+		selected, err := selectedOptions(q, answer)
+		if err != nil {
+			return nil, err
+		}
+		if len(selected) == 0 {
+			return nil, fmt.Errorf("%w: no options selected for question %s", ErrInvalidAnswer, q.ID)
+		}
+
+		total := make([]float32, weightsLen)
+		for _, option := range selected {
+			addWeights(total, option.Weights, 1)
+		}
+		return total, nil
+
+	case models.QuestionTypeLikert:
+		if answer.Value == nil {
+			return nil, fmt.Errorf("%w: no value for question %s", ErrInvalidAnswer, q.ID)
+		}
+
+		anchors, err := likertAnchors(q)
+		if err != nil {
+			return nil, err
+		}
+
+		value := *answer.Value
+		if math.IsNaN(float64(value)) || value < anchors[0].value || value > anchors[len(anchors)-1].value {
+			return nil, fmt.Errorf("%w: value %v is outside of the scale of question %s", ErrInvalidAnswer, value, q.ID)
+		}
+
+		for i := 1; i < len(anchors); i++ {
+			lo, hi := anchors[i-1], anchors[i]
+			if value > hi.value {
+				continue
+			}
+
+			t := (value - lo.value) / (hi.value - lo.value)
+			total := make([]float32, weightsLen)
+			addWeights(total, lo.option.Weights, 1-t)
+			addWeights(total, hi.option.Weights, t)
+			return total, nil
+		}
+		return nil, fmt.Errorf("%w: value %v is outside of the scale of question %s", ErrInvalidAnswer, value, q.ID)
+
+	case models.QuestionTypeNumeric:
+		if answer.Value == nil {
+			return nil, fmt.Errorf("%w: no value for question %s", ErrInvalidAnswer, q.ID)
+		}
+
+		ranges, err := numericRanges(q)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range ranges {
+			if *answer.Value >= r.min && *answer.Value < r.max {
+				return r.option.Weights, nil
+			}
+		}
+		return nil, fmt.Errorf("%w: value %v is outside of the ranges of question %s", ErrInvalidAnswer, *answer.Value, q.ID)
+
+	case models.QuestionTypeRanking:
+		ranked, err := selectedOptions(q, answer)
+		if err != nil {
+			return nil, err
+		}
+		if len(ranked) != len(q.Options) {
+			return nil, fmt.Errorf("%w: all %d options of question %s must be ranked", ErrInvalidAnswer, len(q.Options), q.ID)
+		}
+
+		total := make([]float32, weightsLen)
+		for i, option := range ranked {
+			addWeights(total, option.Weights, rankFactor(i, len(ranked)))
+		}
+		return total, nil
+
+	default:
+		var option *models.Option
+		var exists bool
+		if answer.OptionID != uuid.Nil {
+			option, exists = q.OptionByID(answer.OptionID)
+		} else {
+			option, exists = q.OptionByText(answer.Body)
+		}
+		if !exists {
+			return nil, fmt.Errorf("%w: option '%s' not found for question %s", ErrInvalidAnswer, optionRef(answer.OptionID, answer.Body), q.ID)
+		}
+		return option.Weights, nil
+	}
+}
+
+// selectedOptions resolves the options of a multi-select or ranking answer in
+// the order they were given, rejecting unknown and repeated options.
+func selectedOptions(q *models.Question, answer models.Answer) ([]*models.Option, error) {
+	var selected []*models.Option
+
+	add := func(option *models.Option, exists bool, ref string) error {
+		if !exists {
+			return fmt.Errorf("%w: option '%s' not found for question %s", ErrInvalidAnswer, ref, q.ID)
+		}
+		if slices.Contains(selected, option) {
+			return fmt.Errorf("%w: option '%s' is chosen more than once for question %s", ErrInvalidAnswer, ref, q.ID)
+		}
+		selected = append(selected, option)
+		return nil
+	}
+
+	if len(answer.OptionIDs) > 0 {
+		for _, id := range answer.OptionIDs {
+			option, exists := q.OptionByID(id)
+			if err := add(option, exists, id.String()); err != nil {
+				return nil, err
+			}
+		}
+		return selected, nil
+	}
+
+	for _, text := range answer.Options {
+		option, exists := q.OptionByText(text)
+		if err := add(option, exists, text); err != nil {
+			return nil, err
+		}
+	}
+	return selected, nil
+}
+
+func optionRef(id uuid.UUID, text string) string {
+	if id != uuid.Nil {
+		return id.String()
+	}
+	return text
+}
+
+// maxPoints returns the highest first weight an answer to the question can
+// contribute, as used by knowledge scoring.
+func maxPoints(q *models.Question) float32 {
+	points := make([]float32, 0, len(q.Options))
+	for _, option := range q.Options {
+		if len(option.Weights) > 0 {
+			points = append(points, option.Weights[0])
+		}
+	}
+
+	var total float32
+	switch q.Type {
+	case models.QuestionTypeMultiSelect:
+		for _, p := range points {
+			total += max(p, 0)
+		}
+	case models.QuestionTypeRanking:
+		slices.Sort(points)
+		slices.Reverse(points)
+		for i, p := range points {
+			total += p * rankFactor(i, len(points))
+		}
+	default:
+		for _, p := range points {
+			total = max(total, p)
+		}
+	}
+
+	return max(total, 0)
+}
+
+// rankFactor scales the weights of the option ranked at position i out of n:
+// the first option counts fully and the last one counts 1/n.
+func rankFactor(i, n int) float32 {
+	return float32(n-i) / float32(n)
+}
+
+func addWeights(total, weights []float32, factor float32) {
+	for i, weight := range weights {
+		total[i] += weight * factor
+	}
+}
+
+type likertAnchor struct {
+	option *models.Option
+	value  float32
+}
+
+func likertAnchors(q *models.Question) ([]likertAnchor, error) {
+	anchors := make([]likertAnchor, len(q.Options))
+	for i := range q.Options {
+		option := &q.Options[i]
+		value, err := strconv.ParseFloat(strings.TrimSpace(option.Text), 32)
+		if err != nil {
+			return nil, fmt.Errorf("%w: likert anchor '%s' is not a number", ErrInvalidQuestion, option.Text)
+		}
+		anchors[i] = likertAnchor{option: option, value: float32(value)}
+	}
+
+	slices.SortFunc(anchors, func(a, b likertAnchor) int {
+		return cmp.Compare(a.value, b.value)
+	})
+
+	for i := 1; i < len(anchors); i++ {
+		if anchors[i].value == anchors[i-1].value {
+			return nil, fmt.Errorf("%w: likert anchors '%s' and '%s' are equal", ErrInvalidQuestion, anchors[i-1].option.Text, anchors[i].option.Text)
+		}
+	}
+
+	return anchors, nil
+}
+
+type numericRange struct {
+	option   *models.Option
+	min, max float32
+}
+
+func numericRanges(q *models.Question) ([]numericRange, error) {
+	ranges := make([]numericRange, len(q.Options))
+	for i := range q.Options {
+		r, err := parseRange(q.Options[i].Text)
+		if err != nil {
+			return nil, err
+		}
+		r.option = &q.Options[i]
+		ranges[i] = r
+	}
+
+	slices.SortFunc(ranges, func(a, b numericRange) int {
+		return cmp.Compare(a.min, b.min)
+	})
+
+	return ranges, nil
+}
+
+// parseRange parses "min..max" with either bound optional; min is inclusive and max exclusive.
+func parseRange(option string) (numericRange, error) {
+	lo, hi, ok := strings.Cut(option, "..")
+	if !ok {
+		return numericRange{}, fmt.Errorf("%w: range '%s' must look like 'min..max'", ErrInvalidQuestion, option)
+	}
+
+	minValue, minErr := parseBound(lo, math.Inf(-1))
+	maxValue, maxErr := parseBound(hi, math.Inf(1))
+	if minErr != nil || maxErr != nil {
+		return numericRange{}, fmt.Errorf("%w: range '%s' has an invalid bound", ErrInvalidQuestion, option)
+	}
+
+	r := numericRange{min: minValue, max: maxValue}
+	if r.min >= r.max {
+		return numericRange{}, fmt.Errorf("%w: range '%s' is empty", ErrInvalidQuestion, option)
+	}
+
+	return r, nil
+}
+
+func parseBound(text string, missing float64) (float32, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return float32(missing), nil
+	}
+
+	value, err := strconv.ParseFloat(text, 32)
+	return float32(value), err
+}
@@ -0,0 +1,64 @@
+package question
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+)
+
+// Violation describes a problem with a field of a question. Field is the path
+// of the field within the question, such as "options[1].weights", and is empty
+// when the problem concerns the question as a whole.
+type Violation struct {
+	Field       string
+	Description string
+}
+
+// ValidateForQuiz checks a question that is about to be added to the quiz and
+// returns every problem found, so that they can all be reported at once.
+// Type-specific checks of ValidateQuestion only run once the basic shape of
+// the question is valid.
+func ValidateForQuiz(quiz *models.Quiz, q *models.Question) []Violation {
+	var violations []Violation
+	if strings.TrimSpace(q.Body) == "" {
+		violations = append(violations, Violation{Field: "body", Description: "body must not be empty"})
+	}
+
+	weightsLen := quiz.WeightsLen()
+	for i, option := range q.Options {
+		field := fmt.Sprintf("options[%d]", i)
+
+		if strings.TrimSpace(option.Text) == "" {
+			violations = append(violations, Violation{Field: field + ".text", Description: "option text must not be empty"})
+		} else if slices.ContainsFunc(q.Options[:i], func(previous models.Option) bool { return previous.Text == option.Text }) {
+			violations = append(violations, Violation{
+				Field:       field + ".text",
+				Description: fmt.Sprintf("option '%s' is declared more than once", option.Text),
+			})
+		}
+
+		if len(option.Weights) != weightsLen {
+			violations = append(violations, Violation{
+				Field:       field + ".weights",
+				Description: fmt.Sprintf("option has %d weights, expected %d", len(option.Weights), weightsLen),
+			})
+		}
+
+		if slices.ContainsFunc(option.Weights, func(w float32) bool { return math.IsNaN(float64(w)) || math.IsInf(float64(w), 0) }) {
+			violations = append(violations, Violation{Field: field + ".weights", Description: "weights must be finite numbers"})
+		}
+	}
+
+	if len(violations) > 0 {
+		return violations
+	}
+
+	if err := ValidateQuestion(q); err != nil {
+		violations = append(violations, Violation{Description: err.Error()})
+	}
+
+	return violations
+}
@@ -0,0 +1,75 @@
+package quiz
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+)
+
+// Filter narrows down the listed quizzes and sets their order. Empty fields
+// do not filter anything.
+type Filter struct {
+	Search     string
+	AuthorID   *uuid.UUID
+	Tag        string
+	CategoryID *uuid.UUID
+	Language   string
+	Status     models.QuizStatus
+	SortOrder  models.QuizSortOrder
+}
+
+// Cursor is the position of the last quiz of a page in the sort order of the
+// listing, the next page starts right after it.
+type Cursor struct {
+	SortOrder       models.QuizSortOrder `json:"sort_order"`
+	ID              uuid.UUID            `json:"id"`
+	CreatedAt       time.Time            `json:"created_at"`
+	CompletionCount int64                `json:"completion_count"`
+	Title           string               `json:"title"`
+	RatingAverage   float32              `json:"rating_average"`
+	LikeCount       int64                `json:"like_count"`
+}
+
+func cursorAfter(sortOrder models.QuizSortOrder, q *models.Quiz) *Cursor {
+	return &Cursor{
+		SortOrder:       sortOrder,
+		ID:              q.ID,
+		CreatedAt:       q.CreatedAt,
+		CompletionCount: q.CompletionCount,
+		Title:           q.Title,
+		RatingAverage:   q.RatingAverage,
+		LikeCount:       q.LikeCount,
+	}
+}
+
+func (c *Cursor) PageToken() string {
+	data, _ := json.Marshal(c)
+	return base64.URLEncoding.EncodeToString(data)
+}
+
+// ParsePageToken returns nil for an empty token, meaning the first page.
+func ParsePageToken(token string, sortOrder models.QuizSortOrder) (*Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	data, err := base64.URLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPageToken, err)
+	}
+
+	cursor := new(Cursor)
+	if err := json.Unmarshal(data, cursor); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPageToken, err)
+	}
+
+	if cursor.SortOrder != sortOrder {
+		return nil, fmt.Errorf("%w: token was issued for sort order %s", ErrInvalidPageToken, cursor.SortOrder)
+	}
+
+	return cursor, nil
+}
@@ -3,30 +3,97 @@ package grpc
 import (
 	"context"
 	"errors"
+	"slices"
 
 	"github.com/google/uuid"
 	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+	historyv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/history/v1"
 	quizv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/quiz/v1"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/category"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/media"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/question"
 	"github.com/mibrgmv/whoami-server/quiz/internal/service/quiz"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/translation"
+	"github.com/mibrgmv/whoami-server/shared/grpc/interceptor"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+const (
+	defaultRecommendationsPageSize = 10
+	maxRecommendationsPageSize     = 50
+	recommendationsOverFetch       = 3
+)
+
 type QuizService struct {
-	service *quiz.Service
+	service            *quiz.Service
+	questionService    *question.Service
+	mediaService       *media.Service
+	categoryService    *category.Service
+	translationService *translation.Service
+	historyClient      historyv1.HistoryServiceClient
 	quizv1.UnimplementedQuizServiceServer
 }
 
-func NewService(service *quiz.Service) *QuizService {
+func NewService(service *quiz.Service, questionService *question.Service, mediaService *media.Service,
+	categoryService *category.Service, translationService *translation.Service,
+	historyClient historyv1.HistoryServiceClient) *QuizService {
 	return &QuizService{
-		service: service,
+		service:            service,
+		questionService:    questionService,
+		mediaService:       mediaService,
+		categoryService:    categoryService,
+		translationService: translationService,
+		historyClient:      historyClient,
 	}
 }
 
 func (s *QuizService) CreateQuiz(ctx context.Context, request *quizv1.CreateQuizRequest) (*quizv1.Quiz, error) {
+	authorID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated: %v", err)
+	}
+
+	if request.TimeLimitSeconds < 0 || request.QuestionTimeLimitSeconds < 0 {
+		return nil, status.Error(codes.InvalidArgument, "time limits cannot be negative")
+	}
+
+	results, resultDetails, err := models.ResultsToModel(request.Results, request.ResultDetails)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid results: %v", err)
+	}
+
+	categoryIDs, err := models.CategoryIDsToModel(request.CategoryIds)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid category IDs: %v", err)
+	}
+
 	var q = &models.Quiz{
-		Title:   request.Title,
-		Results: request.Results,
+		Title:                    request.Title,
+		Results:                  results,
+		AuthorID:                 authorID,
+		TieBreakPolicy:           models.TieBreakPolicyToModel(request.TieBreakPolicy),
+		TieBreakSeed:             request.TieBreakSeed,
+		ScoringModel:             models.ScoringModelToModel(request.ScoringModel),
+		TraitAxes:                models.TraitAxesToModel(request.TraitAxes),
+		ScoreThresholds:          request.ScoreThresholds,
+		TimeLimitSeconds:         request.TimeLimitSeconds,
+		QuestionTimeLimitSeconds: request.QuestionTimeLimitSeconds,
+		EarlyTermination:         request.EarlyTermination,
+		QuestionDraw:             models.QuestionDrawToModel(request.QuestionDraw),
+		ResultDetails:            resultDetails,
+		Description:              request.Description,
+		Tags:                     models.NormalizeTags(request.Tags),
+		Language:                 models.NormalizeLanguage(request.Language),
+		CategoryIDs:              categoryIDs,
+	}
+
+	if err := s.checkMedia(ctx, q.MediaIDs()); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkClassification(ctx, q); err != nil {
+		return nil, err
 	}
 
 	createdQuiz, err := s.service.Add(ctx, q)
@@ -51,15 +118,158 @@ func (s *QuizService) GetQuiz(ctx context.Context, request *quizv1.GetQuizReques
 		return nil, status.Errorf(codes.Internal, "failed to get quiz: %v", err)
 	}
 
-	return q.ToProto(), nil
+	if !s.service.CanView(ctx, q) {
+		return nil, status.Errorf(codes.NotFound, "quiz not found: %v", quiz.ErrQuizNotFound)
+	}
+
+	localized, err := s.translationService.LocalizeAll(ctx, []*models.Quiz{q})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to localize quiz: %v", err)
+	}
+
+	return localized[0].ToProto(), nil
+}
+
+// Recommend returns published quizzes the user has not taken yet, ranked by the
+// history service. Recommendations are over-fetched because some of them may
+// have been unpublished or written by the user since they were computed.
+func (s *QuizService) Recommend(ctx context.Context, request *quizv1.RecommendRequest) (*quizv1.RecommendResponse, error) {
+	userID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated: %v", err)
+	}
+
+	pageSize := request.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultRecommendationsPageSize
+	}
+	pageSize = min(pageSize, maxRecommendationsPageSize)
+
+	response, err := s.historyClient.GetRecommendations(ctx, &historyv1.GetRecommendationsRequest{
+		UserId: userID.String(),
+		Limit:  pageSize * recommendationsOverFetch,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to get recommendations: %v", err)
+	}
+
+	quizIDs := make([]uuid.UUID, 0, len(response.Recommendations))
+	recommendations := make(map[uuid.UUID]*historyv1.Recommendation, len(response.Recommendations))
+	for _, rec := range response.Recommendations {
+		quizID, err := uuid.Parse(rec.QuizId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "invalid recommended quiz ID: %v", err)
+		}
+		quizIDs = append(quizIDs, quizID)
+		recommendations[quizID] = rec
+	}
+
+	quizzes, err := s.service.Recommendable(ctx, quizIDs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get recommended quizzes: %v", err)
+	}
+
+	if len(quizzes) > int(pageSize) {
+		quizzes = quizzes[:pageSize]
+	}
+
+	localized, err := s.translationService.LocalizeAll(ctx, quizzes)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to localize quizzes: %v", err)
+	}
+
+	recommended := make([]*quizv1.RecommendedQuiz, len(localized))
+	for i, q := range localized {
+		rec := recommendations[q.ID]
+		recommended[i] = &quizv1.RecommendedQuiz{
+			Quiz:   q.ToProto(),
+			Score:  rec.Score,
+			Reason: recommendationReasonToProto(rec.Reason),
+		}
+	}
+
+	return &quizv1.RecommendResponse{Quizzes: recommended}, nil
+}
+
+// GetQuizStats returns the completion stats the history service counts for the
+// quiz, to users who can see the quiz.
+func (s *QuizService) GetQuizStats(ctx context.Context, request *quizv1.GetQuizStatsRequest) (*quizv1.QuizStats, error) {
+	quizID, err := uuid.Parse(request.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid quiz ID format: %v", err)
+	}
+
+	q, err := s.service.GetByID(ctx, quizID)
+	if err != nil {
+		if errors.Is(err, quiz.ErrQuizNotFound) {
+			return nil, status.Errorf(codes.NotFound, "quiz not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get quiz: %v", err)
+	}
+
+	if !s.service.CanView(ctx, q) {
+		return nil, status.Errorf(codes.NotFound, "quiz not found: %v", quiz.ErrQuizNotFound)
+	}
+
+	stats, err := s.historyClient.GetQuizStats(ctx, &historyv1.GetQuizStatsRequest{
+		Id:       q.ID.String(),
+		From:     request.From,
+		To:       request.To,
+		Interval: historyv1.StatsInterval(request.Interval),
+	})
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Unavailable, "failed to get quiz stats: %v", err)
+	}
+
+	return statsToProto(stats), nil
 }
 
 func (s *QuizService) BatchGetQuizzes(ctx context.Context, request *quizv1.BatchGetQuizzesRequest) (*quizv1.BatchGetQuizzesResponse, error) {
-	quizzes, nextPageToken, err := s.service.Get(ctx, request.PageSize, request.PageToken)
+	filter := quiz.Filter{
+		Search:    request.Search,
+		Tag:       models.NormalizeTag(request.Tag),
+		Language:  models.NormalizeLanguage(request.Language),
+		Status:    models.QuizStatusToModel(request.Status),
+		SortOrder: models.QuizSortOrderToModel(request.SortOrder),
+	}
+
+	if request.AuthorId != "" {
+		authorID, err := uuid.Parse(request.AuthorId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid author ID format: %v", err)
+		}
+		filter.AuthorID = &authorID
+	}
+
+	if request.CategoryId != "" {
+		categoryID, err := uuid.Parse(request.CategoryId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid category ID format: %v", err)
+		}
+		filter.CategoryID = &categoryID
+	}
+
+	quizzes, nextPageToken, err := s.service.Get(ctx, filter, request.PageSize, request.PageToken)
 	if err != nil {
+		if errors.Is(err, quiz.ErrInvalidPageToken) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to get quizzes: %v", err)
 	}
 
+	facets, err := s.service.TagFacets(ctx, filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get tag facets: %v", err)
+	}
+
+	quizzes, err = s.translationService.LocalizeAll(ctx, quizzes)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to localize quizzes: %v", err)
+	}
+
 	var pbQuizzes []*quizv1.Quiz
 	for _, q := range quizzes {
 		pbQuizzes = append(pbQuizzes, q.ToProto())
@@ -68,5 +278,295 @@ func (s *QuizService) BatchGetQuizzes(ctx context.Context, request *quizv1.Batch
 	return &quizv1.BatchGetQuizzesResponse{
 		Quizzes:       pbQuizzes,
 		NextPageToken: nextPageToken,
+		TagFacets:     models.TagFacetsToProto(facets),
 	}, nil
 }
+
+func (s *QuizService) UpdateQuiz(ctx context.Context, request *quizv1.UpdateQuizRequest) (*quizv1.Quiz, error) {
+	existing, err := s.getForMutation(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	questions, err := s.questionService.GetByQuizID(ctx, existing.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get questions by quiz id: %v", err)
+	}
+
+	weightsLen := existing.WeightsLen()
+
+	results, resultDetails, err := models.ResultsToModel(request.Results, request.ResultDetails)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid results: %v", err)
+	}
+
+	existing.Title = request.Title
+	existing.Results = results
+	existing.ResultDetails = resultDetails
+	existing.Tags = models.NormalizeTags(request.Tags)
+
+	existing.CategoryIDs, err = models.CategoryIDsToModel(request.CategoryIds)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid category IDs: %v", err)
+	}
+
+	if request.Description != nil {
+		existing.Description = *request.Description
+	}
+
+	if request.Language != nil {
+		existing.Language = models.NormalizeLanguage(*request.Language)
+	}
+
+	if err := s.checkMedia(ctx, existing.MediaIDs()); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkClassification(ctx, existing); err != nil {
+		return nil, err
+	}
+
+	if model := models.ScoringModelToModel(request.ScoringModel); model != "" {
+		existing.ScoringModel = model
+		existing.TraitAxes = models.TraitAxesToModel(request.TraitAxes)
+		existing.ScoreThresholds = request.ScoreThresholds
+	}
+
+	if existing.WeightsLen() != weightsLen && len(questions) > 0 {
+		return nil, status.Error(codes.FailedPrecondition, "cannot change the number of option weights of a quiz that has questions")
+	}
+
+	if policy := models.TieBreakPolicyToModel(request.TieBreakPolicy); policy != "" {
+		existing.TieBreakPolicy = policy
+		existing.TieBreakSeed = request.TieBreakSeed
+		existing.TiebreakerQuestionID = nil
+
+		if request.TiebreakerQuestionId != "" {
+			tiebreakerID, err := uuid.Parse(request.TiebreakerQuestionId)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid tiebreaker question ID format: %v", err)
+			}
+			if !slices.ContainsFunc(questions, func(q *models.Question) bool { return q.ID == tiebreakerID }) {
+				return nil, status.Errorf(codes.InvalidArgument, "tiebreaker question %s does not belong to the quiz", tiebreakerID)
+			}
+			existing.TiebreakerQuestionID = &tiebreakerID
+		}
+	}
+
+	if request.TimeLimitSeconds != nil {
+		if *request.TimeLimitSeconds < 0 {
+			return nil, status.Error(codes.InvalidArgument, "time limits cannot be negative")
+		}
+		existing.TimeLimitSeconds = *request.TimeLimitSeconds
+	}
+
+	if request.QuestionTimeLimitSeconds != nil {
+		if *request.QuestionTimeLimitSeconds < 0 {
+			return nil, status.Error(codes.InvalidArgument, "time limits cannot be negative")
+		}
+		existing.QuestionTimeLimitSeconds = *request.QuestionTimeLimitSeconds
+	}
+
+	if request.EarlyTermination != nil {
+		existing.EarlyTermination = *request.EarlyTermination
+	}
+
+	if request.QuestionDraw != nil {
+		existing.QuestionDraw = models.QuestionDrawToModel(request.QuestionDraw)
+	}
+
+	updatedQuiz, err := s.service.Update(ctx, existing)
+	if err != nil {
+		if errors.Is(err, quiz.ErrQuizNotFound) {
+			return nil, status.Errorf(codes.NotFound, "quiz not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update quiz: %v", err)
+	}
+
+	return updatedQuiz.ToProto(), nil
+}
+
+func (s *QuizService) DeleteQuiz(ctx context.Context, request *quizv1.DeleteQuizRequest) (*quizv1.DeleteQuizResponse, error) {
+	existing, err := s.getForMutation(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.service.Delete(ctx, existing.ID)
+	if err != nil {
+		if errors.Is(err, quiz.ErrQuizNotFound) {
+			return nil, status.Errorf(codes.NotFound, "quiz not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete quiz: %v", err)
+	}
+
+	if err := s.questionService.InvalidateCache(ctx, existing.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to invalidate questions cache: %v", err)
+	}
+
+	return &quizv1.DeleteQuizResponse{
+		Id:      request.Id,
+		Message: "Quiz deleted successfully",
+	}, nil
+}
+
+func (s *QuizService) PublishQuiz(ctx context.Context, request *quizv1.PublishQuizRequest) (*quizv1.Quiz, error) {
+	q, err := s.getForMutation(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	publishedQuiz, err := s.service.Publish(ctx, q)
+	if err != nil {
+		return nil, statusChangeError(err)
+	}
+
+	return publishedQuiz.ToProto(), nil
+}
+
+func (s *QuizService) ArchiveQuiz(ctx context.Context, request *quizv1.ArchiveQuizRequest) (*quizv1.Quiz, error) {
+	q, err := s.getForMutation(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	archivedQuiz, err := s.service.Archive(ctx, q)
+	if err != nil {
+		return nil, statusChangeError(err)
+	}
+
+	return archivedQuiz.ToProto(), nil
+}
+
+func (s *QuizService) GetQuizVersion(ctx context.Context, request *quizv1.GetQuizVersionRequest) (*quizv1.QuizVersion, error) {
+	quizID, err := uuid.Parse(request.QuizId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid quiz ID format: %v", err)
+	}
+
+	versionID, err := uuid.Parse(request.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid quiz version ID format: %v", err)
+	}
+
+	version, err := s.service.GetVersion(ctx, quizID, versionID)
+	if err != nil {
+		if errors.Is(err, quiz.ErrQuizVersionNotFound) {
+			return nil, status.Errorf(codes.NotFound, "quiz version not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get quiz version: %v", err)
+	}
+
+	return version.ToProto(), nil
+}
+
+func (s *QuizService) getForMutation(ctx context.Context, id string) (*models.Quiz, error) {
+	quizID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid quiz ID format: %v", err)
+	}
+
+	q, err := s.service.GetByID(ctx, quizID)
+	if err != nil {
+		if errors.Is(err, quiz.ErrQuizNotFound) {
+			return nil, status.Errorf(codes.NotFound, "quiz not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get quiz: %v", err)
+	}
+
+	if err := s.authorize(ctx, q); err != nil {
+		return nil, err
+	}
+
+	return q, nil
+}
+
+// checkMedia rejects references to media that was never uploaded.
+func (s *QuizService) checkMedia(ctx context.Context, ids []uuid.UUID) error {
+	err := s.mediaService.CheckExists(ctx, ids)
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, media.ErrMediaNotFound) {
+		return status.Errorf(codes.InvalidArgument, "invalid media reference: %v", err)
+	}
+	return status.Errorf(codes.Internal, "failed to check media: %v", err)
+}
+
+// checkClassification rejects tags and categories that were not created by
+// an admin.
+func (s *QuizService) checkClassification(ctx context.Context, q *models.Quiz) error {
+	err := s.categoryService.CheckExists(ctx, q.Tags, q.CategoryIDs)
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, category.ErrTagNotFound) || errors.Is(err, category.ErrCategoryNotFound) {
+		return status.Errorf(codes.InvalidArgument, "invalid classification: %v", err)
+	}
+	return status.Errorf(codes.Internal, "failed to check classification: %v", err)
+}
+
+func statusChangeError(err error) error {
+	switch {
+	case errors.Is(err, quiz.ErrQuizNotFound):
+		return status.Errorf(codes.NotFound, "quiz not found: %v", err)
+	case errors.Is(err, quiz.ErrInvalidStatusTransition), errors.Is(err, quiz.ErrQuizNotPublishable):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	default:
+		return status.Errorf(codes.Internal, "failed to change quiz status: %v", err)
+	}
+}
+
+func (s *QuizService) authorize(ctx context.Context, q *models.Quiz) error {
+	err := s.service.CheckAuthor(ctx, q)
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, quiz.ErrNotQuizAuthor) {
+		return status.Errorf(codes.PermissionDenied, "permission denied: %v", err)
+	}
+	return status.Errorf(codes.Unauthenticated, "user not authenticated: %v", err)
+}
+
+func recommendationReasonToProto(reason historyv1.RecommendationReason) quizv1.RecommendationReason {
+	switch reason {
+	case historyv1.RecommendationReason_RECOMMENDATION_REASON_SIMILAR_USERS:
+		return quizv1.RecommendationReason_RECOMMENDATION_REASON_SIMILAR_USERS
+	case historyv1.RecommendationReason_RECOMMENDATION_REASON_POPULAR:
+		return quizv1.RecommendationReason_RECOMMENDATION_REASON_POPULAR
+	default:
+		return quizv1.RecommendationReason_RECOMMENDATION_REASON_UNSPECIFIED
+	}
+}
+
+func statsToProto(stats *historyv1.QuizStats) *quizv1.QuizStats {
+	results := make([]*quizv1.ResultShare, len(stats.Results))
+	for i, r := range stats.Results {
+		results[i] = &quizv1.ResultShare{
+			Result:     r.Result,
+			Count:      r.Count,
+			Percentage: r.Percentage,
+		}
+	}
+
+	buckets := make([]*quizv1.CompletionBucket, len(stats.Buckets))
+	for i, b := range stats.Buckets {
+		buckets[i] = &quizv1.CompletionBucket{
+			Start:       b.Start,
+			Completions: b.Completions,
+			UniqueUsers: b.UniqueUsers,
+		}
+	}
+
+	return &quizv1.QuizStats{
+		QuizId:           stats.QuizId,
+		TotalCompletions: stats.TotalCompletions,
+		UniqueUsers:      stats.UniqueUsers,
+		Results:          results,
+		Buckets:          buckets,
+		Interval:         quizv1.StatsInterval(stats.Interval),
+	}
+}
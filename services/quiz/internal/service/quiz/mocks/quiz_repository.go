@@ -0,0 +1,69 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/quiz"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Add(ctx context.Context, q *models.Quiz) (*models.Quiz, error) {
+	args := m.Called(ctx, q)
+	return args.Get(0).(*models.Quiz), args.Error(1)
+}
+
+func (m *MockRepository) Query(ctx context.Context, query quiz.Query) ([]*models.Quiz, error) {
+	args := m.Called(ctx, query)
+	return args.Get(0).([]*models.Quiz), args.Error(1)
+}
+
+func (m *MockRepository) TagFacets(ctx context.Context, query quiz.Query) ([]models.TagFacet, error) {
+	args := m.Called(ctx, query)
+	return args.Get(0).([]models.TagFacet), args.Error(1)
+}
+
+func (m *MockRepository) Update(ctx context.Context, q *models.Quiz) (*models.Quiz, error) {
+	args := m.Called(ctx, q)
+	return args.Get(0).(*models.Quiz), args.Error(1)
+}
+
+func (m *MockRepository) Delete(ctx context.Context, quizID uuid.UUID) error {
+	args := m.Called(ctx, quizID)
+	return args.Error(0)
+}
+
+func (m *MockRepository) SetStatus(ctx context.Context, quizID uuid.UUID, status models.QuizStatus) error {
+	args := m.Called(ctx, quizID, status)
+	return args.Error(0)
+}
+
+func (m *MockRepository) EnsureVersion(ctx context.Context, quizID uuid.UUID) (*models.QuizVersion, error) {
+	args := m.Called(ctx, quizID)
+	return args.Get(0).(*models.QuizVersion), args.Error(1)
+}
+
+// Publish validates the questions the mock returns, as the repository
+// validates the questions it loads.
+func (m *MockRepository) Publish(ctx context.Context, quizID uuid.UUID, validate func(questions []*models.Question) error) (*models.QuizVersion, error) {
+	args := m.Called(ctx, quizID)
+	if err := validate(args.Get(0).([]*models.Question)); err != nil {
+		return nil, err
+	}
+	return args.Get(1).(*models.QuizVersion), args.Error(2)
+}
+
+func (m *MockRepository) GetVersion(ctx context.Context, quizID, versionID uuid.UUID) (*models.QuizVersion, error) {
+	args := m.Called(ctx, quizID, versionID)
+	return args.Get(0).(*models.QuizVersion), args.Error(1)
+}
+
+func (m *MockRepository) GuestSessionEnded(ctx context.Context, guestID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, guestID)
+	return args.Bool(0), args.Error(1)
+}
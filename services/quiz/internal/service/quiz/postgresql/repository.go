@@ -2,9 +2,12 @@ package postgresql
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/mibrgmv/whoami-server/quiz/internal/models"
 	"github.com/mibrgmv/whoami-server/quiz/internal/service/quiz"
@@ -35,67 +38,188 @@ func (r *Repository) Add(ctx context.Context, quiz *models.Quiz) (*models.Quiz,
 		}
 	}()
 
+	if err = Insert(ctx, tx, quiz); err != nil {
+		return nil, err
+	}
+
+	return quiz, nil
+}
+
+// Insert adds the quiz as a draft within the transaction. A quiz without an ID
+// gets a new one.
+func Insert(ctx context.Context, tx pgx.Tx, quiz *models.Quiz) error {
 	sql := `
-	insert into quizzes (quiz_id, quiz_title, quiz_results)
-	values ($1, $2, $3)
-	returning quiz_id
+	insert into quizzes (quiz_id, quiz_title, quiz_results, author_id, quiz_status, tie_break_policy, tie_break_seed,
+	                     tiebreaker_question_id, scoring_model, trait_axes, score_thresholds, time_limit_seconds,
+	                     question_time_limit_seconds, early_termination, question_draw, result_details,
+	                     quiz_description, quiz_language)
+	values ($1, $2, $3, $4, $5, $6, $7, $8, $9, coalesce($10::jsonb, '[]'), coalesce($11::real[], '{}'), $12, $13, $14,
+	        $15, coalesce($16::jsonb, '[]'), $17, $18)
+	returning created_at
 	`
 
-	rows, err := tx.Query(ctx, sql, uuid.New(), quiz.Title, quiz.Results)
+	if quiz.ID == uuid.Nil {
+		quiz.ID = uuid.New()
+	}
+
+	quiz.Status = models.QuizStatusDraft
+	if quiz.TieBreakPolicy == "" {
+		quiz.TieBreakPolicy = models.TieBreakFirstDeclared
+	}
+	if quiz.ScoringModel == "" {
+		quiz.ScoringModel = models.ScoringWeightedSum
+	}
+
+	err := tx.QueryRow(ctx, sql, quiz.ID, quiz.Title, quiz.Results, quiz.AuthorID, quiz.Status, quiz.TieBreakPolicy,
+		quiz.TieBreakSeed, quiz.TiebreakerQuestionID, quiz.ScoringModel, quiz.TraitAxes, quiz.ScoreThresholds,
+		quiz.TimeLimitSeconds, quiz.QuestionTimeLimitSeconds, quiz.EarlyTermination, quiz.QuestionDraw, quiz.ResultDetails,
+		quiz.Description, quiz.Language).Scan(&quiz.CreatedAt)
 	if err != nil {
-		return nil, fmt.Errorf("failed to insert quizzes: %w", err)
+		return fmt.Errorf("failed to insert quizzes: %w", err)
 	}
-	defer rows.Close()
 
-	for i := 0; rows.Next(); i++ {
-		var createdID uuid.UUID
-		if err := rows.Scan(&createdID); err != nil {
-			return nil, fmt.Errorf("failed to scan returned quiz_id: %w", err)
-		}
+	return setClassification(ctx, tx, quiz)
+}
 
-		quiz.ID = createdID
+// setClassification replaces the tags and categories assigned to the quiz.
+func setClassification(ctx context.Context, tx pgx.Tx, q *models.Quiz) error {
+	if _, err := tx.Exec(ctx, `delete from quiz_tags where quiz_id = $1`, q.ID); err != nil {
+		return fmt.Errorf("failed to clear quiz tags: %w", err)
 	}
 
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("rows error: %w", err)
+	tagsSQL := `
+	insert into quiz_tags (quiz_id, tag_name)
+	select $1, unnest($2::text[])
+	`
+
+	if _, err := tx.Exec(ctx, tagsSQL, q.ID, q.Tags); err != nil {
+		return fmt.Errorf("failed to set quiz tags: %w", err)
 	}
 
-	return quiz, nil
+	if _, err := tx.Exec(ctx, `delete from quiz_categories where quiz_id = $1`, q.ID); err != nil {
+		return fmt.Errorf("failed to clear quiz categories: %w", err)
+	}
+
+	categoriesSQL := `
+	insert into quiz_categories (quiz_id, category_id)
+	select $1, unnest($2::uuid[])
+	`
+
+	if _, err := tx.Exec(ctx, categoriesSQL, q.ID, q.CategoryIDs); err != nil {
+		return fmt.Errorf("failed to set quiz categories: %w", err)
+	}
+
+	return nil
+}
+
+// filterSQL narrows quizzes down by the filter of the query, see filterArgs.
+const filterSQL = `
+	    ($1::uuid[] is null or cardinality($1) = 0 or quiz_id = any ($1))
+	and ($2::uuid is null or quiz_status = 'published' or author_id = $2)
+	and ($3::text = '' or search_vector @@ websearch_to_tsquery('simple', $3))
+	and ($4::uuid is null or author_id = $4)
+	and ($5::text = '' or exists (select 1
+	                              from quiz_tags
+	                              where quiz_tags.quiz_id = quizzes.quiz_id
+	                                and tag_name = $5))
+	and ($6::text = '' or quiz_language = $6)
+	and ($7::text = '' or quiz_status = $7)
+	and ($8::uuid is null or exists (select 1
+	                                 from quiz_categories
+	                                 where quiz_categories.quiz_id = quizzes.quiz_id
+	                                   and category_id = $8))
+	`
+
+func filterArgs(query quiz.Query) []any {
+	return []any{query.Ids, query.ViewerID, query.Search, query.AuthorID, query.Tag, query.Language,
+		string(query.Status), query.CategoryID}
+}
+
+// orderings hold, per sort order, the clause that skips quizzes up to the
+// cursor ($11 is the sort key of the cursor, $12 its quiz ID) and the ordering.
+var orderings = map[models.QuizSortOrder]struct {
+	after   string
+	orderBy string
+}{
+	models.QuizSortNewest:        {after: `(created_at, quiz_id) < ($11, $12)`, orderBy: `created_at desc, quiz_id desc`},
+	models.QuizSortMostCompleted: {after: `(completion_count, quiz_id) < ($11, $12)`, orderBy: `completion_count desc, quiz_id desc`},
+	models.QuizSortTitle:         {after: `(quiz_title, quiz_id) > ($11, $12)`, orderBy: `quiz_title asc, quiz_id asc`},
+	models.QuizSortTopRated:      {after: `(rating_average, quiz_id) < ($11, $12)`, orderBy: `rating_average desc, quiz_id desc`},
+	models.QuizSortMostLiked:     {after: `(like_count, quiz_id) < ($11, $12)`, orderBy: `like_count desc, quiz_id desc`},
 }
 
 func (r *Repository) Query(ctx context.Context, query quiz.Query) ([]*models.Quiz, error) {
+	ordering, ok := orderings[query.SortOrder]
+	if !ok {
+		ordering = orderings[models.QuizSortNewest]
+	}
+
 	sql := `
 	select quiz_id,
 		   quiz_title,
-		   quiz_results
+		   quiz_results,
+		   author_id,
+		   quiz_status,
+		   tie_break_policy,
+		   tie_break_seed,
+		   tiebreaker_question_id,
+		   scoring_model,
+		   trait_axes,
+		   score_thresholds,
+		   time_limit_seconds,
+		   question_time_limit_seconds,
+		   early_termination,
+		   question_draw,
+		   result_details,
+		   quiz_description,
+		   array(select tag_name
+		         from quiz_tags
+		         where quiz_tags.quiz_id = quizzes.quiz_id
+		         order by tag_name),
+		   quiz_language,
+		   created_at,
+		   completion_count,
+		   array(select category_id
+		         from quiz_categories
+		         where quiz_categories.quiz_id = quizzes.quiz_id
+		         order by category_id),
+		   rating_average,
+		   rating_count,
+		   like_count
 	from quizzes
-	where (quiz_id > $1)
-	  and ($2::uuid[] is null or cardinality($2) = 0 or quiz_id = any ($2))
-	order by quiz_id asc
-	limit $3
+	where ` + filterSQL + `
+	  and (not $10::boolean or ` + ordering.after + `)
+	order by ` + ordering.orderBy + `
+	limit $9
 	`
 
-	var args []interface{}
-
-	if query.PageToken != "" {
-		pageToken, err := uuid.Parse(query.PageToken)
-		if err != nil {
-			return nil, fmt.Errorf("invalid page token: %w", err)
-		}
-		args = append(args, pageToken)
-	} else {
-		args = append(args, uuid.Nil)
-	}
-
-	args = append(args, query.Ids)
-
 	var pageSize int32
 	if query.PageSize > 0 {
 		pageSize = query.PageSize + 1
 	} else {
 		pageSize = query.PageSize
 	}
-	args = append(args, pageSize)
+
+	after := query.After
+	if after == nil {
+		after = &quiz.Cursor{}
+	}
+
+	var afterKey any
+	switch query.SortOrder {
+	case models.QuizSortMostCompleted:
+		afterKey = after.CompletionCount
+	case models.QuizSortTitle:
+		afterKey = after.Title
+	case models.QuizSortTopRated:
+		afterKey = after.RatingAverage
+	case models.QuizSortMostLiked:
+		afterKey = after.LikeCount
+	default:
+		afterKey = after.CreatedAt
+	}
+
+	args := append(filterArgs(query), pageSize, query.After != nil, afterKey, after.ID)
 
 	rows, err := r.pool.Query(ctx, sql, args...)
 	if err != nil {
@@ -106,7 +230,12 @@ func (r *Repository) Query(ctx context.Context, query quiz.Query) ([]*models.Qui
 	var quizzes []*models.Quiz
 	for rows.Next() {
 		q := new(models.Quiz)
-		if err := rows.Scan(&q.ID, &q.Title, &q.Results); err != nil {
+		if err := rows.Scan(&q.ID, &q.Title, &q.Results, &q.AuthorID, &q.Status,
+			&q.TieBreakPolicy, &q.TieBreakSeed, &q.TiebreakerQuestionID,
+			&q.ScoringModel, &q.TraitAxes, &q.ScoreThresholds,
+			&q.TimeLimitSeconds, &q.QuestionTimeLimitSeconds, &q.EarlyTermination, &q.QuestionDraw,
+			&q.ResultDetails, &q.Description, &q.Tags, &q.Language, &q.CreatedAt, &q.CompletionCount,
+			&q.CategoryIDs, &q.RatingAverage, &q.RatingCount, &q.LikeCount); err != nil {
 			return nil, fmt.Errorf("scan failed: %w", err)
 		}
 
@@ -119,3 +248,395 @@ func (r *Repository) Query(ctx context.Context, query quiz.Query) ([]*models.Qui
 
 	return quizzes, nil
 }
+
+// TagFacets counts the quizzes matching the filter of the query per tag,
+// regardless of paging.
+func (r *Repository) TagFacets(ctx context.Context, query quiz.Query) ([]models.TagFacet, error) {
+	sql := `
+	select tag_name, count(*)
+	from quiz_tags
+	where quiz_id in (select quiz_id
+	                  from quizzes
+	                  where ` + filterSQL + `)
+	group by tag_name
+	order by count(*) desc, tag_name
+	`
+
+	rows, err := r.pool.Query(ctx, sql, filterArgs(query)...)
+	if err != nil {
+		return nil, fmt.Errorf("query failed: %w", err)
+	}
+	defer rows.Close()
+
+	var facets []models.TagFacet
+	for rows.Next() {
+		var facet models.TagFacet
+		if err := rows.Scan(&facet.Tag, &facet.Count); err != nil {
+			return nil, fmt.Errorf("scan failed: %w", err)
+		}
+
+		facets = append(facets, facet)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return facets, nil
+}
+
+func (r *Repository) Update(ctx context.Context, q *models.Quiz) (*models.Quiz, error) {
+	sql := `
+	update quizzes
+	set quiz_title                  = $2,
+	    quiz_results                = $3,
+	    tie_break_policy            = $4,
+	    tie_break_seed              = $5,
+	    tiebreaker_question_id      = $6,
+	    scoring_model               = $7,
+	    trait_axes                  = coalesce($8::jsonb, '[]'),
+	    score_thresholds            = coalesce($9::real[], '{}'),
+	    time_limit_seconds          = $10,
+	    question_time_limit_seconds = $11,
+	    early_termination           = $12,
+	    question_draw               = $13,
+	    result_details              = coalesce($14::jsonb, '[]'),
+	    quiz_description            = $15,
+	    quiz_language               = $16,
+	    current_version_id          = null
+	where quiz_id = $1
+	`
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction failed: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				fmt.Printf("transaction rollback failed: %v\n", rbErr)
+			}
+			return
+		}
+		if cErr := tx.Commit(ctx); cErr != nil {
+			fmt.Printf("transaction commit failed: %v\n", cErr)
+		}
+	}()
+
+	tag, err := tx.Exec(ctx, sql, q.ID, q.Title, q.Results, q.TieBreakPolicy, q.TieBreakSeed, q.TiebreakerQuestionID,
+		q.ScoringModel, q.TraitAxes, q.ScoreThresholds, q.TimeLimitSeconds, q.QuestionTimeLimitSeconds, q.EarlyTermination,
+		q.QuestionDraw, q.ResultDetails, q.Description, q.Language)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update quiz: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		err = quiz.ErrQuizNotFound
+		return nil, err
+	}
+
+	if err = setClassification(ctx, tx, q); err != nil {
+		return nil, err
+	}
+
+	return q, nil
+}
+
+func (r *Repository) Delete(ctx context.Context, quizID uuid.UUID) error {
+	sql := `
+	delete from quizzes
+	where quiz_id = $1
+	`
+
+	tag, err := r.pool.Exec(ctx, sql, quizID)
+	if err != nil {
+		return fmt.Errorf("failed to delete quiz: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return quiz.ErrQuizNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) SetStatus(ctx context.Context, quizID uuid.UUID, status models.QuizStatus) error {
+	sql := `
+	update quizzes
+	set quiz_status = $2
+	where quiz_id = $1
+	`
+
+	tag, err := r.pool.Exec(ctx, sql, quizID, status)
+	if err != nil {
+		return fmt.Errorf("failed to update quiz status: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return quiz.ErrQuizNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) EnsureVersion(ctx context.Context, quizID uuid.UUID) (*models.QuizVersion, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction failed: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				fmt.Printf("transaction rollback failed: %v\n", rbErr)
+			}
+			return
+		}
+		if cErr := tx.Commit(ctx); cErr != nil {
+			fmt.Printf("transaction commit failed: %v\n", cErr)
+		}
+	}()
+
+	locked, err := lockQuiz(ctx, tx, quizID)
+	if err != nil {
+		return nil, err
+	}
+
+	if locked.currentVersionID != nil {
+		var version *models.QuizVersion
+		version, err = scanVersion(tx.QueryRow(ctx, selectVersionSQL, quizID, *locked.currentVersionID))
+		return version, err
+	}
+
+	locked.version.Questions, err = queryQuestions(ctx, tx, quizID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = insertVersion(ctx, tx, locked.version)
+	if err != nil {
+		return nil, err
+	}
+
+	return locked.version, nil
+}
+
+// Publish validates the questions of the quiz, publishes it and snapshots it
+// as a version in one transaction, so the version holds the questions that
+// were validated.
+func (r *Repository) Publish(ctx context.Context, quizID uuid.UUID, validate func(questions []*models.Question) error) (*models.QuizVersion, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction failed: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				fmt.Printf("transaction rollback failed: %v\n", rbErr)
+			}
+			return
+		}
+		if cErr := tx.Commit(ctx); cErr != nil {
+			fmt.Printf("transaction commit failed: %v\n", cErr)
+		}
+	}()
+
+	locked, err := lockQuiz(ctx, tx, quizID)
+	if err != nil {
+		return nil, err
+	}
+
+	if locked.status == models.QuizStatusPublished {
+		err = fmt.Errorf("%w: quiz is already published", quiz.ErrInvalidStatusTransition)
+		return nil, err
+	}
+
+	locked.version.Questions, err = queryQuestions(ctx, tx, quizID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validate(locked.version.Questions)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, `
+	update quizzes
+	set quiz_status = $2
+	where quiz_id = $1
+	`, quizID, models.QuizStatusPublished)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update quiz status: %w", err)
+	}
+
+	if locked.currentVersionID != nil {
+		var version *models.QuizVersion
+		version, err = scanVersion(tx.QueryRow(ctx, selectVersionSQL, quizID, *locked.currentVersionID))
+		return version, err
+	}
+
+	err = insertVersion(ctx, tx, locked.version)
+	if err != nil {
+		return nil, err
+	}
+
+	return locked.version, nil
+}
+
+type lockedQuiz struct {
+	status models.QuizStatus
+	// version holds the title and results of the quiz.
+	version *models.QuizVersion
+	// currentVersionID is the version the quiz has not changed since, if any.
+	currentVersionID *uuid.UUID
+}
+
+// lockQuiz locks the quiz for the rest of the transaction.
+func lockQuiz(ctx context.Context, tx pgx.Tx, quizID uuid.UUID) (*lockedQuiz, error) {
+	locked := &lockedQuiz{version: &models.QuizVersion{QuizID: quizID}}
+
+	err := tx.QueryRow(ctx, `
+	select quiz_status,
+	       quiz_title,
+	       quiz_results,
+	       current_version_id
+	from quizzes
+	where quiz_id = $1
+	for update
+	`, quizID).Scan(&locked.status, &locked.version.Title, &locked.version.Results, &locked.currentVersionID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, quiz.ErrQuizNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock quiz: %w", err)
+	}
+
+	return locked, nil
+}
+
+// insertVersion stores the version as the next one of its quiz and makes it
+// the current version.
+func insertVersion(ctx context.Context, tx pgx.Tx, version *models.QuizVersion) error {
+	questionsJSON, err := json.Marshal(version.Questions)
+	if err != nil {
+		return fmt.Errorf("failed to marshal questions: %w", err)
+	}
+
+	version.ID = uuid.New()
+	err = tx.QueryRow(ctx, `
+	insert into quiz_versions (quiz_version_id, quiz_id, quiz_version_number, quiz_title, quiz_results, quiz_questions)
+	select $1, $2, coalesce(max(quiz_version_number), 0) + 1, $3, $4, $5
+	from quiz_versions
+	where quiz_id = $2
+	returning quiz_version_number, created_at
+	`, version.ID, version.QuizID, version.Title, version.Results, questionsJSON).Scan(&version.Version, &version.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert quiz version: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+	update quizzes
+	set current_version_id = $2
+	where quiz_id = $1
+	`, version.QuizID, version.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update current quiz version: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) GetVersion(ctx context.Context, quizID, versionID uuid.UUID) (*models.QuizVersion, error) {
+	return scanVersion(r.pool.QueryRow(ctx, selectVersionSQL, quizID, versionID))
+}
+
+func (r *Repository) GuestSessionEnded(ctx context.Context, guestID uuid.UUID) (bool, error) {
+	sql := `
+	select exists (select 1
+	               from ended_guest_sessions
+	               where guest_id = $1)
+	`
+
+	var ended bool
+	if err := r.pool.QueryRow(ctx, sql, guestID).Scan(&ended); err != nil {
+		return false, fmt.Errorf("failed to check guest session: %w", err)
+	}
+
+	return ended, nil
+}
+
+const selectVersionSQL = `
+	select quiz_version_id,
+	       quiz_id,
+	       quiz_version_number,
+	       quiz_title,
+	       quiz_results,
+	       quiz_questions,
+	       created_at
+	from quiz_versions
+	where quiz_id = $1
+	  and quiz_version_id = $2
+	`
+
+func scanVersion(row pgx.Row) (*models.QuizVersion, error) {
+	v := new(models.QuizVersion)
+	var questionsJSON []byte
+
+	err := row.Scan(&v.ID, &v.QuizID, &v.Version, &v.Title, &v.Results, &questionsJSON, &v.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, quiz.ErrQuizVersionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("scan failed: %w", err)
+	}
+
+	if err := json.Unmarshal(questionsJSON, &v.Questions); err != nil {
+		return nil, fmt.Errorf("unmarshal failed: %w", err)
+	}
+
+	return v, nil
+}
+
+func queryQuestions(ctx context.Context, tx pgx.Tx, quizID uuid.UUID) ([]*models.Question, error) {
+	rows, err := tx.Query(ctx, `
+	select question_id,
+	       quiz_id,
+	       question_body,
+	       question_options,
+	       question_type,
+	       question_position,
+	       question_route,
+	       question_tags,
+	       question_image_id
+	from questions
+	where quiz_id = $1
+	order by question_position, question_id
+	`, quizID)
+	if err != nil {
+		return nil, fmt.Errorf("query failed: %w", err)
+	}
+	defer rows.Close()
+
+	questions := make([]*models.Question, 0)
+	for rows.Next() {
+		q := new(models.Question)
+		var optionsJSON []byte
+
+		if err := rows.Scan(&q.ID, &q.QuizID, &q.Body, &optionsJSON, &q.Type, &q.Position, &q.Route, &q.Tags,
+			&q.ImageID); err != nil {
+			return nil, fmt.Errorf("scan failed: %w", err)
+		}
+
+		if err := json.Unmarshal(optionsJSON, &q.Options); err != nil {
+			return nil, fmt.Errorf("unmarshal failed: %w", err)
+		}
+
+		questions = append(questions, q)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return questions, nil
+}
@@ -3,7 +3,9 @@ package quiz
 import "github.com/google/uuid"
 
 type Query struct {
-	Ids       []uuid.UUID
-	PageSize  int32
-	PageToken string
+	Ids      []uuid.UUID
+	ViewerID *uuid.UUID
+	Filter
+	PageSize int32
+	After    *Cursor
 }
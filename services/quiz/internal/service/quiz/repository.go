@@ -3,10 +3,19 @@ package quiz
 import (
 	"context"
 
+	"github.com/google/uuid"
 	"github.com/mibrgmv/whoami-server/quiz/internal/models"
 )
 
 type Repository interface {
 	Add(ctx context.Context, quiz *models.Quiz) (*models.Quiz, error)
 	Query(ctx context.Context, query Query) ([]*models.Quiz, error)
+	TagFacets(ctx context.Context, query Query) ([]models.TagFacet, error)
+	Update(ctx context.Context, quiz *models.Quiz) (*models.Quiz, error)
+	Delete(ctx context.Context, quizID uuid.UUID) error
+	SetStatus(ctx context.Context, quizID uuid.UUID, status models.QuizStatus) error
+	EnsureVersion(ctx context.Context, quizID uuid.UUID) (*models.QuizVersion, error)
+	Publish(ctx context.Context, quizID uuid.UUID, validate func(questions []*models.Question) error) (*models.QuizVersion, error)
+	GetVersion(ctx context.Context, quizID, versionID uuid.UUID) (*models.QuizVersion, error)
+	GuestSessionEnded(ctx context.Context, guestID uuid.UUID) (bool, error)
 }
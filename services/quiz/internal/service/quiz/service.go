@@ -4,13 +4,23 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/google/uuid"
 	"github.com/mibrgmv/whoami-server/quiz/internal/models"
-	"github.com/mibrgmv/whoami-server/shared/tools"
+	"github.com/mibrgmv/whoami-server/shared/grpc/interceptor"
 )
 
-var ErrQuizNotFound = errors.New("quiz not found")
+const AdminRole = "quiz-admin"
+
+var (
+	ErrQuizNotFound            = errors.New("quiz not found")
+	ErrQuizVersionNotFound     = errors.New("quiz version not found")
+	ErrNotQuizAuthor           = errors.New("only the quiz author can modify the quiz")
+	ErrInvalidStatusTransition = errors.New("invalid quiz status transition")
+	ErrQuizNotPublishable      = errors.New("quiz cannot be published")
+	ErrInvalidPageToken        = errors.New("invalid page token")
+)
 
 type Service struct {
 	repo Repository
@@ -24,13 +34,25 @@ func (s *Service) Add(ctx context.Context, quiz *models.Quiz) (*models.Quiz, err
 	return s.repo.Add(ctx, quiz)
 }
 
-func (s *Service) Get(ctx context.Context, pageSize int32, pageToken string) ([]*models.Quiz, string, error) {
-	parsedToken, err := tools.ParsePageToken(pageToken)
+func (s *Service) Update(ctx context.Context, quiz *models.Quiz) (*models.Quiz, error) {
+	return s.repo.Update(ctx, quiz)
+}
+
+func (s *Service) Delete(ctx context.Context, quizID uuid.UUID) error {
+	return s.repo.Delete(ctx, quizID)
+}
+
+func (s *Service) Get(ctx context.Context, filter Filter, pageSize int32, pageToken string) ([]*models.Quiz, string, error) {
+	if filter.SortOrder == "" {
+		filter.SortOrder = models.QuizSortNewest
+	}
+
+	after, err := ParsePageToken(pageToken, filter.SortOrder)
 	if err != nil {
-		return nil, "", fmt.Errorf("failed to parse token: %w", err)
+		return nil, "", err
 	}
 
-	quizzes, err := s.repo.Query(ctx, Query{PageSize: pageSize, PageToken: parsedToken})
+	quizzes, err := s.repo.Query(ctx, Query{ViewerID: viewerID(ctx), Filter: filter, PageSize: pageSize, After: after})
 	if err != nil {
 		return nil, "", err
 	}
@@ -38,11 +60,51 @@ func (s *Service) Get(ctx context.Context, pageSize int32, pageToken string) ([]
 	var nextPageToken string
 	if pageSize > 0 && len(quizzes) > int(pageSize) {
 		quizzes = quizzes[:len(quizzes)-1]
-		lastQuizID := quizzes[len(quizzes)-1].ID
-		nextPageToken = tools.CreatePageToken(lastQuizID)
+		nextPageToken = cursorAfter(filter.SortOrder, quizzes[len(quizzes)-1]).PageToken()
+	}
+
+	return quizzes, nextPageToken, nil
+}
+
+// TagFacets counts the quizzes matching the filter per tag.
+func (s *Service) TagFacets(ctx context.Context, filter Filter) ([]models.TagFacet, error) {
+	return s.repo.TagFacets(ctx, Query{ViewerID: viewerID(ctx), Filter: filter})
+}
+
+// Recommendable returns the published quizzes among the given ones in the same
+// order, leaving out the quizzes of the user.
+func (s *Service) Recommendable(ctx context.Context, quizIDs []uuid.UUID) ([]*models.Quiz, error) {
+	if len(quizIDs) == 0 {
+		return nil, nil
+	}
+
+	quizzes, err := s.repo.Query(ctx, Query{
+		Ids:      quizIDs,
+		Filter:   Filter{Status: models.QuizStatusPublished, SortOrder: models.QuizSortNewest},
+		PageSize: int32(len(quizIDs)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	userID, _ := interceptor.GetUserIDFromContext(ctx)
+
+	byID := make(map[uuid.UUID]*models.Quiz, len(quizzes))
+	for _, q := range quizzes {
+		if q.AuthorID != userID {
+			byID[q.ID] = q
+		}
+	}
+
+	recommendable := make([]*models.Quiz, 0, len(byID))
+	for _, id := range quizIDs {
+		if q, ok := byID[id]; ok {
+			recommendable = append(recommendable, q)
+			delete(byID, id)
+		}
 	}
 
-	return quizzes, nextPageToken, err
+	return recommendable, nil
 }
 
 func (s *Service) GetByID(ctx context.Context, quizID uuid.UUID) (*models.Quiz, error) {
@@ -57,3 +119,185 @@ func (s *Service) GetByID(ctx context.Context, quizID uuid.UUID) (*models.Quiz,
 
 	return quizzes[0], nil
 }
+
+func (s *Service) CheckAuthor(ctx context.Context, quiz *models.Quiz) error {
+	userID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if quiz.AuthorID == userID || slices.Contains(interceptor.GetRolesFromContext(ctx), AdminRole) {
+		return nil
+	}
+
+	return ErrNotQuizAuthor
+}
+
+func (s *Service) EnsureVersion(ctx context.Context, quizID uuid.UUID) (*models.QuizVersion, error) {
+	return s.repo.EnsureVersion(ctx, quizID)
+}
+
+func (s *Service) GetVersion(ctx context.Context, quizID, versionID uuid.UUID) (*models.QuizVersion, error) {
+	return s.repo.GetVersion(ctx, quizID, versionID)
+}
+
+func (s *Service) CanView(ctx context.Context, quiz *models.Quiz) bool {
+	return quiz.Status != models.QuizStatusDraft || s.CheckAuthor(ctx, quiz) == nil
+}
+
+// Publish makes the quiz available to everyone. The questions are validated,
+// the status is changed and the quiz is snapshotted as a version in one
+// transaction, so a question edited meanwhile cannot slip into the version
+// unvalidated.
+func (s *Service) Publish(ctx context.Context, quiz *models.Quiz) (*models.Quiz, error) {
+	if quiz.Status == models.QuizStatusPublished {
+		return nil, fmt.Errorf("%w: quiz is already published", ErrInvalidStatusTransition)
+	}
+
+	_, err := s.repo.Publish(ctx, quiz.ID, func(questions []*models.Question) error {
+		return validateForPublish(quiz, questions)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	quiz.Status = models.QuizStatusPublished
+	return quiz, nil
+}
+
+func (s *Service) Archive(ctx context.Context, quiz *models.Quiz) (*models.Quiz, error) {
+	if quiz.Status == models.QuizStatusArchived {
+		return nil, fmt.Errorf("%w: quiz is already archived", ErrInvalidStatusTransition)
+	}
+
+	return s.setStatus(ctx, quiz, models.QuizStatusArchived)
+}
+
+func (s *Service) setStatus(ctx context.Context, quiz *models.Quiz, status models.QuizStatus) (*models.Quiz, error) {
+	if err := s.repo.SetStatus(ctx, quiz.ID, status); err != nil {
+		return nil, err
+	}
+
+	quiz.Status = status
+	return quiz, nil
+}
+
+func validateForPublish(quiz *models.Quiz, questions []*models.Question) error {
+	if err := validateScoring(quiz); err != nil {
+		return err
+	}
+
+	if len(questions) == 0 {
+		return fmt.Errorf("%w: quiz has no questions", ErrQuizNotPublishable)
+	}
+
+	for _, q := range questions {
+		if len(q.Options) == 0 {
+			return fmt.Errorf("%w: question %s has no options", ErrQuizNotPublishable, q.ID)
+		}
+
+		for _, option := range q.Options {
+			if len(option.Weights) != quiz.WeightsLen() {
+				return fmt.Errorf("%w: option '%s' of question %s has %d weights, expected %d",
+					ErrQuizNotPublishable, option.Text, q.ID, len(option.Weights), quiz.WeightsLen())
+			}
+		}
+	}
+
+	if quiz.TieBreakPolicy == models.TieBreakTiebreakerQuestion &&
+		!slices.ContainsFunc(questions, func(q *models.Question) bool { return quiz.IsTiebreaker(q.ID) }) {
+		return fmt.Errorf("%w: tiebreaker question is not set", ErrQuizNotPublishable)
+	}
+
+	if err := models.NewFlow(questions).Validate(); err != nil {
+		return fmt.Errorf("%w: %v", ErrQuizNotPublishable, err)
+	}
+
+	return validateDraw(quiz, questions)
+}
+
+// validateDraw checks that there are enough questions to draw from and that
+// the quiz does not branch, as routes could lead to questions left undrawn.
+func validateDraw(quiz *models.Quiz, questions []*models.Question) error {
+	draw := quiz.QuestionDraw
+	if draw == nil {
+		return nil
+	}
+
+	pool := slices.DeleteFunc(slices.Clone(questions), func(q *models.Question) bool { return quiz.IsTiebreaker(q.ID) })
+
+	var perTag int32
+	for tag, n := range draw.PerTag {
+		tagged := 0
+		for _, q := range pool {
+			if slices.Contains(q.Tags, tag) {
+				tagged++
+			}
+		}
+		if n < 0 || int(n) > tagged {
+			return fmt.Errorf("%w: cannot draw %d questions tagged '%s' out of %d", ErrQuizNotPublishable, n, tag, tagged)
+		}
+		perTag += n
+	}
+
+	if draw.Count < 0 || draw.Count > 0 && draw.Count < perTag || int(draw.Count) > len(pool) {
+		return fmt.Errorf("%w: cannot draw %d questions out of %d with %d drawn by tag",
+			ErrQuizNotPublishable, draw.Count, len(pool), perTag)
+	}
+
+	for _, q := range questions {
+		routed := q.Route != nil || slices.ContainsFunc(q.Options, func(option models.Option) bool { return option.Route != nil })
+		if routed {
+			return fmt.Errorf("%w: question %s routes, but quizzes that draw questions cannot branch", ErrQuizNotPublishable, q.ID)
+		}
+	}
+
+	return nil
+}
+
+func validateScoring(quiz *models.Quiz) error {
+	if quiz.ScoringModel == models.ScoringTraits {
+		if len(quiz.TraitAxes) == 0 {
+			return fmt.Errorf("%w: quiz has no trait axes", ErrQuizNotPublishable)
+		}
+		for _, axis := range quiz.TraitAxes {
+			if axis.Positive == "" || axis.Negative == "" {
+				return fmt.Errorf("%w: trait axis poles must not be empty", ErrQuizNotPublishable)
+			}
+		}
+		return nil
+	}
+
+	if len(quiz.Results) == 0 {
+		return fmt.Errorf("%w: quiz has no results", ErrQuizNotPublishable)
+	}
+
+	thresholds := len(quiz.ScoreThresholds)
+	needsThresholds := quiz.ScoringModel == models.ScoringBands ||
+		quiz.ScoringModel == models.ScoringKnowledge && thresholds > 0
+	if needsThresholds && thresholds != len(quiz.Results)-1 {
+		return fmt.Errorf("%w: quiz with %d results needs %d score thresholds, got %d",
+			ErrQuizNotPublishable, len(quiz.Results), len(quiz.Results)-1, thresholds)
+	}
+
+	if !slices.IsSorted(quiz.ScoreThresholds) {
+		return fmt.Errorf("%w: score thresholds must be ascending", ErrQuizNotPublishable)
+	}
+
+	return nil
+}
+
+// viewerID returns the user whose drafts may be listed alongside published
+// quizzes, or nil when the caller is allowed to see every quiz.
+func viewerID(ctx context.Context) *uuid.UUID {
+	if slices.Contains(interceptor.GetRolesFromContext(ctx), AdminRole) {
+		return nil
+	}
+
+	userID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return &uuid.Nil
+	}
+
+	return &userID
+}
@@ -0,0 +1,434 @@
+package quiz_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/quiz"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/quiz/mocks"
+	"github.com/mibrgmv/whoami-server/shared/grpc/interceptor"
+	"github.com/mibrgmv/whoami-server/shared/guest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestCheckAuthor(t *testing.T) {
+	service := quiz.NewService(nil)
+
+	authorID := uuid.New()
+	q := &models.Quiz{
+		ID:       uuid.New(),
+		Title:    "GTA V Character Quiz",
+		Results:  []string{"Michael", "Franklin", "Trevor"},
+		AuthorID: authorID,
+	}
+
+	tests := []struct {
+		name    string
+		userID  string
+		roles   []string
+		wantErr error
+		anyErr  bool
+	}{
+		{
+			name:   "Author",
+			userID: authorID.String(),
+		},
+		{
+			name:    "Other user",
+			userID:  uuid.NewString(),
+			wantErr: quiz.ErrNotQuizAuthor,
+		},
+		{
+			name:    "Other user with unrelated role",
+			userID:  uuid.NewString(),
+			roles:   []string{"offline_access"},
+			wantErr: quiz.ErrNotQuizAuthor,
+		},
+		{
+			name:   "Admin",
+			userID: uuid.NewString(),
+			roles:  []string{quiz.AdminRole},
+		},
+		{
+			name:   "Unauthenticated",
+			anyErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.userID != "" {
+				ctx = context.WithValue(ctx, interceptor.UserIDKey, tt.userID)
+			}
+			if tt.roles != nil {
+				ctx = context.WithValue(ctx, interceptor.RolesKey, tt.roles)
+			}
+
+			err := service.CheckAuthor(ctx, q)
+
+			switch {
+			case tt.wantErr != nil:
+				assert.ErrorIs(t, err, tt.wantErr)
+			case tt.anyErr:
+				assert.Error(t, err)
+				assert.NotErrorIs(t, err, quiz.ErrNotQuizAuthor)
+			default:
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPublish(t *testing.T) {
+	quizID := uuid.New()
+	newQuiz := func(status models.QuizStatus) *models.Quiz {
+		return &models.Quiz{
+			ID:      quizID,
+			Title:   "GTA V Character Quiz",
+			Results: []string{"Michael", "Franklin", "Trevor"},
+			Status:  status,
+		}
+	}
+
+	validQuestions := []*models.Question{
+		{
+			ID:     uuid.New(),
+			QuizID: quizID,
+			Body:   "Do you like drinking gasoline?",
+			Options: models.OptionsFromWeights(map[string][]float32{
+				"Yes": {0.0, 0.0, 1.0},
+				"No":  {0.5, 0.5, 0.0},
+			}),
+		},
+	}
+
+	// flowQuestions returns three questions by position with the given routes
+	// applied: routes[i] is the route of question i, optionRoutes[i] the route
+	// of its "Yes" option; a target of -1 ends the quiz.
+	flowQuestions := func(routes, optionRoutes map[int]int) []*models.Question {
+		questions := make([]*models.Question, 3)
+		for i := range questions {
+			questions[i] = &models.Question{
+				ID:       uuid.New(),
+				QuizID:   quizID,
+				Body:     "Do you like drinking gasoline?",
+				Position: int32(i),
+				Options: models.OptionsFromWeights(map[string][]float32{
+					"No":  {0.5, 0.5, 0.0},
+					"Yes": {0.0, 0.0, 1.0},
+				}),
+			}
+		}
+
+		route := func(target int) *models.Route {
+			if target < 0 {
+				return &models.Route{End: true}
+			}
+			return &models.Route{NextQuestionID: &questions[target].ID}
+		}
+		for i, target := range routes {
+			questions[i].Route = route(target)
+		}
+		for i, target := range optionRoutes {
+			questions[i].Options[1].Route = route(target)
+		}
+
+		return questions
+	}
+
+	unknownTarget := flowQuestions(nil, nil)
+	unknownID := uuid.New()
+	unknownTarget[0].Options[1].Route = &models.Route{NextQuestionID: &unknownID}
+
+	withDraw := func(draw *models.QuestionDraw) *models.Quiz {
+		q := newQuiz(models.QuizStatusDraft)
+		q.QuestionDraw = draw
+		return q
+	}
+
+	taggedQuestions := flowQuestions(nil, nil)
+	taggedQuestions[0].Tags = []string{"heists"}
+
+	tests := []struct {
+		name      string
+		quiz      *models.Quiz
+		questions []*models.Question
+		wantErr   error
+	}{
+		{
+			name:      "Valid draft",
+			quiz:      newQuiz(models.QuizStatusDraft),
+			questions: validQuestions,
+		},
+		{
+			name:      "Archived quiz can be republished",
+			quiz:      newQuiz(models.QuizStatusArchived),
+			questions: validQuestions,
+		},
+		{
+			name:      "Already published",
+			quiz:      newQuiz(models.QuizStatusPublished),
+			questions: validQuestions,
+			wantErr:   quiz.ErrInvalidStatusTransition,
+		},
+		{
+			name:    "No questions",
+			quiz:    newQuiz(models.QuizStatusDraft),
+			wantErr: quiz.ErrQuizNotPublishable,
+		},
+		{
+			name: "Weights length mismatch",
+			quiz: newQuiz(models.QuizStatusDraft),
+			questions: []*models.Question{
+				{
+					ID:     uuid.New(),
+					QuizID: quizID,
+					Body:   "Are you good at math?",
+					Options: models.OptionsFromWeights(map[string][]float32{
+						"Yes": {1.0, 0.0},
+					}),
+				},
+			},
+			wantErr: quiz.ErrQuizNotPublishable,
+		},
+		{
+			name: "Tiebreaker policy without tiebreaker question",
+			quiz: &models.Quiz{
+				ID:             quizID,
+				Title:          "GTA V Character Quiz",
+				Results:        []string{"Michael", "Franklin", "Trevor"},
+				Status:         models.QuizStatusDraft,
+				TieBreakPolicy: models.TieBreakTiebreakerQuestion,
+			},
+			questions: validQuestions,
+			wantErr:   quiz.ErrQuizNotPublishable,
+		},
+		{
+			name: "Bands without thresholds",
+			quiz: &models.Quiz{
+				ID:           quizID,
+				Title:        "GTA V Character Quiz",
+				Results:      []string{"Michael", "Franklin", "Trevor"},
+				Status:       models.QuizStatusDraft,
+				ScoringModel: models.ScoringBands,
+			},
+			questions: validQuestions,
+			wantErr:   quiz.ErrQuizNotPublishable,
+		},
+		{
+			name:      "Branching flow",
+			quiz:      newQuiz(models.QuizStatusDraft),
+			questions: flowQuestions(map[int]int{1: -1}, map[int]int{0: 2}),
+		},
+		{
+			name:      "Flow with a cycle",
+			quiz:      newQuiz(models.QuizStatusDraft),
+			questions: flowQuestions(nil, map[int]int{2: 0}),
+			wantErr:   quiz.ErrQuizNotPublishable,
+		},
+		{
+			name:      "Unreachable question",
+			quiz:      newQuiz(models.QuizStatusDraft),
+			questions: flowQuestions(map[int]int{0: 2}, nil),
+			wantErr:   quiz.ErrQuizNotPublishable,
+		},
+		{
+			name:      "Route to unknown question",
+			quiz:      newQuiz(models.QuizStatusDraft),
+			questions: unknownTarget,
+			wantErr:   quiz.ErrQuizNotPublishable,
+		},
+		{
+			name:      "Question draw",
+			quiz:      withDraw(&models.QuestionDraw{Count: 2, PerTag: map[string]int32{"heists": 1}}),
+			questions: taggedQuestions,
+		},
+		{
+			name:      "Question draw with too few tagged questions",
+			quiz:      withDraw(&models.QuestionDraw{PerTag: map[string]int32{"heists": 2}}),
+			questions: taggedQuestions,
+			wantErr:   quiz.ErrQuizNotPublishable,
+		},
+		{
+			name:      "Question draw larger than the quiz",
+			quiz:      withDraw(&models.QuestionDraw{Count: 4}),
+			questions: taggedQuestions,
+			wantErr:   quiz.ErrQuizNotPublishable,
+		},
+		{
+			name:      "Question draw in a branching quiz",
+			quiz:      withDraw(&models.QuestionDraw{Count: 2}),
+			questions: flowQuestions(map[int]int{1: -1}, nil),
+			wantErr:   quiz.ErrQuizNotPublishable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mocks.MockRepository)
+			mockRepo.On("Publish", mock.Anything, quizID).Return(tt.questions, &models.QuizVersion{ID: uuid.New(), QuizID: quizID, Version: 1}, nil)
+			service := quiz.NewService(mockRepo)
+			status := tt.quiz.Status
+
+			published, err := service.Publish(context.Background(), tt.quiz)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, status, tt.quiz.Status)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, models.QuizStatusPublished, published.Status)
+			mockRepo.AssertCalled(t, "Publish", mock.Anything, quizID)
+		})
+	}
+}
+
+func TestCanView(t *testing.T) {
+	service := quiz.NewService(nil)
+
+	authorID := uuid.New()
+	draft := &models.Quiz{ID: uuid.New(), AuthorID: authorID, Status: models.QuizStatusDraft}
+	published := &models.Quiz{ID: uuid.New(), AuthorID: authorID, Status: models.QuizStatusPublished}
+
+	authorCtx := context.WithValue(context.Background(), interceptor.UserIDKey, authorID.String())
+	otherCtx := context.WithValue(context.Background(), interceptor.UserIDKey, uuid.NewString())
+
+	assert.True(t, service.CanView(authorCtx, draft))
+	assert.False(t, service.CanView(otherCtx, draft))
+	assert.True(t, service.CanView(otherCtx, published))
+}
+
+func TestGet_PageToken(t *testing.T) {
+	mockRepo := new(mocks.MockRepository)
+	service := quiz.NewService(mockRepo)
+	ctx := context.WithValue(context.Background(), interceptor.UserIDKey, uuid.NewString())
+
+	quizzes := []*models.Quiz{
+		{ID: uuid.New(), Title: "Alpha", CompletionCount: 30},
+		{ID: uuid.New(), Title: "Beta", CompletionCount: 20},
+		{ID: uuid.New(), Title: "Gamma", CompletionCount: 10},
+	}
+	filter := quiz.Filter{Search: "planet", SortOrder: models.QuizSortMostCompleted}
+
+	mockRepo.On("Query", mock.Anything, mock.MatchedBy(func(query quiz.Query) bool {
+		return query.After == nil && query.Filter == filter && query.PageSize == 2
+	})).Return(quizzes, nil).Once()
+
+	page, token, err := service.Get(ctx, filter, 2, "")
+	assert.NoError(t, err)
+	assert.Equal(t, quizzes[:2], page)
+	assert.NotEmpty(t, token)
+
+	mockRepo.On("Query", mock.Anything, mock.MatchedBy(func(query quiz.Query) bool {
+		return query.After != nil && query.After.ID == quizzes[1].ID && query.After.CompletionCount == 20
+	})).Return(quizzes[2:], nil).Once()
+
+	_, _, err = service.Get(ctx, quiz.Filter{SortOrder: models.QuizSortTitle}, 2, token)
+	assert.ErrorIs(t, err, quiz.ErrInvalidPageToken)
+
+	page, token, err = service.Get(ctx, filter, 2, token)
+	assert.NoError(t, err)
+	assert.Equal(t, quizzes[2:], page)
+	assert.Empty(t, token)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRecommendable(t *testing.T) {
+	mockRepo := new(mocks.MockRepository)
+	service := quiz.NewService(mockRepo)
+
+	userID := uuid.New()
+	ctx := context.WithValue(context.Background(), interceptor.UserIDKey, userID.String())
+
+	first := &models.Quiz{ID: uuid.New(), Title: "Which planet are you", Status: models.QuizStatusPublished}
+	second := &models.Quiz{ID: uuid.New(), Title: "Which season are you", Status: models.QuizStatusPublished}
+	own := &models.Quiz{ID: uuid.New(), Title: "My quiz", Status: models.QuizStatusPublished, AuthorID: userID}
+	unpublishedID := uuid.New()
+
+	ids := []uuid.UUID{second.ID, unpublishedID, own.ID, first.ID}
+	mockRepo.On("Query", mock.Anything, mock.MatchedBy(func(query quiz.Query) bool {
+		return assert.ObjectsAreEqual(ids, query.Ids) && query.Status == models.QuizStatusPublished && query.PageSize == 4
+	})).Return([]*models.Quiz{first, own, second}, nil).Once()
+
+	recommendable, err := service.Recommendable(ctx, ids)
+	assert.NoError(t, err)
+	assert.Equal(t, []*models.Quiz{second, first}, recommendable)
+
+	recommendable, err = service.Recommendable(ctx, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, recommendable)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestTakerID(t *testing.T) {
+	userID := uuid.New()
+	guestID := guest.NewID()
+
+	userCtx := context.WithValue(context.Background(), interceptor.UserIDKey, userID.String())
+	guestCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(quiz.GuestIDKey, guestID.String()))
+
+	takerID, err := quiz.TakerID(userCtx)
+	assert.NoError(t, err)
+	assert.Equal(t, userID, takerID)
+
+	takerID, err = quiz.TakerID(guestCtx)
+	assert.NoError(t, err)
+	assert.Equal(t, guestID, takerID)
+
+	takerID, err = quiz.TakerID(context.WithValue(guestCtx, interceptor.UserIDKey, userID.String()))
+	assert.NoError(t, err)
+	assert.Equal(t, userID, takerID)
+
+	_, err = quiz.TakerID(context.Background())
+	assert.ErrorIs(t, err, quiz.ErrNoQuizTaker)
+
+	badCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(quiz.GuestIDKey, "not-a-uuid"))
+	_, err = quiz.TakerID(badCtx)
+	assert.ErrorIs(t, err, quiz.ErrNoQuizTaker)
+
+	userAsGuestCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(quiz.GuestIDKey, userID.String()))
+	_, err = quiz.TakerID(userAsGuestCtx)
+	assert.ErrorIs(t, err, quiz.ErrNoQuizTaker)
+
+	spoofedCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(quiz.GuestIDKey, guest.NewID().String(), quiz.GuestIDKey, guestID.String()))
+	_, err = quiz.TakerID(spoofedCtx)
+	assert.ErrorIs(t, err, quiz.ErrNoQuizTaker)
+
+	guestDraft := &models.Quiz{ID: uuid.New(), AuthorID: guestID, Status: models.QuizStatusDraft}
+	assert.False(t, quiz.NewService(nil).CanView(guestCtx, guestDraft))
+}
+
+func TestService_TakerID_EndedGuestSession(t *testing.T) {
+	userID := uuid.New()
+	guestID := guest.NewID()
+	endedID := guest.NewID()
+
+	mockRepo := new(mocks.MockRepository)
+	mockRepo.On("GuestSessionEnded", mock.Anything, guestID).Return(false, nil)
+	mockRepo.On("GuestSessionEnded", mock.Anything, endedID).Return(true, nil)
+	service := quiz.NewService(mockRepo)
+
+	guestCtx := func(id uuid.UUID) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(quiz.GuestIDKey, id.String()))
+	}
+
+	takerID, err := service.TakerID(guestCtx(guestID))
+	assert.NoError(t, err)
+	assert.Equal(t, guestID, takerID)
+
+	_, err = service.TakerID(guestCtx(endedID))
+	assert.ErrorIs(t, err, quiz.ErrGuestSessionEnded)
+	assert.ErrorIs(t, err, quiz.ErrNoQuizTaker)
+
+	takerID, err = service.TakerID(context.WithValue(guestCtx(endedID), interceptor.UserIDKey, userID.String()))
+	assert.NoError(t, err, "the user the guest signed in as is not affected")
+	assert.Equal(t, userID, takerID)
+	mockRepo.AssertNumberOfCalls(t, "GuestSessionEnded", 2)
+}
@@ -0,0 +1,74 @@
+package quiz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/shared/grpc/interceptor"
+	"github.com/mibrgmv/whoami-server/shared/guest"
+	"google.golang.org/grpc/metadata"
+)
+
+// GuestIDKey is the metadata key the gateway passes the anonymous session of
+// a guest with, once it has checked the signature of the session.
+const GuestIDKey = "guest_id"
+
+var (
+	ErrNoQuizTaker = errors.New("user or guest session required")
+	// ErrGuestSessionEnded rejects a guest session after the guest has signed
+	// in and their attempts and history have been moved to the user.
+	ErrGuestSessionEnded = fmt.Errorf("%w: guest session has ended, sign in to continue", ErrNoQuizTaker)
+)
+
+// TakerID returns whom attempts and history are recorded for: the signed in
+// user or, for a guest, the ID of their anonymous session. Guests take quizzes
+// the same way users do but cannot author or moderate anything, since only
+// the user ID is checked for that.
+func TakerID(ctx context.Context) (uuid.UUID, error) {
+	if userID, err := interceptor.GetUserIDFromContext(ctx); err == nil {
+		return userID, nil
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get(GuestIDKey)) == 0 || md.Get(GuestIDKey)[0] == "" {
+		return uuid.Nil, ErrNoQuizTaker
+	}
+
+	// the gateway sends exactly one guest ID and drops any a client sends, so
+	// more than one means the request did not come from the gateway
+	if len(md.Get(GuestIDKey)) > 1 {
+		return uuid.Nil, fmt.Errorf("%w: more than one guest session ID", ErrNoQuizTaker)
+	}
+
+	guestID, err := uuid.Parse(md.Get(GuestIDKey)[0])
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%w: invalid guest session ID: %v", ErrNoQuizTaker, err)
+	}
+	if !guest.IsID(guestID) {
+		return uuid.Nil, fmt.Errorf("%w: invalid guest session ID", ErrNoQuizTaker)
+	}
+
+	return guestID, nil
+}
+
+// TakerID returns whom attempts and history are recorded for, see TakerID, and
+// rejects a guest session that has ended with ErrGuestSessionEnded, so that
+// nothing is recorded for a guest after they signed in.
+func (s *Service) TakerID(ctx context.Context) (uuid.UUID, error) {
+	takerID, err := TakerID(ctx)
+	if err != nil || !guest.IsID(takerID) {
+		return takerID, err
+	}
+
+	ended, err := s.repo.GuestSessionEnded(ctx, takerID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if ended {
+		return uuid.Nil, ErrGuestSessionEnded
+	}
+
+	return takerID, nil
+}
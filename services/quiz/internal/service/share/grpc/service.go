@@ -0,0 +1,124 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+	historyv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/history/v1"
+	sharev1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/share/v1"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/quiz"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/share"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/translation"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ShareService struct {
+	service            *share.Service
+	quizService        *quiz.Service
+	translationService *translation.Service
+	historyClient      historyv1.HistoryServiceClient
+	sharev1.UnimplementedShareServiceServer
+}
+
+func NewService(service *share.Service, quizService *quiz.Service, translationService *translation.Service,
+	historyClient historyv1.HistoryServiceClient) *ShareService {
+	return &ShareService{
+		service:            service,
+		quizService:        quizService,
+		translationService: translationService,
+		historyClient:      historyClient,
+	}
+}
+
+func (s *ShareService) GetSharedResult(ctx context.Context, request *sharev1.GetSharedResultRequest) (*sharev1.SharedResult, error) {
+	shared, q, detail, err := s.getSharedResult(ctx, request.ShareToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sharev1.SharedResult{
+		ShareToken:      shared.ShareToken,
+		QuizId:          q.ID.String(),
+		QuizTitle:       q.Title,
+		Result:          detail.ToProto(),
+		DisplayName:     shared.DisplayName,
+		CompletedAt:     shared.Item.CompletedAt,
+		ImageUrl:        fmt.Sprintf("/api/v1/shared/%s/image", shared.ShareToken),
+		DisplayLanguage: q.DisplayLanguage,
+	}, nil
+}
+
+func (s *ShareService) GetSharedResultImage(ctx context.Context, request *sharev1.GetSharedResultImageRequest) (*httpbody.HttpBody, error) {
+	shared, q, detail, err := s.getSharedResult(ctx, request.ShareToken)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := s.service.RenderCard(&share.Card{
+		QuizTitle:         q.Title,
+		Result:            detail.Title,
+		ResultDescription: detail.Description,
+		DisplayName:       shared.DisplayName,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to render result card: %v", err)
+	}
+
+	return &httpbody.HttpBody{
+		ContentType: "image/png",
+		Data:        data,
+	}, nil
+}
+
+// getSharedResult returns the shared history item with the quiz and the detail
+// of its result, both in the language negotiated for the caller. Results of
+// draft quizzes are never shown.
+func (s *ShareService) getSharedResult(ctx context.Context, token string) (*historyv1.SharedItem, *models.Quiz, *models.ResultDetail, error) {
+	if token == "" {
+		return nil, nil, nil, status.Error(codes.InvalidArgument, "share token is required")
+	}
+
+	shared, err := s.historyClient.GetSharedItem(ctx, &historyv1.GetSharedItemRequest{ShareToken: token})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil, nil, status.Error(codes.NotFound, "shared result not found")
+		}
+		return nil, nil, nil, status.Errorf(codes.Unavailable, "failed to get shared result: %v", err)
+	}
+
+	quizID, err := uuid.Parse(shared.Item.QuizId)
+	if err != nil {
+		return nil, nil, nil, status.Errorf(codes.Internal, "invalid quiz ID format: %v", err)
+	}
+
+	q, err := s.quizService.GetByID(ctx, quizID)
+	if err != nil {
+		if errors.Is(err, quiz.ErrQuizNotFound) {
+			return nil, nil, nil, status.Error(codes.NotFound, "shared result not found")
+		}
+		return nil, nil, nil, status.Errorf(codes.Internal, "failed to get quiz: %v", err)
+	}
+
+	if q.Status == models.QuizStatusDraft {
+		return nil, nil, nil, status.Error(codes.NotFound, "shared result not found")
+	}
+
+	localization, err := s.translationService.Localize(ctx, q)
+	if err != nil {
+		return nil, nil, nil, status.Errorf(codes.Internal, "failed to localize quiz: %v", err)
+	}
+	localized := localization.Quiz(q)
+
+	detail := q.ResultDetail(shared.Item.QuizResult)
+	if i := slices.Index(q.Results, shared.Item.QuizResult); i >= 0 {
+		detail.Title = localized.Results[i]
+	}
+
+	return shared, localized, detail, nil
+}
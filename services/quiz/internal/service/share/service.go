@@ -0,0 +1,190 @@
+package share
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"strings"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+// The size of Open Graph images recommended by most platforms.
+const (
+	CardWidth  = 1200
+	CardHeight = 630
+)
+
+const cardPadding = 80
+
+var (
+	cardBackground = color.RGBA{R: 0x1e, G: 0x1b, B: 0x4b, A: 0xff}
+	cardAccent     = color.RGBA{R: 0xa5, G: 0xb4, B: 0xfc, A: 0xff}
+	cardText       = color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+	cardMuted      = color.RGBA{R: 0xc7, G: 0xd2, B: 0xfe, A: 0xff}
+)
+
+// Card is what the image of a shared result shows.
+type Card struct {
+	QuizTitle         string
+	Result            string
+	ResultDescription string
+	DisplayName       string
+}
+
+type Service struct {
+	regular *opentype.Font
+	bold    *opentype.Font
+}
+
+func NewService() (*Service, error) {
+	regular, err := opentype.Parse(goregular.TTF)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse regular font: %w", err)
+	}
+
+	bold, err := opentype.Parse(gobold.TTF)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse bold font: %w", err)
+	}
+
+	return &Service{
+		regular: regular,
+		bold:    bold,
+	}, nil
+}
+
+// RenderCard draws the card as a PNG image of CardWidth by CardHeight pixels.
+// Text that does not fit is wrapped and cut with an ellipsis.
+func (s *Service) RenderCard(card *Card) ([]byte, error) {
+	img := image.NewRGBA(image.Rect(0, 0, CardWidth, CardHeight))
+	draw.Draw(img, img.Bounds(), &image.Uniform{C: cardBackground}, image.Point{}, draw.Src)
+	draw.Draw(img, image.Rect(0, 0, 16, CardHeight), &image.Uniform{C: cardAccent}, image.Point{}, draw.Src)
+
+	quizFace, err := s.face(s.bold, 40)
+	if err != nil {
+		return nil, err
+	}
+	defer quizFace.Close()
+
+	resultFace, err := s.face(s.bold, 72)
+	if err != nil {
+		return nil, err
+	}
+	defer resultFace.Close()
+
+	textFace, err := s.face(s.regular, 30)
+	if err != nil {
+		return nil, err
+	}
+	defer textFace.Close()
+
+	y := cardPadding
+	y = drawLines(img, quizFace, cardMuted, card.QuizTitle, y, 2)
+	y += 40
+	y = drawLines(img, resultFace, cardText, card.Result, y, 2)
+	y += 24
+	drawLines(img, textFace, cardMuted, card.ResultDescription, y, 3)
+
+	if card.DisplayName != "" {
+		drawLine(img, textFace, cardAccent, card.DisplayName, CardHeight-cardPadding)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("failed to encode card: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (s *Service) face(f *opentype.Font, size float64) (font.Face, error) {
+	face, err := opentype.NewFace(f, &opentype.FaceOptions{Size: size, DPI: 72, Hinting: font.HintingFull})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create font face: %w", err)
+	}
+	return face, nil
+}
+
+// drawLines draws the text wrapped to at most maxLines lines below y and
+// returns the y below the last line.
+func drawLines(img draw.Image, face font.Face, c color.Color, text string, y, maxLines int) int {
+	lineHeight := face.Metrics().Height.Ceil()
+	for _, line := range wrap(face, text, CardWidth-2*cardPadding, maxLines) {
+		y += face.Metrics().Ascent.Ceil()
+		drawLine(img, face, c, line, y)
+		y += lineHeight - face.Metrics().Ascent.Ceil()
+	}
+	return y
+}
+
+func drawLine(img draw.Image, face font.Face, c color.Color, text string, baseline int) {
+	d := &font.Drawer{
+		Dst:  img,
+		Src:  image.NewUniform(c),
+		Face: face,
+		Dot:  fixed.P(cardPadding, baseline),
+	}
+	d.DrawString(text)
+}
+
+// wrap splits the text into lines no wider than width, breaking between
+// words. The last line is cut with an ellipsis when the text needs more than
+// maxLines lines.
+func wrap(face font.Face, text string, width, maxLines int) []string {
+	maxWidth := fixed.I(width)
+
+	var lines []string
+	var line string
+	for _, word := range strings.Fields(text) {
+		candidate := word
+		if line != "" {
+			candidate = line + " " + word
+		}
+
+		if line != "" && font.MeasureString(face, candidate) > maxWidth {
+			lines = append(lines, line)
+			line = word
+		} else {
+			line = candidate
+		}
+
+		if len(lines) == maxLines {
+			break
+		}
+	}
+	if line != "" && len(lines) < maxLines {
+		lines = append(lines, line)
+		line = ""
+	}
+
+	for i, l := range lines {
+		lines[i] = truncate(face, l, maxWidth, i == len(lines)-1 && line != "")
+	}
+
+	return lines
+}
+
+// truncate cuts the line to fit maxWidth, ending it with an ellipsis if it is
+// cut or more text follows it.
+func truncate(face font.Face, line string, maxWidth fixed.Int26_6, more bool) string {
+	const ellipsis = "…"
+
+	if !more && font.MeasureString(face, line) <= maxWidth {
+		return line
+	}
+
+	runes := []rune(line)
+	for len(runes) > 0 && font.MeasureString(face, string(runes)+ellipsis) > maxWidth {
+		runes = runes[:len(runes)-1]
+	}
+
+	return strings.TrimRight(string(runes), " ") + ellipsis
+}
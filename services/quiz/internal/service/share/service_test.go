@@ -0,0 +1,42 @@
+package share_test
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/share"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderCard(t *testing.T) {
+	service, err := share.NewService()
+	if err != nil {
+		t.Fatalf("failed to create service: %v", err)
+	}
+
+	cards := []*share.Card{
+		{
+			QuizTitle:         "Какой ты персонаж?",
+			Result:            "Шерлок Холмс",
+			ResultDescription: strings.Repeat("Наблюдательный и логичный. ", 40),
+			DisplayName:       "alice",
+		},
+		{
+			QuizTitle: strings.Repeat("Averyveryverylongwordwithoutspaces", 10),
+			Result:    "A",
+		},
+		{},
+	}
+
+	for _, card := range cards {
+		data, err := service.RenderCard(card)
+		assert.NoError(t, err)
+
+		img, err := png.Decode(bytes.NewReader(data))
+		assert.NoError(t, err)
+		assert.Equal(t, share.CardWidth, img.Bounds().Dx())
+		assert.Equal(t, share.CardHeight, img.Bounds().Dy())
+	}
+}
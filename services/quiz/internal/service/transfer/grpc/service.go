@@ -0,0 +1,98 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+	quizv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/quiz/v1"
+	transferv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/transfer/v1"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/category"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/media"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/quiz"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/transfer"
+	"github.com/mibrgmv/whoami-server/shared/grpc/interceptor"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type TransferService struct {
+	service         *transfer.Service
+	quizService     *quiz.Service
+	categoryService *category.Service
+	transferv1.UnimplementedTransferServiceServer
+}
+
+func NewService(service *transfer.Service, quizService *quiz.Service, categoryService *category.Service) *TransferService {
+	return &TransferService{
+		service:         service,
+		quizService:     quizService,
+		categoryService: categoryService,
+	}
+}
+
+func (s *TransferService) ExportQuiz(ctx context.Context, request *transferv1.ExportQuizRequest) (*transferv1.QuizDocument, error) {
+	quizID, err := uuid.Parse(request.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid quiz ID format: %v", err)
+	}
+
+	q, err := s.quizService.GetByID(ctx, quizID)
+	if err != nil {
+		if errors.Is(err, quiz.ErrQuizNotFound) {
+			return nil, status.Errorf(codes.NotFound, "quiz not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get quiz: %v", err)
+	}
+
+	if err := s.quizService.CheckAuthor(ctx, q); err != nil {
+		if errors.Is(err, quiz.ErrNotQuizAuthor) {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied: %v", err)
+		}
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated: %v", err)
+	}
+
+	document, err := s.service.Export(ctx, q)
+	if err != nil {
+		if errors.Is(err, media.ErrMediaNotFound) {
+			return nil, status.Errorf(codes.FailedPrecondition, "failed to export quiz: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to export quiz: %v", err)
+	}
+
+	return document.ToProto(), nil
+}
+
+func (s *TransferService) ImportQuiz(ctx context.Context, request *transferv1.ImportQuizRequest) (*quizv1.Quiz, error) {
+	authorID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated: %v", err)
+	}
+
+	if request.Document == nil {
+		return nil, status.Error(codes.InvalidArgument, "document is required")
+	}
+
+	document, err := models.QuizDocumentToModel(request.Document)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid quiz document: %v", err)
+	}
+
+	if err := s.categoryService.CheckExists(ctx, document.Quiz.Tags, nil); err != nil {
+		if errors.Is(err, category.ErrTagNotFound) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid quiz document: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to check tags: %v", err)
+	}
+
+	q, err := s.service.Import(ctx, document, authorID)
+	if err != nil {
+		if errors.Is(err, transfer.ErrInvalidDocument) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to import quiz: %v", err)
+	}
+
+	return q.ToProto(), nil
+}
@@ -0,0 +1,17 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Import(ctx context.Context, document *models.QuizDocument) (*models.Quiz, error) {
+	args := m.Called(ctx, document)
+	return args.Get(0).(*models.Quiz), args.Error(1)
+}
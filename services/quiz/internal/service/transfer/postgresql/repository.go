@@ -0,0 +1,55 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+	mediapg "github.com/mibrgmv/whoami-server/quiz/internal/service/media/postgresql"
+	questionpg "github.com/mibrgmv/whoami-server/quiz/internal/service/question/postgresql"
+	quizpg "github.com/mibrgmv/whoami-server/quiz/internal/service/quiz/postgresql"
+)
+
+type Repository struct {
+	pool *pgxpool.Pool
+}
+
+func NewRepository(pool *pgxpool.Pool) *Repository {
+	return &Repository{pool: pool}
+}
+
+// Import records the media, the quiz and its questions in one transaction.
+func (r *Repository) Import(ctx context.Context, document *models.QuizDocument) (*models.Quiz, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction failed: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				fmt.Printf("transaction rollback failed: %v\n", rbErr)
+			}
+			return
+		}
+		if cErr := tx.Commit(ctx); cErr != nil {
+			fmt.Printf("transaction commit failed: %v\n", cErr)
+		}
+	}()
+
+	for _, m := range document.Media {
+		if err = mediapg.Insert(ctx, tx, m.Media); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = quizpg.Insert(ctx, tx, document.Quiz); err != nil {
+		return nil, err
+	}
+
+	if _, err = questionpg.Insert(ctx, tx, document.Questions); err != nil {
+		return nil, err
+	}
+
+	return document.Quiz, nil
+}
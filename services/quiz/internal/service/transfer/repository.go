@@ -0,0 +1,11 @@
+package transfer
+
+import (
+	"context"
+
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+)
+
+type Repository interface {
+	Import(ctx context.Context, document *models.QuizDocument) (*models.Quiz, error)
+}
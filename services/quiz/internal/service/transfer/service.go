@@ -0,0 +1,137 @@
+package transfer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/media"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/question"
+)
+
+var ErrInvalidDocument = errors.New("invalid quiz document")
+
+type Service struct {
+	repo            Repository
+	questionService *question.Service
+	mediaService    *media.Service
+}
+
+func NewService(repo Repository, questionService *question.Service, mediaService *media.Service) *Service {
+	return &Service{
+		repo:            repo,
+		questionService: questionService,
+		mediaService:    mediaService,
+	}
+}
+
+// Export collects the quiz, its questions and the content of every media they
+// reference into a document.
+func (s *Service) Export(ctx context.Context, quiz *models.Quiz) (*models.QuizDocument, error) {
+	questions, err := s.questionService.GetByQuizID(ctx, quiz.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	mediaIDs := quiz.MediaIDs()
+	for _, q := range questions {
+		for _, id := range q.MediaIDs() {
+			if !slices.Contains(mediaIDs, id) {
+				mediaIDs = append(mediaIDs, id)
+			}
+		}
+	}
+
+	mediaContents := make([]models.MediaContent, len(mediaIDs))
+	for i, id := range mediaIDs {
+		mediaContents[i], err = s.readMedia(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &models.QuizDocument{
+		Quiz:      quiz,
+		Questions: questions,
+		Media:     mediaContents,
+	}, nil
+}
+
+func (s *Service) readMedia(ctx context.Context, id uuid.UUID) (models.MediaContent, error) {
+	m, content, err := s.mediaService.Open(ctx, id)
+	if err != nil {
+		return models.MediaContent{}, err
+	}
+	defer content.Close()
+
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return models.MediaContent{}, fmt.Errorf("failed to read media content: %w", err)
+	}
+
+	return models.MediaContent{Media: m, Content: data}, nil
+}
+
+// Import validates the document and creates the quiz it describes as a draft
+// of the author. Either the whole document is imported or nothing is.
+func (s *Service) Import(ctx context.Context, document *models.QuizDocument, authorID uuid.UUID) (*models.Quiz, error) {
+	if err := validateDocument(document); err != nil {
+		return nil, err
+	}
+
+	document.Quiz.AuthorID = authorID
+
+	var stored []uuid.UUID
+	for _, m := range document.Media {
+		m.Media.OwnerID = authorID
+		if err := s.mediaService.Store(ctx, m.Media, m.Content); err != nil {
+			s.discard(ctx, stored)
+			if errors.Is(err, media.ErrInvalidMedia) {
+				return nil, fmt.Errorf("%w: %v", ErrInvalidDocument, err)
+			}
+			return nil, err
+		}
+		stored = append(stored, m.Media.ID)
+	}
+
+	quiz, err := s.repo.Import(ctx, document)
+	if err != nil {
+		s.discard(ctx, stored)
+		return nil, err
+	}
+
+	return quiz, nil
+}
+
+func (s *Service) discard(ctx context.Context, ids []uuid.UUID) {
+	for _, id := range ids {
+		s.mediaService.Discard(ctx, id)
+	}
+}
+
+func validateDocument(document *models.QuizDocument) error {
+	quiz := document.Quiz
+	if quiz.TimeLimitSeconds < 0 || quiz.QuestionTimeLimitSeconds < 0 {
+		return fmt.Errorf("%w: time limits cannot be negative", ErrInvalidDocument)
+	}
+
+	for i, q := range document.Questions {
+		if violations := question.ValidateForQuiz(quiz, q); len(violations) > 0 {
+			field := fmt.Sprintf("questions[%d]", i)
+			if violations[0].Field != "" {
+				field += "." + violations[0].Field
+			}
+			return fmt.Errorf("%w: %s: %s", ErrInvalidDocument, field, violations[0].Description)
+		}
+	}
+
+	if err := models.NewFlow(document.Questions).Validate(); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidDocument, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,196 @@
+package transfer_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/media"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/media/local"
+	mediamocks "github.com/mibrgmv/whoami-server/quiz/internal/service/media/mocks"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/question"
+	questionmocks "github.com/mibrgmv/whoami-server/quiz/internal/service/question/mocks"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/transfer"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/transfer/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var png = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00\x01\x00\x00\x00\x01\x08\x06\x00\x00\x00")
+
+type fixture struct {
+	service      *transfer.Service
+	repo         *mocks.MockRepository
+	questionRepo *questionmocks.MockRepository
+	cache        *questionmocks.MockCache
+	mediaRepo    *mediamocks.MockRepository
+	store        *local.Store
+}
+
+func newFixture(t *testing.T) *fixture {
+	store, err := local.NewStore(local.Config{Root: t.TempDir()})
+	if err != nil {
+		t.Fatalf("failed to create store: %v", err)
+	}
+
+	f := &fixture{
+		repo:         new(mocks.MockRepository),
+		questionRepo: new(questionmocks.MockRepository),
+		cache:        new(questionmocks.MockCache),
+		mediaRepo:    new(mediamocks.MockRepository),
+		store:        store,
+	}
+
+	questionService := question.NewService(f.questionRepo, f.cache)
+	mediaService := media.NewService(f.mediaRepo, store, media.Config{})
+	f.service = transfer.NewService(f.repo, questionService, mediaService)
+	return f
+}
+
+func TestExportImport(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+
+	imageID := uuid.New()
+	assert.NoError(t, f.store.Put(ctx, imageID.String(), bytes.NewReader(png)))
+	image := &models.Media{ID: imageID, OwnerID: uuid.New(), ContentType: "image/png", Size: int64(len(png))}
+
+	quizID := uuid.New()
+	firstID, secondID := uuid.New(), uuid.New()
+	quiz := &models.Quiz{
+		ID:      quizID,
+		Title:   "Which planet are you?",
+		Results: []string{"Mars", "Venus"},
+		ResultDetails: []models.ResultDetail{
+			{Title: "Mars", Description: "Red and restless", ImageID: &imageID},
+			{Title: "Venus", Description: "Bright and stormy"},
+		},
+		TieBreakPolicy:       models.TieBreakTiebreakerQuestion,
+		TiebreakerQuestionID: &secondID,
+	}
+	questions := []*models.Question{
+		{
+			ID:      firstID,
+			QuizID:  quizID,
+			Body:    "Do you like the heat?",
+			Options: []models.Option{{ID: uuid.New(), Text: "Yes", Weights: []float32{0, 1}, ImageID: &imageID}},
+			Route:   &models.Route{NextQuestionID: &secondID},
+		},
+		{
+			ID:       secondID,
+			QuizID:   quizID,
+			Body:     "Do you like sand?",
+			Options:  []models.Option{{ID: uuid.New(), Text: "Yes", Weights: []float32{1, 0}}},
+			Position: 1,
+		},
+	}
+
+	f.cache.On("Get", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("cache miss"))
+	f.cache.On("Set", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	f.questionRepo.On("Query", mock.Anything, question.Query{QuizIds: []uuid.UUID{quizID}}).Return(questions, nil)
+	f.mediaRepo.On("Query", mock.Anything, media.Query{Ids: []uuid.UUID{imageID}}).Return([]*models.Media{image}, nil)
+
+	exported, err := f.service.Export(ctx, quiz)
+	assert.NoError(t, err)
+	assert.Len(t, exported.Media, 1)
+	assert.Equal(t, png, exported.Media[0].Content)
+
+	document, err := models.QuizDocumentToModel(exported.ToProto())
+	assert.NoError(t, err)
+
+	authorID := uuid.New()
+	f.repo.On("Import", mock.Anything, document).Return(document.Quiz, nil).Once()
+
+	imported, err := f.service.Import(ctx, document, authorID)
+	assert.NoError(t, err)
+	f.repo.AssertExpectations(t)
+
+	assert.NotEqual(t, quizID, imported.ID)
+	assert.Equal(t, authorID, imported.AuthorID)
+	assert.Equal(t, quiz.Title, imported.Title)
+
+	newImageID := document.Media[0].Media.ID
+	assert.NotEqual(t, imageID, newImageID)
+	assert.Equal(t, authorID, document.Media[0].Media.OwnerID)
+	assert.Equal(t, &newImageID, imported.ResultDetails[0].ImageID)
+
+	first, second := document.Questions[0], document.Questions[1]
+	assert.NotEqual(t, firstID, first.ID)
+	assert.Equal(t, imported.ID, first.QuizID)
+	assert.Equal(t, &second.ID, first.Route.NextQuestionID)
+	assert.Equal(t, &second.ID, imported.TiebreakerQuestionID)
+	assert.Equal(t, &newImageID, first.Options[0].ImageID)
+	assert.NotEqual(t, questions[0].Options[0].ID, first.Options[0].ID)
+
+	content, err := f.store.Get(ctx, newImageID.String())
+	assert.NoError(t, err)
+	defer content.Close()
+
+	data, err := io.ReadAll(content)
+	assert.NoError(t, err)
+	assert.Equal(t, png, data)
+}
+
+func TestImport_InvalidDocument(t *testing.T) {
+	quizID := uuid.New()
+	quiz := &models.Quiz{ID: quizID, Results: []string{"Mars", "Venus"}}
+
+	tests := []struct {
+		name      string
+		questions []*models.Question
+	}{
+		{
+			name: "weights do not match results",
+			questions: []*models.Question{
+				{ID: uuid.New(), QuizID: quizID, Body: "Hot?", Options: []models.Option{{ID: uuid.New(), Text: "Yes", Weights: []float32{1}}}},
+			},
+		},
+		{
+			name: "duplicate options",
+			questions: []*models.Question{
+				{ID: uuid.New(), QuizID: quizID, Body: "Hot?", Options: []models.Option{
+					{ID: uuid.New(), Text: "Yes", Weights: []float32{1, 0}},
+					{ID: uuid.New(), Text: "Yes", Weights: []float32{0, 1}},
+				}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture(t)
+			document := &models.QuizDocument{
+				Quiz:      quiz,
+				Questions: tt.questions,
+				Media:     []models.MediaContent{{Media: &models.Media{ID: uuid.New()}, Content: png}},
+			}
+
+			_, err := f.service.Import(context.Background(), document, uuid.New())
+			assert.ErrorIs(t, err, transfer.ErrInvalidDocument)
+			f.repo.AssertNotCalled(t, "Import", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestImport_RepositoryErrorDiscardsMedia(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+
+	mediaID := uuid.New()
+	document := &models.QuizDocument{
+		Quiz:  &models.Quiz{ID: uuid.New(), Results: []string{"Mars"}},
+		Media: []models.MediaContent{{Media: &models.Media{ID: mediaID}, Content: png}},
+	}
+
+	f.repo.On("Import", mock.Anything, document).Return((*models.Quiz)(nil), errors.New("db down")).Once()
+
+	_, err := f.service.Import(ctx, document, uuid.New())
+	assert.Error(t, err)
+
+	_, err = f.store.Get(ctx, mediaID.String())
+	assert.ErrorIs(t, err, media.ErrBlobNotFound)
+}
@@ -0,0 +1,127 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+	translationv1 "github.com/mibrgmv/whoami-server/quiz/internal/protogen/translation/v1"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/question"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/quiz"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/translation"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type TranslationService struct {
+	service         *translation.Service
+	quizService     *quiz.Service
+	questionService *question.Service
+	translationv1.UnimplementedTranslationServiceServer
+}
+
+func NewService(service *translation.Service, quizService *quiz.Service, questionService *question.Service) *TranslationService {
+	return &TranslationService{
+		service:         service,
+		quizService:     quizService,
+		questionService: questionService,
+	}
+}
+
+func (s *TranslationService) PutQuizTranslation(ctx context.Context, request *translationv1.PutQuizTranslationRequest) (*translationv1.QuizTranslation, error) {
+	q, err := s.getForAuthor(ctx, request.QuizId)
+	if err != nil {
+		return nil, err
+	}
+
+	questionTranslations, err := models.QuestionTranslationsToModel(request.Questions)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid question translations: %v", err)
+	}
+
+	questions, err := s.questionService.GetByQuizID(ctx, q.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get questions by quiz id: %v", err)
+	}
+
+	t, err := s.service.Put(ctx, q, questions, &models.QuizTranslation{
+		Language:    request.Language,
+		Title:       request.Title,
+		Description: request.Description,
+		Results:     request.Results,
+		Questions:   questionTranslations,
+	})
+	if err != nil {
+		if errors.Is(err, translation.ErrInvalidTranslation) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to put quiz translation: %v", err)
+	}
+
+	return t.ToProto(), nil
+}
+
+func (s *TranslationService) ListQuizTranslations(ctx context.Context, request *translationv1.ListQuizTranslationsRequest) (*translationv1.ListQuizTranslationsResponse, error) {
+	q, err := s.getForAuthor(ctx, request.QuizId)
+	if err != nil {
+		return nil, err
+	}
+
+	translations, err := s.service.List(ctx, q.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list quiz translations: %v", err)
+	}
+
+	var pbTranslations []*translationv1.QuizTranslation
+	for _, t := range translations {
+		pbTranslations = append(pbTranslations, t.ToProto())
+	}
+
+	return &translationv1.ListQuizTranslationsResponse{Translations: pbTranslations}, nil
+}
+
+func (s *TranslationService) DeleteQuizTranslation(ctx context.Context, request *translationv1.DeleteQuizTranslationRequest) (*translationv1.DeleteQuizTranslationResponse, error) {
+	q, err := s.getForAuthor(ctx, request.QuizId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.service.Delete(ctx, q.ID, request.Language); err != nil {
+		if errors.Is(err, translation.ErrTranslationNotFound) {
+			return nil, status.Errorf(codes.NotFound, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete quiz translation: %v", err)
+	}
+
+	return &translationv1.DeleteQuizTranslationResponse{
+		QuizId:   request.QuizId,
+		Language: request.Language,
+		Message:  "Translation deleted successfully",
+	}, nil
+}
+
+// getForAuthor returns the quiz if the caller may edit its translations.
+func (s *TranslationService) getForAuthor(ctx context.Context, id string) (*models.Quiz, error) {
+	quizID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid quiz ID format: %v", err)
+	}
+
+	q, err := s.quizService.GetByID(ctx, quizID)
+	if err != nil {
+		if errors.Is(err, quiz.ErrQuizNotFound) {
+			return nil, status.Errorf(codes.NotFound, "quiz not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get quiz: %v", err)
+	}
+
+	if err := s.quizService.CheckAuthor(ctx, q); err != nil {
+		if errors.Is(err, quiz.ErrNotQuizAuthor) {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied: %v", err)
+		}
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated: %v", err)
+	}
+
+	return q, nil
+}
@@ -0,0 +1,95 @@
+package translation
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+	"google.golang.org/grpc/metadata"
+)
+
+// AcceptLanguageKey is the metadata key the gateway forwards the
+// Accept-Language header of the request with.
+const AcceptLanguageKey = "accept-language"
+
+// AcceptedLanguages returns the languages accepted by the caller, most
+// preferred first.
+func AcceptedLanguages(ctx context.Context) []string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	return ParseAcceptLanguage(strings.Join(md.Get(AcceptLanguageKey), ","))
+}
+
+// ParseAcceptLanguage returns the languages of an Accept-Language header
+// ordered by their quality, skipping the wildcard and refused languages.
+func ParseAcceptLanguage(header string) []string {
+	type weighted struct {
+		language string
+		quality  float64
+	}
+
+	var languages []weighted
+	for _, part := range strings.Split(header, ",") {
+		language, params, _ := strings.Cut(part, ";")
+		language = models.NormalizeLanguage(language)
+		if language == "" || language == "*" {
+			continue
+		}
+
+		quality := 1.0
+		for _, param := range strings.Split(params, ";") {
+			if value, ok := strings.CutPrefix(strings.TrimSpace(param), "q="); ok {
+				if q, err := strconv.ParseFloat(value, 64); err == nil {
+					quality = q
+				}
+			}
+		}
+
+		if quality > 0 {
+			languages = append(languages, weighted{language: language, quality: quality})
+		}
+	}
+
+	slices.SortStableFunc(languages, func(a, b weighted) int {
+		switch {
+		case a.quality > b.quality:
+			return -1
+		case a.quality < b.quality:
+			return 1
+		}
+		return 0
+	})
+
+	result := make([]string, len(languages))
+	for i, l := range languages {
+		result[i] = l.language
+	}
+	return result
+}
+
+// Negotiate picks the first accepted language that is available, matching
+// "en-us" with "en" and the other way around, or the fallback.
+func Negotiate(accepted, available []string, fallback string) string {
+	for _, language := range accepted {
+		if slices.Contains(available, language) {
+			return language
+		}
+
+		base := baseLanguage(language)
+		if i := slices.IndexFunc(available, func(a string) bool { return baseLanguage(a) == base }); i >= 0 {
+			return available[i]
+		}
+	}
+
+	return fallback
+}
+
+func baseLanguage(language string) string {
+	base, _, _ := strings.Cut(language, "-")
+	return base
+}
@@ -0,0 +1,29 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/translation"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Put(ctx context.Context, t *models.QuizTranslation) (*models.QuizTranslation, error) {
+	args := m.Called(ctx, t)
+	return args.Get(0).(*models.QuizTranslation), args.Error(1)
+}
+
+func (m *MockRepository) Query(ctx context.Context, query translation.Query) ([]*models.QuizTranslation, error) {
+	args := m.Called(ctx, query)
+	return args.Get(0).([]*models.QuizTranslation), args.Error(1)
+}
+
+func (m *MockRepository) Delete(ctx context.Context, quizID uuid.UUID, language string) error {
+	args := m.Called(ctx, quizID, language)
+	return args.Error(0)
+}
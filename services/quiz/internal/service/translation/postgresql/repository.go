@@ -0,0 +1,195 @@
+package postgresql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/translation"
+)
+
+type Repository struct {
+	pool *pgxpool.Pool
+}
+
+func NewRepository(pool *pgxpool.Pool) *Repository {
+	return &Repository{pool: pool}
+}
+
+func (r *Repository) Put(ctx context.Context, t *models.QuizTranslation) (*models.QuizTranslation, error) {
+	quizSQL := `
+	insert into quiz_translations (quiz_id, translation_language, translation_title, translation_description,
+	                               translation_results)
+	values ($1, $2, $3, $4, coalesce($5::text[], '{}'))
+	on conflict (quiz_id, translation_language) do update
+	    set translation_title       = excluded.translation_title,
+	        translation_description = excluded.translation_description,
+	        translation_results     = excluded.translation_results,
+	        updated_at              = now()
+	returning updated_at
+	`
+
+	questionsSQL := `
+	insert into question_translations (quiz_id, translation_language, question_id, translation_body,
+	                                   translation_options)
+	select $1, $2, question_id, translation_body, translation_options
+	from unnest($3::uuid[], $4::text[], $5::jsonb[]) as source (question_id, translation_body, translation_options)
+	`
+
+	questionIDs := make([]uuid.UUID, len(t.Questions))
+	bodies := make([]string, len(t.Questions))
+	options := make([][]byte, len(t.Questions))
+	for i, q := range t.Questions {
+		questionIDs[i] = q.QuestionID
+		bodies[i] = q.Body
+
+		optionsJSON, err := json.Marshal(q.Options)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal options: %w", err)
+		}
+		options[i] = optionsJSON
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction failed: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				fmt.Printf("transaction rollback failed: %v\n", rbErr)
+			}
+			return
+		}
+		if cErr := tx.Commit(ctx); cErr != nil {
+			fmt.Printf("transaction commit failed: %v\n", cErr)
+		}
+	}()
+
+	err = tx.QueryRow(ctx, quizSQL, t.QuizID, t.Language, t.Title, t.Description, t.Results).Scan(&t.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert quiz translation: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, `delete from question_translations where quiz_id = $1 and translation_language = $2`,
+		t.QuizID, t.Language); err != nil {
+		return nil, fmt.Errorf("failed to clear question translations: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, questionsSQL, t.QuizID, t.Language, questionIDs, bodies, options); err != nil {
+		return nil, fmt.Errorf("failed to insert question translations: %w", err)
+	}
+
+	return t, nil
+}
+
+func (r *Repository) Query(ctx context.Context, query translation.Query) ([]*models.QuizTranslation, error) {
+	sql := `
+	select quiz_id,
+	       translation_language,
+	       translation_title,
+	       translation_description,
+	       translation_results,
+	       updated_at
+	from quiz_translations
+	where quiz_id = any ($1)
+	order by quiz_id, translation_language
+	`
+
+	rows, err := r.pool.Query(ctx, sql, query.QuizIds)
+	if err != nil {
+		return nil, fmt.Errorf("query failed: %w", err)
+	}
+	defer rows.Close()
+
+	var translations []*models.QuizTranslation
+	for rows.Next() {
+		t := new(models.QuizTranslation)
+		if err := rows.Scan(&t.QuizID, &t.Language, &t.Title, &t.Description, &t.Results, &t.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("scan failed: %w", err)
+		}
+
+		translations = append(translations, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	if query.WithQuestions && len(translations) > 0 {
+		if err := r.queryQuestions(ctx, query, translations); err != nil {
+			return nil, err
+		}
+	}
+
+	return translations, nil
+}
+
+func (r *Repository) queryQuestions(ctx context.Context, query translation.Query, translations []*models.QuizTranslation) error {
+	sql := `
+	select question_translations.quiz_id,
+	       question_translations.translation_language,
+	       question_translations.question_id,
+	       translation_body,
+	       translation_options
+	from question_translations
+	         join questions on questions.question_id = question_translations.question_id
+	where question_translations.quiz_id = any ($1)
+	order by question_position, question_translations.question_id
+	`
+
+	rows, err := r.pool.Query(ctx, sql, query.QuizIds)
+	if err != nil {
+		return fmt.Errorf("query failed: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var quizID uuid.UUID
+		var language string
+		var q models.QuestionTranslation
+		var optionsJSON []byte
+
+		if err := rows.Scan(&quizID, &language, &q.QuestionID, &q.Body, &optionsJSON); err != nil {
+			return fmt.Errorf("scan failed: %w", err)
+		}
+
+		if err := json.Unmarshal(optionsJSON, &q.Options); err != nil {
+			return fmt.Errorf("unmarshal failed: %w", err)
+		}
+
+		for _, t := range translations {
+			if t.QuizID == quizID && t.Language == language {
+				t.Questions = append(t.Questions, q)
+			}
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("rows error: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) Delete(ctx context.Context, quizID uuid.UUID, language string) error {
+	sql := `
+	delete from quiz_translations
+	where quiz_id = $1
+	  and translation_language = $2
+	`
+
+	tag, err := r.pool.Exec(ctx, sql, quizID, language)
+	if err != nil {
+		return fmt.Errorf("failed to delete quiz translation: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return translation.ErrTranslationNotFound
+	}
+
+	return nil
+}
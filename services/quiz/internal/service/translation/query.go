@@ -0,0 +1,8 @@
+package translation
+
+import "github.com/google/uuid"
+
+type Query struct {
+	QuizIds       []uuid.UUID
+	WithQuestions bool
+}
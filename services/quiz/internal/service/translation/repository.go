@@ -0,0 +1,14 @@
+package translation
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+)
+
+type Repository interface {
+	Put(ctx context.Context, translation *models.QuizTranslation) (*models.QuizTranslation, error)
+	Query(ctx context.Context, query Query) ([]*models.QuizTranslation, error)
+	Delete(ctx context.Context, quizID uuid.UUID, language string) error
+}
@@ -0,0 +1,179 @@
+package translation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+)
+
+const defaultLanguage = "ru"
+
+var (
+	ErrTranslationNotFound = errors.New("translation not found")
+	ErrInvalidTranslation  = errors.New("invalid translation")
+)
+
+type Config struct {
+	// DefaultLanguage is the language of quizzes that do not set one, and the
+	// language shown when none of the accepted ones is available.
+	DefaultLanguage string `mapstructure:"default_language"`
+}
+
+type Service struct {
+	repo            Repository
+	defaultLanguage string
+}
+
+func NewService(repo Repository, cfg Config) *Service {
+	language := models.NormalizeLanguage(cfg.DefaultLanguage)
+	if language == "" {
+		language = defaultLanguage
+	}
+
+	return &Service{
+		repo:            repo,
+		defaultLanguage: language,
+	}
+}
+
+// Language returns the language the quiz is written in.
+func (s *Service) Language(q *models.Quiz) string {
+	if q.Language != "" {
+		return q.Language
+	}
+	return s.defaultLanguage
+}
+
+// Put replaces the translation of the quiz into the language of the
+// translation.
+func (s *Service) Put(ctx context.Context, q *models.Quiz, questions []*models.Question, t *models.QuizTranslation) (*models.QuizTranslation, error) {
+	t.QuizID = q.ID
+	t.Language = models.NormalizeLanguage(t.Language)
+
+	if err := s.validate(q, questions, t); err != nil {
+		return nil, err
+	}
+
+	return s.repo.Put(ctx, t)
+}
+
+func (s *Service) validate(q *models.Quiz, questions []*models.Question, t *models.QuizTranslation) error {
+	if t.Language == "" {
+		return fmt.Errorf("%w: language must not be empty", ErrInvalidTranslation)
+	}
+
+	if t.Language == s.Language(q) {
+		return fmt.Errorf("%w: the quiz is written in %s", ErrInvalidTranslation, t.Language)
+	}
+
+	if len(t.Results) > 0 && len(t.Results) != len(q.Results) {
+		return fmt.Errorf("%w: expected %d results, got %d", ErrInvalidTranslation, len(q.Results), len(t.Results))
+	}
+
+	for i, tq := range t.Questions {
+		j := slices.IndexFunc(questions, func(question *models.Question) bool { return question.ID == tq.QuestionID })
+		if j < 0 {
+			return fmt.Errorf("%w: question %s is not in the quiz", ErrInvalidTranslation, tq.QuestionID)
+		}
+
+		if slices.ContainsFunc(t.Questions[:i], func(previous models.QuestionTranslation) bool {
+			return previous.QuestionID == tq.QuestionID
+		}) {
+			return fmt.Errorf("%w: question %s is translated more than once", ErrInvalidTranslation, tq.QuestionID)
+		}
+
+		for optionID := range tq.Options {
+			if _, exists := questions[j].OptionByID(optionID); !exists {
+				return fmt.Errorf("%w: option %s is not in question %s", ErrInvalidTranslation, optionID, tq.QuestionID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) List(ctx context.Context, quizID uuid.UUID) ([]*models.QuizTranslation, error) {
+	return s.repo.Query(ctx, Query{QuizIds: []uuid.UUID{quizID}, WithQuestions: true})
+}
+
+func (s *Service) Delete(ctx context.Context, quizID uuid.UUID, language string) error {
+	return s.repo.Delete(ctx, quizID, models.NormalizeLanguage(language))
+}
+
+// Localize negotiates the language to show the quiz, its questions and
+// results in from the languages accepted by the caller.
+func (s *Service) Localize(ctx context.Context, q *models.Quiz) (*models.Localization, error) {
+	translations, err := s.repo.Query(ctx, Query{QuizIds: []uuid.UUID{q.ID}, WithQuestions: true})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.localization(AcceptedLanguages(ctx), q, translations), nil
+}
+
+// LocalizeAll returns copies of the quizzes in the languages negotiated for
+// each of them.
+func (s *Service) LocalizeAll(ctx context.Context, quizzes []*models.Quiz) ([]*models.Quiz, error) {
+	if len(quizzes) == 0 {
+		return quizzes, nil
+	}
+
+	ids := make([]uuid.UUID, len(quizzes))
+	for i, q := range quizzes {
+		ids[i] = q.ID
+	}
+
+	translations, err := s.repo.Query(ctx, Query{QuizIds: ids})
+	if err != nil {
+		return nil, err
+	}
+
+	accepted := AcceptedLanguages(ctx)
+	localized := make([]*models.Quiz, len(quizzes))
+	for i, q := range quizzes {
+		var quizTranslations []*models.QuizTranslation
+		for _, t := range translations {
+			if t.QuizID == q.ID {
+				quizTranslations = append(quizTranslations, t)
+			}
+		}
+
+		localized[i] = s.localization(accepted, q, quizTranslations).Quiz(q)
+	}
+
+	return localized, nil
+}
+
+// localization falls back to the default language when it is available and
+// to the language the quiz is written in otherwise.
+func (s *Service) localization(accepted []string, q *models.Quiz, translations []*models.QuizTranslation) *models.Localization {
+	language := s.Language(q)
+	languages := []string{language}
+	for _, t := range translations {
+		if t.Language != language {
+			languages = append(languages, t.Language)
+		}
+	}
+
+	fallback := language
+	if slices.Contains(languages, s.defaultLanguage) {
+		fallback = s.defaultLanguage
+	}
+
+	l := &models.Localization{
+		Language:  Negotiate(accepted, languages, fallback),
+		Languages: languages,
+	}
+
+	if l.Language != language {
+		if i := slices.IndexFunc(translations, func(t *models.QuizTranslation) bool { return t.Language == l.Language }); i >= 0 {
+			l.Translation = translations[i]
+		}
+	}
+
+	return l
+}
@@ -0,0 +1,129 @@
+package translation_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mibrgmv/whoami-server/quiz/internal/models"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/translation"
+	"github.com/mibrgmv/whoami-server/quiz/internal/service/translation/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestParseAcceptLanguage(t *testing.T) {
+	languages := translation.ParseAcceptLanguage("en-US;q=0.8, ru, *;q=0.5, de;q=0, fr;q=0.8")
+	assert.Equal(t, []string{"ru", "en-us", "fr"}, languages)
+
+	assert.Empty(t, translation.ParseAcceptLanguage(""))
+}
+
+func TestNegotiate(t *testing.T) {
+	available := []string{"ru", "en"}
+
+	assert.Equal(t, "en", translation.Negotiate([]string{"de", "en-us"}, available, "ru"))
+	assert.Equal(t, "ru", translation.Negotiate([]string{"ru-ru", "en"}, available, "en"))
+	assert.Equal(t, "ru", translation.Negotiate([]string{"de"}, available, "ru"))
+	assert.Equal(t, "ru", translation.Negotiate(nil, available, "ru"))
+}
+
+func TestLocalize(t *testing.T) {
+	repo := new(mocks.MockRepository)
+	service := translation.NewService(repo, translation.Config{})
+
+	optionID := uuid.New()
+	question := &models.Question{
+		ID:      uuid.New(),
+		Body:    "Любимое время года?",
+		Options: []models.Option{{ID: optionID, Text: "Лето"}, {ID: uuid.New(), Text: "Зима"}},
+	}
+	quiz := &models.Quiz{
+		ID:            uuid.New(),
+		Title:         "Какой вы сезон",
+		Results:       []string{"Лето", "Зима"},
+		ResultDetails: []models.ResultDetail{{Title: "Зима", Description: "Холодно"}},
+	}
+	english := &models.QuizTranslation{
+		QuizID:   quiz.ID,
+		Language: "en",
+		Title:    "Which season are you",
+		Results:  []string{"Summer", ""},
+		Questions: []models.QuestionTranslation{{
+			QuestionID: question.ID,
+			Body:       "Favourite season?",
+			Options:    map[uuid.UUID]string{optionID: "Summer"},
+		}},
+	}
+
+	repo.On("Query", mock.Anything, translation.Query{QuizIds: []uuid.UUID{quiz.ID}, WithQuestions: true}).
+		Return([]*models.QuizTranslation{english}, nil)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(translation.AcceptLanguageKey, "en-GB,en;q=0.9"))
+	localization, err := service.Localize(ctx, quiz)
+	assert.NoError(t, err)
+	assert.Equal(t, "en", localization.Language)
+	assert.Equal(t, []string{"ru", "en"}, localization.Languages)
+
+	localizedQuiz := localization.Quiz(quiz)
+	assert.Equal(t, "Which season are you", localizedQuiz.Title)
+	assert.Equal(t, []string{"Summer", "Зима"}, localizedQuiz.Results)
+	assert.Equal(t, "Какой вы сезон", quiz.Title)
+
+	localizedQuestion := localization.Question(question)
+	assert.Equal(t, "Favourite season?", localizedQuestion.Body)
+	assert.Equal(t, "Summer", localizedQuestion.Options[0].Text)
+	assert.Equal(t, "Зима", localizedQuestion.Options[1].Text)
+	assert.Equal(t, "Лето", question.Options[0].Text)
+
+	evaluation := models.NewEvaluation(quiz.Results, []float32{2, 1}, 0)
+	evaluation.ResultDetail = quiz.ResultDetail(evaluation.Result)
+	localizedEvaluation := localization.Evaluation(quiz.Results, evaluation)
+	assert.Equal(t, "Summer", localizedEvaluation.Result)
+	assert.Equal(t, "Summer", localizedEvaluation.ResultDetail.Title)
+	assert.Equal(t, "Зима", localizedEvaluation.Scores[1].Result)
+	assert.Equal(t, "Лето", evaluation.Result)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(translation.AcceptLanguageKey, "de"))
+	localization, err = service.Localize(ctx, quiz)
+	assert.NoError(t, err)
+	assert.Equal(t, "ru", localization.Language)
+	assert.Nil(t, localization.Translation)
+	assert.Equal(t, "Какой вы сезон", localization.Quiz(quiz).Title)
+}
+
+func TestPut_Invalid(t *testing.T) {
+	repo := new(mocks.MockRepository)
+	service := translation.NewService(repo, translation.Config{DefaultLanguage: "en"})
+	ctx := context.Background()
+
+	question := &models.Question{ID: uuid.New(), Options: []models.Option{{ID: uuid.New(), Text: "Yes"}}}
+	quiz := &models.Quiz{ID: uuid.New(), Results: []string{"A", "B"}}
+	questions := []*models.Question{question}
+
+	tests := []struct {
+		name        string
+		translation *models.QuizTranslation
+	}{
+		{"no language", &models.QuizTranslation{}},
+		{"original language", &models.QuizTranslation{Language: " EN "}},
+		{"results mismatch", &models.QuizTranslation{Language: "ru", Results: []string{"А"}}},
+		{"unknown question", &models.QuizTranslation{Language: "ru", Questions: []models.QuestionTranslation{
+			{QuestionID: uuid.New()},
+		}}},
+		{"unknown option", &models.QuizTranslation{Language: "ru", Questions: []models.QuestionTranslation{
+			{QuestionID: question.ID, Options: map[uuid.UUID]string{uuid.New(): "Да"}},
+		}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.Put(ctx, quiz, questions, tt.translation)
+			assert.True(t, errors.Is(err, translation.ErrInvalidTranslation), "got %v", err)
+		})
+	}
+
+	repo.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+}
@@ -15,6 +15,7 @@ const (
 	UsernameKey      string = "username"
 	EmailKey         string = "email"
 	EmailVerifiedKey string = "email_verified"
+	RolesKey         string = "roles"
 )
 
 func UnaryMetadataInterceptor() grpc.UnaryServerInterceptor {
@@ -63,6 +64,10 @@ func enrichContextFromMetadata(ctx context.Context) context.Context {
 		newCtx = context.WithValue(newCtx, EmailVerifiedKey, verified)
 	}
 
+	if roles := md.Get("roles"); len(roles) > 0 {
+		newCtx = context.WithValue(newCtx, RolesKey, roles)
+	}
+
 	return newCtx
 }
 
@@ -95,3 +100,8 @@ func GetEmailFromContext(ctx context.Context) (string, error) {
 	}
 	return email, nil
 }
+
+func GetRolesFromContext(ctx context.Context) []string {
+	roles, _ := ctx.Value(RolesKey).([]string)
+	return roles
+}
@@ -0,0 +1,17 @@
+// Package guest tells the IDs of guest sessions apart from user IDs.
+package guest
+
+import "github.com/google/uuid"
+
+// NewID returns a new guest ID. Guest IDs are version 8 UUIDs, while Keycloak
+// gives users random version 4 ones, so a guest ID is never a user ID.
+func NewID() uuid.UUID {
+	id := uuid.New()
+	id[6] = id[6]&0x0f | 0x80
+	return id
+}
+
+// IsID reports whether the ID was made by NewID.
+func IsID(id uuid.UUID) bool {
+	return id.Version() == 8 && id.Variant() == uuid.RFC4122
+}
@@ -15,3 +15,19 @@ type Claims struct {
 	Scope             string                 `json:"scope"`
 	SessionState      string                 `json:"session_state"`
 }
+
+func (c *Claims) RealmRoles() []string {
+	rawRoles, ok := c.RealmAccess["roles"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	roles := make([]string, 0, len(rawRoles))
+	for _, r := range rawRoles {
+		if role, ok := r.(string); ok {
+			roles = append(roles, role)
+		}
+	}
+
+	return roles
+}